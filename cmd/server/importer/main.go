@@ -0,0 +1,152 @@
+// Command importer loads a csv or yaml career plan into the database of the server. It lives under cmd/server
+// because it reuses the internal packages of the server, which Go only lets the packages under cmd/server import.
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/database"
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service"
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
+)
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	opts, err := parseOptions(os.Args[1:], os.Stderr)
+	if err != nil {
+		return err
+	}
+
+	plan, err := readPlan(opts)
+	if err != nil {
+		return err
+	}
+
+	db, err := database.OpenMigrated(database.ConfigFromEnv())
+	if err != nil {
+		return err
+	}
+
+	defer db.Close()
+
+	return importPlan(storage.NewStorage(db), opts, plan, os.Stdout)
+}
+
+type options struct {
+	careerID string
+	file     string
+	format   string
+	dryRun   bool
+}
+
+// parseOptions reads the flags of the command, writing the usage to output when they are wrong.
+func parseOptions(args []string, output io.Writer) (options, error) {
+	var opts options
+
+	flags := flag.NewFlagSet("importer", flag.ContinueOnError)
+	flags.SetOutput(output)
+	flags.Usage = func() {
+		fmt.Fprintln(output, "Usage of importer:")
+		fmt.Fprintln(output, "  go run ./cmd/server/importer -career ID -file PLAN [-format csv|yaml] [-dry-run]")
+		fmt.Fprintln(output, "The database is read from DATABASE_DRIVER and DATABASE_CONFIG, as in the server, and migrated before importing.")
+		flags.PrintDefaults()
+	}
+
+	flags.StringVar(&opts.careerID, "career", "", "id of the career to import the plan into")
+	flags.StringVar(&opts.file, "file", "", "path of the csv or yaml plan")
+	flags.StringVar(&opts.format, "format", "", "format of the plan, inferred from the file extension when empty")
+	flags.BoolVar(&opts.dryRun, "dry-run", false, "print the changes without applying them")
+
+	if err := flags.Parse(args); err != nil {
+		return options{}, err
+	}
+
+	if opts.careerID == "" || opts.file == "" {
+		flags.Usage()
+		return options{}, errors.New("career and file are required")
+	}
+
+	if opts.format == "" {
+		opts.format = strings.TrimPrefix(filepath.Ext(opts.file), ".")
+	}
+
+	return opts, nil
+}
+
+func readPlan(opts options) (service.CareerPlan, error) {
+	f, err := os.Open(opts.file)
+	if err != nil {
+		return service.CareerPlan{}, fmt.Errorf("could not open plan: %v", err)
+	}
+
+	defer f.Close()
+
+	return service.ParseCareerPlan(opts.format, f)
+}
+
+func importPlan(stg service.Storage, opts options, plan service.CareerPlan, w io.Writer) error {
+	response, err := service.NewService(stg).ImportCareerPlan(opts.careerID, plan, opts.dryRun)
+	if err != nil {
+		return err
+	}
+
+	return printChanges(w, response)
+}
+
+var actionSymbols = map[string]string{"create": "+", "update": "~", "delete": "-"}
+
+func printChanges(w io.Writer, response []byte) error {
+	var result struct {
+		DryRun  bool `json:"dry_run"`
+		Changes []struct {
+			Action   string `json:"action"`
+			Resource string `json:"resource"`
+			Subject  string `json:"subject"`
+			Name     string `json:"name"`
+			Detail   string `json:"detail"`
+		} `json:"changes"`
+		Summary struct {
+			Create int `json:"create"`
+			Update int `json:"update"`
+			Delete int `json:"delete"`
+		} `json:"summary"`
+	}
+
+	if err := json.Unmarshal(response, &result); err != nil {
+		return fmt.Errorf("could not read import result: %v", err)
+	}
+
+	for _, change := range result.Changes {
+		line := fmt.Sprintf("%s %s %s", actionSymbols[change.Action], change.Resource, change.Subject)
+		if change.Name != "" {
+			line += " / " + change.Name
+		}
+
+		if change.Detail != "" {
+			line += ": " + change.Detail
+		}
+
+		fmt.Fprintln(w, line)
+	}
+
+	status := "applied"
+	if result.DryRun {
+		status = "dry run, nothing applied"
+	}
+
+	fmt.Fprintf(w, "%d to create, %d to update, %d to delete (%s)\n", result.Summary.Create, result.Summary.Update, result.Summary.Delete, status)
+	return nil
+}
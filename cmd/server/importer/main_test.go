@@ -0,0 +1,188 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service"
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage/memory"
+)
+
+const yamlPlan = `subjects:
+  - name: Álgebra
+    type: Obligatoria
+  - name: Análisis I
+    correlatives:
+      - {subject: Álgebra, requirement: REGULARIZADA}
+`
+
+func TestParseOptions(t *testing.T) {
+	tt := []struct {
+		name     string
+		args     []string
+		expected options
+	}{
+		{
+			name:     "format from extension",
+			args:     []string{"-career", "1", "-file", "plans/sistemas.yaml"},
+			expected: options{careerID: "1", file: "plans/sistemas.yaml", format: "yaml"},
+		},
+		{
+			name:     "explicit format and dry run",
+			args:     []string{"-career", "1", "-file", "plan.txt", "-format", "csv", "-dry-run"},
+			expected: options{careerID: "1", file: "plan.txt", format: "csv", dryRun: true},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// Given
+			var output bytes.Buffer
+
+			// When
+			opts, err := parseOptions(tc.args, &output)
+
+			// Then
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, opts)
+			require.Empty(t, output.String())
+		})
+	}
+}
+
+func TestParseOptions_Error(t *testing.T) {
+	tt := []struct {
+		name          string
+		args          []string
+		expectedError string
+	}{
+		{
+			name:          "missing career",
+			args:          []string{"-file", "plan.yaml"},
+			expectedError: "career and file are required",
+		},
+		{
+			name:          "missing file",
+			args:          []string{"-career", "1"},
+			expectedError: "career and file are required",
+		},
+		{
+			name:          "unknown flag",
+			args:          []string{"-career", "1", "-file", "plan.yaml", "-force"},
+			expectedError: "flag provided but not defined: -force",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// Given
+			var output bytes.Buffer
+
+			// When
+			_, err := parseOptions(tc.args, &output)
+
+			// Then
+			require.EqualError(t, err, tc.expectedError)
+			require.Contains(t, output.String(), "Usage of importer")
+		})
+	}
+}
+
+func TestReadPlan(t *testing.T) {
+	// Given
+	file := filepath.Join(t.TempDir(), "plan.yaml")
+	require.NoError(t, os.WriteFile(file, []byte(yamlPlan), 0o600))
+
+	// When
+	plan, err := readPlan(options{file: file, format: "yaml"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Len(t, plan.Subjects, 2)
+	require.Equal(t, "Álgebra", plan.Subjects[0].Name)
+	require.Equal(t, "Álgebra", plan.Subjects[1].Correlatives[0].Subject)
+}
+
+func TestReadPlan_Error(t *testing.T) {
+	// Given
+	file := filepath.Join(t.TempDir(), "plan.yaml")
+	require.NoError(t, os.WriteFile(file, []byte(yamlPlan), 0o600))
+
+	// When
+	_, missingErr := readPlan(options{file: filepath.Join(t.TempDir(), "missing.yaml"), format: "yaml"})
+	_, formatErr := readPlan(options{file: file, format: "xml"})
+
+	// Then
+	require.Contains(t, missingErr.Error(), "could not open plan")
+	require.EqualError(t, formatErr, "service: invalid career plan [format: xml]: format must be csv or yaml")
+}
+
+func TestImportPlan_MemoryStorage(t *testing.T) {
+	// Given
+	storage_ := memory.NewStorage()
+	facultyID, _ := storage_.CreateFaculty(storage.FacultyRequest{Name: "Exactas"})
+	careerID, _ := storage_.CreateCareer(storage.CareerRequest{FacultyID: facultyID, Name: "Sistemas"})
+
+	file := filepath.Join(t.TempDir(), "plan.yaml")
+	require.NoError(t, os.WriteFile(file, []byte(yamlPlan), 0o600))
+
+	opts := options{careerID: strconv.Itoa(careerID), file: file, format: "yaml", dryRun: true}
+	plan, err := readPlan(opts)
+	require.NoError(t, err)
+
+	// When
+	var dryRun bytes.Buffer
+	require.NoError(t, importPlan(storage_, opts, plan, &dryRun))
+
+	stored, err := storage_.GetCareerPlan(opts.careerID)
+	require.NoError(t, err)
+
+	opts.dryRun = false
+	var applied bytes.Buffer
+	require.NoError(t, importPlan(storage_, opts, plan, &applied))
+
+	// Then
+	require.Equal(t, `+ subject Álgebra: type: Obligatoria
++ subject Análisis I
++ correlative Análisis I / Álgebra: REGULARIZADA
+3 to create, 0 to update, 0 to delete (dry run, nothing applied)
+`, dryRun.String())
+	require.Empty(t, stored.Subjects)
+	require.Contains(t, applied.String(), "3 to create, 0 to update, 0 to delete (applied)\n")
+
+	stored, err = storage_.GetCareerPlan(opts.careerID)
+	require.NoError(t, err)
+	require.Len(t, stored.Subjects, 2)
+}
+
+func TestImportPlan_CareerNotFoundError(t *testing.T) {
+	// Given
+	var output bytes.Buffer
+
+	// When
+	err := importPlan(memory.NewStorage(), options{careerID: "99"}, service.CareerPlan{}, &output)
+
+	// Then
+	require.EqualError(t, err, "could not find career [career_id: 99]: service: resource not found")
+	require.Empty(t, output.String())
+}
+
+func TestPrintChanges_Error(t *testing.T) {
+	// Given
+	var output bytes.Buffer
+
+	// When
+	err := printChanges(&output, []byte(`not json`))
+
+	// Then
+	require.Contains(t, err.Error(), "could not read import result")
+	require.Empty(t, output.String())
+}
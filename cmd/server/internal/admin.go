@@ -0,0 +1,555 @@
+package internal
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service"
+	"github.com/mateoferrari97/Kit/web/server"
+)
+
+type facultyInformation struct {
+	Name string `json:"name" validate:"required,max=50"`
+	URI  string `json:"uri" validate:"omitempty,url,max=128"`
+}
+
+type careerInformation struct {
+	FacultyID int    `json:"faculty_id" validate:"required,min=1"`
+	Name      string `json:"name" validate:"required,max=50"`
+	URI       string `json:"uri" validate:"omitempty,url,max=128"`
+}
+
+type subjectInformation struct {
+	Name string `json:"name" validate:"required,max=50"`
+	URI  string `json:"uri" validate:"omitempty,url,max=128"`
+	Meet string `json:"meet" validate:"omitempty,url,max=128"`
+}
+
+type careerSubjectInformation struct {
+	Hours  *int   `json:"hours" validate:"omitempty,min=0"`
+	Type   string `json:"type" validate:"omitempty,max=64"`
+	Points *int   `json:"points" validate:"omitempty,min=0"`
+}
+
+type professorshipInformation struct {
+	Name string `json:"name" validate:"required,max=50"`
+	Term string `json:"term"`
+}
+
+type scheduleInformation struct {
+	Day   string `json:"day" validate:"required,oneof=Lunes Martes Miércoles Jueves Viernes Sábado Domingo"`
+	Start string `json:"start" validate:"required"`
+	End   string `json:"end" validate:"required"`
+}
+
+type termInformation struct {
+	Year         int    `json:"year" validate:"required"`
+	Cuatrimestre int    `json:"cuatrimestre" validate:"required,min=1,max=2"`
+	Start        string `json:"start" validate:"required"`
+	End          string `json:"end" validate:"required"`
+}
+
+func catalogError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrNotFound):
+		return server.NewError(err.Error(), http.StatusNotFound)
+	case errors.Is(err, service.ErrResourceAlreadyExist), errors.Is(err, service.ErrResourceInUse):
+		return server.NewError(err.Error(), http.StatusConflict)
+	case errors.Is(err, service.ErrInvalidTerm), errors.Is(err, service.ErrInvalidSchedule), errors.Is(err, service.ErrInvalidEquivalence),
+		errors.Is(err, service.ErrInvalidPolicy):
+		return server.NewError(err.Error(), http.StatusBadRequest)
+	default:
+		return err
+	}
+}
+
+func (h *Handler) CreateFaculty() {
+	wrapH := func(w http.ResponseWriter, r *http.Request) error {
+		var faculty facultyInformation
+		if err := decodeAndValidate(r, &faculty); err != nil {
+			return err
+		}
+
+		response, err := h.service.CreateFaculty(service.FacultyRequest{Name: faculty.Name, URI: faculty.URI})
+		if err != nil {
+			return catalogError(err)
+		}
+
+		return server.RespondJSON(w, response, http.StatusCreated)
+	}
+
+	h.wrapAdmin(http.MethodPost, "/faculties", wrapH)
+}
+
+func (h *Handler) UpdateFaculty() {
+	wrapH := func(w http.ResponseWriter, r *http.Request) error {
+		facultyID, err := requiredParam(r, "facultyID", "faculty id")
+		if err != nil {
+			return err
+		}
+
+		var faculty facultyInformation
+		if err := decodeAndValidate(r, &faculty); err != nil {
+			return err
+		}
+
+		if err := h.service.UpdateFaculty(facultyID, service.FacultyRequest{Name: faculty.Name, URI: faculty.URI}); err != nil {
+			return catalogError(err)
+		}
+
+		return server.RespondJSON(w, nil, http.StatusOK)
+	}
+
+	h.wrapAdmin(http.MethodPut, "/faculties/{facultyID}", wrapH)
+}
+
+func (h *Handler) DeleteFaculty() {
+	wrapH := func(w http.ResponseWriter, r *http.Request) error {
+		facultyID, err := requiredParam(r, "facultyID", "faculty id")
+		if err != nil {
+			return err
+		}
+
+		if err := h.service.DeleteFaculty(facultyID); err != nil {
+			return catalogError(err)
+		}
+
+		return server.RespondJSON(w, nil, http.StatusNoContent)
+	}
+
+	h.wrapAdmin(http.MethodDelete, "/faculties/{facultyID}", wrapH)
+}
+
+func (h *Handler) CreateCareer() {
+	wrapH := func(w http.ResponseWriter, r *http.Request) error {
+		var career careerInformation
+		if err := decodeAndValidate(r, &career); err != nil {
+			return err
+		}
+
+		response, err := h.service.CreateCareer(service.CareerRequest{
+			FacultyID: career.FacultyID,
+			Name:      career.Name,
+			URI:       career.URI,
+		})
+
+		if err != nil {
+			return catalogError(err)
+		}
+
+		return server.RespondJSON(w, response, http.StatusCreated)
+	}
+
+	h.wrapAdmin(http.MethodPost, "/careers", wrapH)
+}
+
+func (h *Handler) UpdateCareer() {
+	wrapH := func(w http.ResponseWriter, r *http.Request) error {
+		careerID, err := requiredParam(r, "careerID", "career id")
+		if err != nil {
+			return err
+		}
+
+		var career careerInformation
+		if err := decodeAndValidate(r, &career); err != nil {
+			return err
+		}
+
+		if err := h.service.UpdateCareer(careerID, service.CareerRequest{
+			FacultyID: career.FacultyID,
+			Name:      career.Name,
+			URI:       career.URI,
+		}); err != nil {
+			return catalogError(err)
+		}
+
+		return server.RespondJSON(w, nil, http.StatusOK)
+	}
+
+	h.wrapAdmin(http.MethodPut, "/careers/{careerID}", wrapH)
+}
+
+func (h *Handler) DeleteCareer() {
+	wrapH := func(w http.ResponseWriter, r *http.Request) error {
+		careerID, err := requiredParam(r, "careerID", "career id")
+		if err != nil {
+			return err
+		}
+
+		if err := h.service.DeleteCareer(careerID); err != nil {
+			return catalogError(err)
+		}
+
+		return server.RespondJSON(w, nil, http.StatusNoContent)
+	}
+
+	h.wrapAdmin(http.MethodDelete, "/careers/{careerID}", wrapH)
+}
+
+func (h *Handler) CreateSubject() {
+	wrapH := func(w http.ResponseWriter, r *http.Request) error {
+		var subject subjectInformation
+		if err := decodeAndValidate(r, &subject); err != nil {
+			return err
+		}
+
+		response, err := h.service.CreateSubject(service.SubjectRequest{
+			Name: subject.Name,
+			URI:  subject.URI,
+			Meet: subject.Meet,
+		})
+
+		if err != nil {
+			return catalogError(err)
+		}
+
+		return server.RespondJSON(w, response, http.StatusCreated)
+	}
+
+	h.wrapAdmin(http.MethodPost, "/subjects", wrapH)
+}
+
+func (h *Handler) UpdateSubject() {
+	wrapH := func(w http.ResponseWriter, r *http.Request) error {
+		subjectID, err := requiredParam(r, "subjectID", "subject id")
+		if err != nil {
+			return err
+		}
+
+		var subject subjectInformation
+		if err := decodeAndValidate(r, &subject); err != nil {
+			return err
+		}
+
+		if err := h.service.UpdateSubject(subjectID, service.SubjectRequest{
+			Name: subject.Name,
+			URI:  subject.URI,
+			Meet: subject.Meet,
+		}); err != nil {
+			return catalogError(err)
+		}
+
+		return server.RespondJSON(w, nil, http.StatusOK)
+	}
+
+	h.wrapAdmin(http.MethodPut, "/subjects/{subjectID}", wrapH)
+}
+
+func (h *Handler) DeleteSubject() {
+	wrapH := func(w http.ResponseWriter, r *http.Request) error {
+		subjectID, err := requiredParam(r, "subjectID", "subject id")
+		if err != nil {
+			return err
+		}
+
+		if err := h.service.DeleteSubject(subjectID); err != nil {
+			return catalogError(err)
+		}
+
+		return server.RespondJSON(w, nil, http.StatusNoContent)
+	}
+
+	h.wrapAdmin(http.MethodDelete, "/subjects/{subjectID}", wrapH)
+}
+
+func (h *Handler) CreateCareerSubject() {
+	wrapH := func(w http.ResponseWriter, r *http.Request) error {
+		careerID, err := requiredParam(r, "careerID", "career id")
+		if err != nil {
+			return err
+		}
+
+		var careerSubject struct {
+			SubjectID int `json:"subject_id" validate:"required,min=1"`
+			careerSubjectInformation
+		}
+
+		if err := decodeAndValidate(r, &careerSubject); err != nil {
+			return err
+		}
+
+		response, err := h.service.CreateCareerSubject(service.CareerSubjectRequest{
+			CareerID:  careerID,
+			SubjectID: strconv.Itoa(careerSubject.SubjectID),
+			Hours:     careerSubject.Hours,
+			Type:      careerSubject.Type,
+			Points:    careerSubject.Points,
+		})
+
+		if err != nil {
+			return catalogError(err)
+		}
+
+		return server.RespondJSON(w, response, http.StatusCreated)
+	}
+
+	h.wrapAdmin(http.MethodPost, "/careers/{careerID}/subjects", wrapH)
+}
+
+func (h *Handler) UpdateCareerSubject() {
+	wrapH := func(w http.ResponseWriter, r *http.Request) error {
+		careerID, err := requiredParam(r, "careerID", "career id")
+		if err != nil {
+			return err
+		}
+
+		subjectID, err := requiredParam(r, "subjectID", "subject id")
+		if err != nil {
+			return err
+		}
+
+		var careerSubject careerSubjectInformation
+		if err := decodeAndValidate(r, &careerSubject); err != nil {
+			return err
+		}
+
+		if err := h.service.UpdateCareerSubject(service.CareerSubjectRequest{
+			CareerID:  careerID,
+			SubjectID: subjectID,
+			Hours:     careerSubject.Hours,
+			Type:      careerSubject.Type,
+			Points:    careerSubject.Points,
+		}); err != nil {
+			return catalogError(err)
+		}
+
+		return server.RespondJSON(w, nil, http.StatusOK)
+	}
+
+	h.wrapAdmin(http.MethodPut, "/careers/{careerID}/subjects/{subjectID}", wrapH)
+}
+
+func (h *Handler) DeleteCareerSubject() {
+	wrapH := func(w http.ResponseWriter, r *http.Request) error {
+		careerID, err := requiredParam(r, "careerID", "career id")
+		if err != nil {
+			return err
+		}
+
+		subjectID, err := requiredParam(r, "subjectID", "subject id")
+		if err != nil {
+			return err
+		}
+
+		if err := h.service.DeleteCareerSubject(careerID, subjectID); err != nil {
+			return catalogError(err)
+		}
+
+		return server.RespondJSON(w, nil, http.StatusNoContent)
+	}
+
+	h.wrapAdmin(http.MethodDelete, "/careers/{careerID}/subjects/{subjectID}", wrapH)
+}
+
+func (h *Handler) CreateProfessorship() {
+	wrapH := func(w http.ResponseWriter, r *http.Request) error {
+		careerID, err := requiredParam(r, "careerID", "career id")
+		if err != nil {
+			return err
+		}
+
+		subjectID, err := requiredParam(r, "subjectID", "subject id")
+		if err != nil {
+			return err
+		}
+
+		var professorship professorshipInformation
+		if err := decodeAndValidate(r, &professorship); err != nil {
+			return err
+		}
+
+		response, err := h.service.CreateProfessorship(service.ProfessorshipRequest{
+			CareerID:  careerID,
+			SubjectID: subjectID,
+			Name:      professorship.Name,
+			Term:      professorship.Term,
+		})
+
+		if err != nil {
+			return catalogError(err)
+		}
+
+		return server.RespondJSON(w, response, http.StatusCreated)
+	}
+
+	h.wrapAdmin(http.MethodPost, "/careers/{careerID}/subjects/{subjectID}/professorships", wrapH)
+}
+
+func (h *Handler) UpdateProfessorship() {
+	wrapH := func(w http.ResponseWriter, r *http.Request) error {
+		professorshipID, err := requiredParam(r, "professorshipID", "professorship id")
+		if err != nil {
+			return err
+		}
+
+		var professorship professorshipInformation
+		if err := decodeAndValidate(r, &professorship); err != nil {
+			return err
+		}
+
+		if err := h.service.UpdateProfessorship(service.ProfessorshipRequest{
+			ProfessorshipID: professorshipID,
+			Name:            professorship.Name,
+			Term:            professorship.Term,
+		}); err != nil {
+			return catalogError(err)
+		}
+
+		return server.RespondJSON(w, nil, http.StatusOK)
+	}
+
+	h.wrapAdmin(http.MethodPut, "/professorships/{professorshipID}", wrapH)
+}
+
+func (h *Handler) DeleteProfessorship() {
+	wrapH := func(w http.ResponseWriter, r *http.Request) error {
+		professorshipID, err := requiredParam(r, "professorshipID", "professorship id")
+		if err != nil {
+			return err
+		}
+
+		if err := h.service.DeleteProfessorship(professorshipID); err != nil {
+			return catalogError(err)
+		}
+
+		return server.RespondJSON(w, nil, http.StatusNoContent)
+	}
+
+	h.wrapAdmin(http.MethodDelete, "/professorships/{professorshipID}", wrapH)
+}
+
+func (h *Handler) CreateSchedule() {
+	wrapH := func(w http.ResponseWriter, r *http.Request) error {
+		professorshipID, err := requiredParam(r, "professorshipID", "professorship id")
+		if err != nil {
+			return err
+		}
+
+		var schedule scheduleInformation
+		if err := decodeAndValidate(r, &schedule); err != nil {
+			return err
+		}
+
+		response, err := h.service.CreateSchedule(service.ScheduleRequest{
+			ProfessorshipID: professorshipID,
+			Day:             schedule.Day,
+			Start:           schedule.Start,
+			End:             schedule.End,
+		})
+
+		if err != nil {
+			return catalogError(err)
+		}
+
+		return server.RespondJSON(w, response, http.StatusCreated)
+	}
+
+	h.wrapAdmin(http.MethodPost, "/professorships/{professorshipID}/schedules", wrapH)
+}
+
+func (h *Handler) UpdateSchedule() {
+	wrapH := func(w http.ResponseWriter, r *http.Request) error {
+		professorshipID, err := requiredParam(r, "professorshipID", "professorship id")
+		if err != nil {
+			return err
+		}
+
+		scheduleID, err := requiredParam(r, "scheduleID", "schedule id")
+		if err != nil {
+			return err
+		}
+
+		var schedule scheduleInformation
+		if err := decodeAndValidate(r, &schedule); err != nil {
+			return err
+		}
+
+		if err := h.service.UpdateSchedule(service.ScheduleRequest{
+			ProfessorshipID: professorshipID,
+			ScheduleID:      scheduleID,
+			Day:             schedule.Day,
+			Start:           schedule.Start,
+			End:             schedule.End,
+		}); err != nil {
+			return catalogError(err)
+		}
+
+		return server.RespondJSON(w, nil, http.StatusOK)
+	}
+
+	h.wrapAdmin(http.MethodPut, "/professorships/{professorshipID}/schedules/{scheduleID}", wrapH)
+}
+
+func (h *Handler) DeleteSchedule() {
+	wrapH := func(w http.ResponseWriter, r *http.Request) error {
+		professorshipID, err := requiredParam(r, "professorshipID", "professorship id")
+		if err != nil {
+			return err
+		}
+
+		scheduleID, err := requiredParam(r, "scheduleID", "schedule id")
+		if err != nil {
+			return err
+		}
+
+		if err := h.service.DeleteSchedule(professorshipID, scheduleID); err != nil {
+			return catalogError(err)
+		}
+
+		return server.RespondJSON(w, nil, http.StatusNoContent)
+	}
+
+	h.wrapAdmin(http.MethodDelete, "/professorships/{professorshipID}/schedules/{scheduleID}", wrapH)
+}
+
+func (h *Handler) CreateTerm() {
+	wrapH := func(w http.ResponseWriter, r *http.Request) error {
+		var term termInformation
+		if err := decodeAndValidate(r, &term); err != nil {
+			return err
+		}
+
+		response, err := h.service.CreateTerm(service.TermRequest{
+			Year:         term.Year,
+			Cuatrimestre: term.Cuatrimestre,
+			Start:        term.Start,
+			End:          term.End,
+		})
+
+		if err != nil {
+			return catalogError(err)
+		}
+
+		return server.RespondJSON(w, response, http.StatusCreated)
+	}
+
+	h.wrapAdmin(http.MethodPost, "/terms", wrapH)
+}
+
+func (h *Handler) UpdateTerm() {
+	wrapH := func(w http.ResponseWriter, r *http.Request) error {
+		termID, err := requiredParam(r, "termID", "term id")
+		if err != nil {
+			return err
+		}
+
+		var term termInformation
+		if err := decodeAndValidate(r, &term); err != nil {
+			return err
+		}
+
+		if err := h.service.UpdateTerm(termID, service.TermRequest{
+			Year:         term.Year,
+			Cuatrimestre: term.Cuatrimestre,
+			Start:        term.Start,
+			End:          term.End,
+		}); err != nil {
+			return catalogError(err)
+		}
+
+		return server.RespondJSON(w, nil, http.StatusOK)
+	}
+
+	h.wrapAdmin(http.MethodPut, "/terms/{termID}", wrapH)
+}
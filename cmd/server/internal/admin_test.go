@@ -0,0 +1,229 @@
+package internal
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service"
+	"github.com/mateoferrari97/Kit/web/server"
+)
+
+func newAdminServer(service_ *serviceMock) (*server.Server, *Handler) {
+	service_.On("Authenticate", "admin").Return(service.Identity{StudentID: 99, StudentEmail: "admin@gmail.com", Role: service.RoleAdmin}, nil)
+	service_.On("Authenticate", "student").Return(service.Identity{StudentID: 1, StudentEmail: "example@gmail.com", Role: service.RoleStudent}, nil)
+
+	sv := server.NewServer()
+	return sv, NewHandler(sv, service_)
+}
+
+func serveAdmin(sv *server.Server, method, path, token, body string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(method, path, bytes.NewReader([]byte(body)))
+	r.Header.Set("Authorization", "Bearer "+token)
+
+	sv.Router.ServeHTTP(w, r)
+	return w
+}
+
+func TestHandler_CreateFaculty(t *testing.T) {
+	// Given
+	service_ := serviceMock{}
+	service_.On("CreateFaculty", service.FacultyRequest{Name: "Exactas", URI: "https://exactas.unlp.edu.ar"}).Return([]byte(`{"id":1}`), nil)
+
+	sv, h := newAdminServer(&service_)
+	h.CreateFaculty()
+
+	// When
+	w := serveAdmin(sv, http.MethodPost, "/admin/faculties", "admin", `{"name":"Exactas","uri":"https://exactas.unlp.edu.ar"}`)
+
+	// Then
+	require.Equal(t, http.StatusCreated, w.Code)
+	require.Equal(t, `{"id":1}`, w.Body.String())
+}
+
+func TestHandler_CreateFaculty_ForbiddenError(t *testing.T) {
+	// Given
+	service_ := serviceMock{}
+
+	sv, h := newAdminServer(&service_)
+	h.CreateFaculty()
+
+	// When
+	w := serveAdmin(sv, http.MethodPost, "/admin/faculties", "student", `{"name":"Exactas"}`)
+
+	// Then
+	require.Equal(t, http.StatusForbidden, w.Code)
+	service_.AssertNotCalled(t, "CreateFaculty")
+}
+
+func TestHandler_CreateCareer_BodyValidationError(t *testing.T) {
+	// Given
+	service_ := serviceMock{}
+
+	sv, h := newAdminServer(&service_)
+	h.CreateCareer()
+
+	// When
+	w := serveAdmin(sv, http.MethodPost, "/admin/careers", "admin", `{"name":"Sistemas","uri":"not an url"}`)
+
+	// Then
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestHandler_UpdateSubject(t *testing.T) {
+	// Given
+	service_ := serviceMock{}
+	service_.On("UpdateSubject", "2", service.SubjectRequest{Name: "Análisis I", Meet: "https://meet.google.com/abc"}).Return(nil)
+
+	sv, h := newAdminServer(&service_)
+	h.UpdateSubject()
+
+	// When
+	w := serveAdmin(sv, http.MethodPut, "/admin/subjects/2", "admin", `{"name":"Análisis I","meet":"https://meet.google.com/abc"}`)
+
+	// Then
+	require.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestHandler_CreateCareerSubject(t *testing.T) {
+	// Given
+	hours := 96
+
+	service_ := serviceMock{}
+	service_.On("CreateCareerSubject", service.CareerSubjectRequest{
+		CareerID:  "1",
+		SubjectID: "2",
+		Hours:     &hours,
+		Type:      "Obligatoria",
+	}).Return([]byte(`{"id":7}`), nil)
+
+	sv, h := newAdminServer(&service_)
+	h.CreateCareerSubject()
+
+	// When
+	w := serveAdmin(sv, http.MethodPost, "/admin/careers/1/subjects", "admin", `{"subject_id":2,"hours":96,"type":"Obligatoria"}`)
+
+	// Then
+	require.Equal(t, http.StatusCreated, w.Code)
+	require.Equal(t, `{"id":7}`, w.Body.String())
+}
+
+func TestHandler_DeleteCareerSubject_InUseError(t *testing.T) {
+	// Given
+	service_ := serviceMock{}
+	service_.On("DeleteCareerSubject", "1", "2").Return(service.ErrResourceInUse)
+
+	sv, h := newAdminServer(&service_)
+	h.DeleteCareerSubject()
+
+	// When
+	w := serveAdmin(sv, http.MethodDelete, "/admin/careers/1/subjects/2", "admin", "")
+
+	// Then
+	require.Equal(t, http.StatusConflict, w.Code)
+}
+
+func TestHandler_CreateProfessorship_InvalidTermError(t *testing.T) {
+	// Given
+	service_ := serviceMock{}
+	service_.On("CreateProfessorship", service.ProfessorshipRequest{
+		CareerID:  "1",
+		SubjectID: "2",
+		Name:      "Cátedra A",
+		Term:      "2021",
+	}).Return([]byte{}, service.ErrInvalidTerm)
+
+	sv, h := newAdminServer(&service_)
+	h.CreateProfessorship()
+
+	// When
+	w := serveAdmin(sv, http.MethodPost, "/admin/careers/1/subjects/2/professorships", "admin", `{"name":"Cátedra A","term":"2021"}`)
+
+	// Then
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestHandler_DeleteProfessorship_NotFoundError(t *testing.T) {
+	// Given
+	service_ := serviceMock{}
+	service_.On("DeleteProfessorship", "9").Return(service.ErrNotFound)
+
+	sv, h := newAdminServer(&service_)
+	h.DeleteProfessorship()
+
+	// When
+	w := serveAdmin(sv, http.MethodDelete, "/admin/professorships/9", "admin", "")
+
+	// Then
+	require.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestHandler_CreateSchedule_BodyValidationError(t *testing.T) {
+	// Given
+	service_ := serviceMock{}
+
+	sv, h := newAdminServer(&service_)
+	h.CreateSchedule()
+
+	// When
+	w := serveAdmin(sv, http.MethodPost, "/admin/professorships/9/schedules", "admin", `{"day":"Monday","start":"08:00","end":"10:00"}`)
+
+	// Then
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestHandler_UpdateSchedule(t *testing.T) {
+	// Given
+	service_ := serviceMock{}
+	service_.On("UpdateSchedule", service.ScheduleRequest{
+		ProfessorshipID: "9",
+		ScheduleID:      "3",
+		Day:             "Miércoles",
+		Start:           "08:00",
+		End:             "10:00",
+	}).Return(nil)
+
+	sv, h := newAdminServer(&service_)
+	h.UpdateSchedule()
+
+	// When
+	w := serveAdmin(sv, http.MethodPut, "/admin/professorships/9/schedules/3", "admin", `{"day":"Miércoles","start":"08:00","end":"10:00"}`)
+
+	// Then
+	require.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestHandler_CreateTerm(t *testing.T) {
+	// Given
+	service_ := serviceMock{}
+	service_.On("CreateTerm", service.TermRequest{Year: 2021, Cuatrimestre: 1, Start: "2021-03-15", End: "2021-07-10"}).Return([]byte(`{"id":3}`), nil)
+
+	sv, h := newAdminServer(&service_)
+	h.CreateTerm()
+
+	// When
+	w := serveAdmin(sv, http.MethodPost, "/admin/terms", "admin", `{"year":2021,"cuatrimestre":1,"start":"2021-03-15","end":"2021-07-10"}`)
+
+	// Then
+	require.Equal(t, http.StatusCreated, w.Code)
+	require.Equal(t, `{"id":3}`, w.Body.String())
+}
+
+func TestHandler_UpdateTerm_AlreadyExistError(t *testing.T) {
+	// Given
+	service_ := serviceMock{}
+	service_.On("UpdateTerm", "3", service.TermRequest{Year: 2021, Cuatrimestre: 1, Start: "2021-03-15", End: "2021-07-10"}).Return(service.ErrResourceAlreadyExist)
+
+	sv, h := newAdminServer(&service_)
+	h.UpdateTerm()
+
+	// When
+	w := serveAdmin(sv, http.MethodPut, "/admin/terms/3", "admin", `{"year":2021,"cuatrimestre":1,"start":"2021-03-15","end":"2021-07-10"}`)
+
+	// Then
+	require.Equal(t, http.StatusConflict, w.Code)
+}
@@ -0,0 +1,111 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service"
+	"github.com/mateoferrari97/Kit/web/server"
+)
+
+type contextKey string
+
+const identityKey contextKey = "identity"
+
+func identityFromRequest(r *http.Request) (service.Identity, bool) {
+	identity, ok := r.Context().Value(identityKey).(service.Identity)
+	return identity, ok
+}
+
+func (h *Handler) Login() {
+	wrapH := func(w http.ResponseWriter, r *http.Request) error {
+		var credentials struct {
+			StudentEmail string `json:"student_email" validate:"required"`
+			Password     string `json:"password" validate:"required"`
+		}
+
+		if err := decodeAndValidate(r, &credentials); err != nil {
+			return err
+		}
+
+		b, err := h.service.Login(credentials.StudentEmail, credentials.Password)
+		if err != nil {
+			if errors.Is(err, service.ErrInvalidCredentials) {
+				return server.NewError(err.Error(), http.StatusUnauthorized)
+			}
+
+			return err
+		}
+
+		return server.RespondJSON(w, b, http.StatusOK)
+	}
+
+	h.wrapper.Wrap(http.MethodPost, "/login", wrapH)
+}
+
+// wrapStudent registers a student route twice: under /students/{studentEmail}, only reachable by the student the
+// token was issued for and by admins, and under /me, where the student email is resolved from the token.
+func (h *Handler) wrapStudent(method, pattern string, f server.HandlerFunc) {
+	h.wrapper.Wrap(method, "/students/{studentEmail}"+pattern, f, h.authenticate)
+	h.wrapper.Wrap(method, "/me"+pattern, f, h.authenticate)
+}
+
+func (h *Handler) wrapAdmin(method, pattern string, f server.HandlerFunc) {
+	h.wrapper.Wrap(method, "/admin"+pattern, f, h.authenticate, requireAdmin)
+}
+
+func (h *Handler) authenticate(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if token == "" || token == r.Header.Get("Authorization") {
+			respondError(w, server.NewError("bearer token is required", http.StatusUnauthorized))
+			return
+		}
+
+		identity, err := h.service.Authenticate(token)
+		if err != nil {
+			if errors.Is(err, service.ErrUnauthorized) {
+				respondError(w, server.NewError(err.Error(), http.StatusUnauthorized))
+				return
+			}
+
+			respondError(w, server.NewError(err.Error(), http.StatusInternalServerError))
+			return
+		}
+
+		vars := map[string]string{}
+		for k, v := range mux.Vars(r) {
+			vars[k] = v
+		}
+
+		if requested, exist := vars["studentEmail"]; !exist {
+			vars["studentEmail"] = identity.StudentEmail
+		} else if requested != identity.StudentEmail && !identity.IsAdmin() {
+			respondError(w, server.NewError("token does not belong to student", http.StatusForbidden))
+			return
+		}
+
+		r = mux.SetURLVars(r, vars)
+		next(w, r.WithContext(context.WithValue(r.Context(), identityKey, identity)))
+	}
+}
+
+func requireAdmin(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		identity, ok := identityFromRequest(r)
+		if !ok || !identity.IsAdmin() {
+			respondError(w, server.NewError("admin role is required", http.StatusForbidden))
+			return
+		}
+
+		next(w, r)
+	}
+}
+
+func respondError(w http.ResponseWriter, err *server.Error) {
+	_ = server.RespondJSON(w, err, err.StatusCode)
+}
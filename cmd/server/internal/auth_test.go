@@ -0,0 +1,179 @@
+package internal
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service"
+	"github.com/mateoferrari97/Kit/web/server"
+)
+
+func TestHandler_Login(t *testing.T) {
+	// Given
+	wrapper := wrapperMock{}
+	service_ := serviceMock{}
+	service_.On("Login", "example@gmail.com", "secret-password").Return([]byte(`{"token":"token"}`), nil)
+
+	h := NewHandler(&wrapper, &service_)
+	h.Login()
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest("POST", "whocares", bytes.NewReader([]byte(`{"student_email":"example@gmail.com","password":"secret-password"}`)))
+
+	// When
+	err := wrapper.f(w, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, `{"token":"token"}`, w.Body.String())
+}
+
+func TestHandler_Login_BodyValidationError(t *testing.T) {
+	// Given
+	wrapper := wrapperMock{}
+
+	h := NewHandler(&wrapper, nil)
+	h.Login()
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest("POST", "whocares", bytes.NewReader([]byte(`{"student_email":"example@gmail.com"}`)))
+
+	// When
+	err := wrapper.f(w, r)
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "400 bad_request: Key: 'Password' Error:Field validation for 'Password' failed on the 'required' tag")
+}
+
+func TestHandler_Login_InvalidCredentialsError(t *testing.T) {
+	// Given
+	wrapper := wrapperMock{}
+	service_ := serviceMock{}
+	service_.On("Login", "example@gmail.com", "secret-password").Return([]byte{}, service.ErrInvalidCredentials)
+
+	h := NewHandler(&wrapper, &service_)
+	h.Login()
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest("POST", "whocares", bytes.NewReader([]byte(`{"student_email":"example@gmail.com","password":"secret-password"}`)))
+
+	// When
+	err := wrapper.f(w, r)
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	hErr := err.(*server.Error)
+	require.Equal(t, http.StatusUnauthorized, hErr.StatusCode)
+}
+
+func TestHandler_StudentRoutes(t *testing.T) {
+	tt := []struct {
+		name          string
+		path          string
+		authorization string
+		expectedCode  int
+	}{
+		{
+			name:          "me route resolves student from token",
+			path:          "/me/careers/1/subjects",
+			authorization: "Bearer token",
+			expectedCode:  http.StatusOK,
+		},
+		{
+			name:          "student route of token owner",
+			path:          "/students/example@gmail.com/careers/1/subjects",
+			authorization: "Bearer token",
+			expectedCode:  http.StatusOK,
+		},
+		{
+			name:          "student route of another student",
+			path:          "/students/another@gmail.com/careers/1/subjects",
+			authorization: "Bearer token",
+			expectedCode:  http.StatusForbidden,
+		},
+		{
+			name:          "student route accessed by admin",
+			path:          "/students/another@gmail.com/careers/1/subjects",
+			authorization: "Bearer admin",
+			expectedCode:  http.StatusOK,
+		},
+		{
+			name:         "missing token",
+			path:         "/me/careers/1/subjects",
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name:          "not a bearer token",
+			path:          "/me/careers/1/subjects",
+			authorization: "Basic token",
+			expectedCode:  http.StatusUnauthorized,
+		},
+		{
+			name:          "invalid token",
+			path:          "/me/careers/1/subjects",
+			authorization: "Bearer invalid",
+			expectedCode:  http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// Given
+			service_ := serviceMock{}
+			service_.On("Authenticate", "token").Return(service.Identity{StudentID: 1, StudentEmail: "example@gmail.com", Role: service.RoleStudent}, nil)
+			service_.On("Authenticate", "admin").Return(service.Identity{StudentID: 99, StudentEmail: "admin@gmail.com", Role: service.RoleAdmin}, nil)
+			service_.On("Authenticate", "invalid").Return(service.Identity{}, service.ErrUnauthorized)
+			service_.On("GetStudentSubjects", "example@gmail.com", "1").Return([]byte(`[]`), nil)
+			service_.On("GetStudentSubjects", "another@gmail.com", "1").Return([]byte(`[]`), nil)
+
+			sv := server.NewServer()
+			h := NewHandler(sv, &service_)
+			h.GetStudentSubjects()
+
+			w := httptest.NewRecorder()
+			r, _ := http.NewRequest("GET", tc.path, nil)
+			if tc.authorization != "" {
+				r.Header.Set("Authorization", tc.authorization)
+			}
+
+			// When
+			sv.Router.ServeHTTP(w, r)
+
+			// Then
+			require.Equal(t, tc.expectedCode, w.Code)
+		})
+	}
+}
+
+func TestHandler_StudentRoutes_AuthenticateError(t *testing.T) {
+	// Given
+	service_ := serviceMock{}
+	service_.On("Authenticate", "token").Return(service.Identity{}, errors.New("error"))
+
+	sv := server.NewServer()
+	h := NewHandler(sv, &service_)
+	h.GetStudentSubjects()
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest("GET", "/me/careers/1/subjects", nil)
+	r.Header.Set("Authorization", "Bearer token")
+
+	// When
+	sv.Router.ServeHTTP(w, r)
+
+	// Then
+	require.Equal(t, http.StatusInternalServerError, w.Code)
+}
@@ -0,0 +1,39 @@
+package internal
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service"
+	"github.com/mateoferrari97/Kit/web/server"
+)
+
+func (h *Handler) GetAvailableSubjects() {
+	wrapH := func(w http.ResponseWriter, r *http.Request) error {
+		studentEmail, err := requiredParam(r, "studentEmail", "student email")
+		if err != nil {
+			return err
+		}
+
+		careerID, err := requiredParam(r, "careerID", "career id")
+		if err != nil {
+			return err
+		}
+
+		availableSubjects, err := h.service.GetAvailableSubjects(studentEmail, careerID)
+		if err != nil {
+			switch {
+			case errors.Is(err, service.ErrNotFound):
+				return server.NewError(err.Error(), http.StatusNotFound)
+			case errors.Is(err, service.ErrCorrelativesCycle):
+				return server.NewError(err.Error(), http.StatusConflict)
+			default:
+				return err
+			}
+		}
+
+		return server.RespondJSON(w, availableSubjects, http.StatusOK)
+	}
+
+	h.wrapStudent(http.MethodGet, "/careers/{careerID}/available-subjects", wrapH)
+}
@@ -0,0 +1,140 @@
+package internal
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service"
+	"github.com/mateoferrari97/Kit/web/server"
+)
+
+func TestHandler_GetAvailableSubjects(t *testing.T) {
+	// Given
+	wrapper := wrapperMock{}
+	service_ := serviceMock{}
+	service_.On("GetAvailableSubjects", "example@gmail.com", "1").Return([]byte(`{"available":[],"blocked":[]}`), nil)
+
+	h := NewHandler(&wrapper, &service_)
+	h.GetAvailableSubjects()
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest("GET", "whocares", nil)
+	r = mux.SetURLVars(r, map[string]string{
+		"studentEmail": "example@gmail.com",
+		"careerID":     "1",
+	})
+
+	// When
+	err := wrapper.f(w, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, `{"available":[],"blocked":[]}`, w.Body.String())
+}
+
+func TestHandler_GetAvailableSubjects_ParamsError(t *testing.T) {
+	tt := []struct {
+		name          string
+		params        map[string]string
+		expectedError string
+	}{
+		{
+			name:          "student email is missing",
+			params:        map[string]string{"careerID": "1"},
+			expectedError: "student email is required",
+		},
+		{
+			name:          "career id is missing",
+			params:        map[string]string{"studentEmail": "example@gmail.com"},
+			expectedError: "career id is required",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// Given
+			wrapper := wrapperMock{}
+
+			h := NewHandler(&wrapper, nil)
+			h.GetAvailableSubjects()
+
+			w := httptest.NewRecorder()
+			r, _ := http.NewRequest("GET", "whocares", nil)
+			r = mux.SetURLVars(r, tc.params)
+
+			// When
+			err := wrapper.f(w, r)
+			if err == nil {
+				t.Fatal("test must fail")
+			}
+
+			// Then
+			hErr := err.(*server.Error)
+			require.Equal(t, http.StatusBadRequest, hErr.StatusCode)
+			require.Equal(t, tc.expectedError, hErr.Message)
+		})
+	}
+}
+
+func TestHandler_GetAvailableSubjects_ServiceNotFoundError(t *testing.T) {
+	// Given
+	wrapper := wrapperMock{}
+	service_ := serviceMock{}
+	service_.On("GetAvailableSubjects", "example@gmail.com", "1").Return([]byte{}, service.ErrNotFound)
+
+	h := NewHandler(&wrapper, &service_)
+	h.GetAvailableSubjects()
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest("GET", "whocares", nil)
+	r = mux.SetURLVars(r, map[string]string{
+		"studentEmail": "example@gmail.com",
+		"careerID":     "1",
+	})
+
+	// When
+	err := wrapper.f(w, r)
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	hErr := err.(*server.Error)
+	require.Equal(t, http.StatusNotFound, hErr.StatusCode)
+	require.Equal(t, "service: resource not found", hErr.Message)
+}
+
+func TestHandler_GetAvailableSubjects_ServiceCorrelativesCycleError(t *testing.T) {
+	// Given
+	wrapper := wrapperMock{}
+	service_ := serviceMock{}
+	service_.On("GetAvailableSubjects", "example@gmail.com", "1").Return([]byte{}, service.ErrCorrelativesCycle)
+
+	h := NewHandler(&wrapper, &service_)
+	h.GetAvailableSubjects()
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest("GET", "whocares", nil)
+	r = mux.SetURLVars(r, map[string]string{
+		"studentEmail": "example@gmail.com",
+		"careerID":     "1",
+	})
+
+	// When
+	err := wrapper.f(w, r)
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	hErr := err.(*server.Error)
+	require.Equal(t, http.StatusConflict, hErr.StatusCode)
+	require.Equal(t, "service: correlatives have a cycle", hErr.Message)
+}
@@ -0,0 +1,102 @@
+package internal
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service"
+	"github.com/mateoferrari97/Kit/web/server"
+)
+
+func (h *Handler) GetStudentCalendar() {
+	wrapH := func(w http.ResponseWriter, r *http.Request) error {
+		studentEmail, err := requiredParam(r, "studentEmail", "student email")
+		if err != nil {
+			return err
+		}
+
+		calendar, err := h.service.GetStudentCalendar(studentEmail, r.URL.Query().Get("term"))
+		if err != nil {
+			return calendarError(err)
+		}
+
+		return writeCalendar(w, calendar)
+	}
+
+	h.wrapStudent(http.MethodGet, "/calendar.ics", wrapH)
+}
+
+func calendarError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrNotFound):
+		return server.NewError(err.Error(), http.StatusNotFound)
+	case errors.Is(err, service.ErrInvalidTerm):
+		return server.NewError(err.Error(), http.StatusBadRequest)
+	default:
+		return err
+	}
+}
+
+func writeCalendar(w http.ResponseWriter, calendar []byte) error {
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Content-Disposition", `inline; filename="calendar.ics"`)
+	w.WriteHeader(http.StatusOK)
+
+	_, err := w.Write(calendar)
+	return err
+}
+
+func (h *Handler) CreateCalendarToken() {
+	wrapH := func(w http.ResponseWriter, r *http.Request) error {
+		studentEmail, err := requiredParam(r, "studentEmail", "student email")
+		if err != nil {
+			return err
+		}
+
+		response, err := h.service.CreateCalendarToken(studentEmail)
+		if err != nil {
+			return studentError(err)
+		}
+
+		return server.RespondJSON(w, response, http.StatusCreated)
+	}
+
+	h.wrapStudent(http.MethodPost, "/calendar-token", wrapH)
+}
+
+func (h *Handler) RevokeCalendarToken() {
+	wrapH := func(w http.ResponseWriter, r *http.Request) error {
+		studentEmail, err := requiredParam(r, "studentEmail", "student email")
+		if err != nil {
+			return err
+		}
+
+		if err := h.service.RevokeCalendarToken(studentEmail); err != nil {
+			return studentError(err)
+		}
+
+		return server.RespondJSON(w, nil, http.StatusNoContent)
+	}
+
+	h.wrapStudent(http.MethodDelete, "/calendar-token", wrapH)
+}
+
+// GetCalendarByToken serves the calendar feed to subscription clients, which can't send an Authorization header.
+// The secret in the path only grants access to the calendar.
+func (h *Handler) GetCalendarByToken() {
+	wrapH := func(w http.ResponseWriter, r *http.Request) error {
+		token, err := requiredParam(r, "token", "calendar token")
+		if err != nil {
+			return err
+		}
+
+		calendar, err := h.service.GetCalendarByToken(token, r.URL.Query().Get("term"))
+		if err != nil {
+			return calendarError(err)
+		}
+
+		return writeCalendar(w, calendar)
+	}
+
+	h.wrapper.Wrap(http.MethodGet, "/calendar/{token:[A-Za-z0-9_-]+}.ics", wrapH)
+}
@@ -0,0 +1,166 @@
+package internal
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service"
+	"github.com/mateoferrari97/Kit/web/server"
+)
+
+func TestHandler_GetStudentCalendar(t *testing.T) {
+	// Given
+	wrapper := wrapperMock{}
+	service_ := serviceMock{}
+	service_.On("GetStudentCalendar", "example@gmail.com", "").Return([]byte("BEGIN:VCALENDAR\r\nEND:VCALENDAR\r\n"), nil)
+
+	h := NewHandler(&wrapper, &service_)
+	h.GetStudentCalendar()
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest("GET", "whocares", nil)
+	r = mux.SetURLVars(r, map[string]string{
+		"studentEmail": "example@gmail.com",
+	})
+
+	// When
+	err := wrapper.f(w, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "text/calendar; charset=utf-8", w.Header().Get("Content-Type"))
+	require.Equal(t, "BEGIN:VCALENDAR\r\nEND:VCALENDAR\r\n", w.Body.String())
+}
+
+func TestHandler_GetStudentCalendar_StudentEmailIsRequiredError(t *testing.T) {
+	// Given
+	wrapper := wrapperMock{}
+
+	h := NewHandler(&wrapper, nil)
+	h.GetStudentCalendar()
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest("GET", "whocares", nil)
+
+	// When
+	err := wrapper.f(w, r)
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	hErr := err.(*server.Error)
+	require.Equal(t, http.StatusBadRequest, hErr.StatusCode)
+	require.Equal(t, "student email is required", hErr.Message)
+}
+
+func TestHandler_GetStudentCalendar_ServiceError(t *testing.T) {
+	// Given
+	wrapper := wrapperMock{}
+	service_ := serviceMock{}
+	service_.On("GetStudentCalendar", "example@gmail.com", "").Return([]byte{}, errors.New("error"))
+
+	h := NewHandler(&wrapper, &service_)
+	h.GetStudentCalendar()
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest("GET", "whocares", nil)
+	r = mux.SetURLVars(r, map[string]string{
+		"studentEmail": "example@gmail.com",
+	})
+
+	// When
+	err := wrapper.f(w, r)
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "error")
+}
+
+func TestHandler_CreateCalendarToken(t *testing.T) {
+	// Given
+	service_ := serviceMock{}
+	service_.On("CreateCalendarToken", "example@gmail.com").Return([]byte(`{"token":"abc","path":"/calendar/abc.ics"}`), nil)
+
+	sv, h := newAdminServer(&service_)
+	h.CreateCalendarToken()
+
+	// When
+	w := serveAdmin(sv, http.MethodPost, "/me/calendar-token", "student", "")
+
+	// Then
+	require.Equal(t, http.StatusCreated, w.Code)
+	require.Equal(t, `{"token":"abc","path":"/calendar/abc.ics"}`, w.Body.String())
+}
+
+func TestHandler_RevokeCalendarToken(t *testing.T) {
+	// Given
+	service_ := serviceMock{}
+	service_.On("RevokeCalendarToken", "example@gmail.com").Return(nil)
+
+	sv, h := newAdminServer(&service_)
+	h.RevokeCalendarToken()
+
+	// When
+	w := serveAdmin(sv, http.MethodDelete, "/me/calendar-token", "student", "")
+
+	// Then
+	require.Equal(t, http.StatusNoContent, w.Code)
+}
+
+func TestHandler_GetCalendarByToken(t *testing.T) {
+	// Given
+	service_ := serviceMock{}
+	service_.On("GetCalendarByToken", "abc-_1", "2021-1").Return([]byte("BEGIN:VCALENDAR\r\nEND:VCALENDAR\r\n"), nil)
+
+	sv, h := newAdminServer(&service_)
+	h.GetCalendarByToken()
+
+	// When
+	w := serveAdmin(sv, http.MethodGet, "/calendar/abc-_1.ics?term=2021-1", "", "")
+
+	// Then
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "text/calendar; charset=utf-8", w.Header().Get("Content-Type"))
+	require.Equal(t, "BEGIN:VCALENDAR\r\nEND:VCALENDAR\r\n", w.Body.String())
+}
+
+func TestHandler_GetCalendarByToken_NotFoundError(t *testing.T) {
+	// Given
+	service_ := serviceMock{}
+	service_.On("GetCalendarByToken", "revoked", "").Return([]byte(nil), service.ErrNotFound)
+
+	sv, h := newAdminServer(&service_)
+	h.GetCalendarByToken()
+
+	// When
+	w := serveAdmin(sv, http.MethodGet, "/calendar/revoked.ics", "", "")
+
+	// Then
+	require.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestHandler_GetStudentCalendar_InvalidTermError(t *testing.T) {
+	// Given
+	service_ := serviceMock{}
+	service_.On("GetStudentCalendar", "example@gmail.com", "2021").Return([]byte(nil), service.ErrInvalidTerm)
+
+	sv, h := newAdminServer(&service_)
+	h.GetStudentCalendar()
+
+	// When
+	w := serveAdmin(sv, http.MethodGet, "/me/calendar.ics?term=2021", "student", "")
+
+	// Then
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
@@ -0,0 +1,70 @@
+package internal
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service"
+	"github.com/mateoferrari97/Kit/web/server"
+)
+
+func (h *Handler) GetFaculties() {
+	wrapH := func(w http.ResponseWriter, r *http.Request) error {
+		faculties, err := h.service.GetFaculties()
+		if err != nil {
+			if errors.Is(err, service.ErrNotFound) {
+				return server.NewError(err.Error(), http.StatusNotFound)
+			}
+
+			return err
+		}
+
+		return server.RespondJSON(w, faculties, http.StatusOK)
+	}
+
+	h.wrapper.Wrap(http.MethodGet, "/faculties", wrapH)
+}
+
+func (h *Handler) GetFacultyCareers() {
+	wrapH := func(w http.ResponseWriter, r *http.Request) error {
+		facultyID, err := requiredParam(r, "facultyID", "faculty id")
+		if err != nil {
+			return err
+		}
+
+		careers, err := h.service.GetFacultyCareers(facultyID)
+		if err != nil {
+			if errors.Is(err, service.ErrNotFound) {
+				return server.NewError(err.Error(), http.StatusNotFound)
+			}
+
+			return err
+		}
+
+		return server.RespondJSON(w, careers, http.StatusOK)
+	}
+
+	h.wrapper.Wrap(http.MethodGet, "/faculties/{facultyID}/careers", wrapH)
+}
+
+func (h *Handler) GetCareer() {
+	wrapH := func(w http.ResponseWriter, r *http.Request) error {
+		careerID, err := requiredParam(r, "careerID", "career id")
+		if err != nil {
+			return err
+		}
+
+		career, err := h.service.GetCareer(careerID)
+		if err != nil {
+			if errors.Is(err, service.ErrNotFound) {
+				return server.NewError(err.Error(), http.StatusNotFound)
+			}
+
+			return err
+		}
+
+		return server.RespondJSON(w, career, http.StatusOK)
+	}
+
+	h.wrapper.Wrap(http.MethodGet, "/careers/{careerID}", wrapH)
+}
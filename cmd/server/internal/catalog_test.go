@@ -0,0 +1,244 @@
+package internal
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service"
+	"github.com/mateoferrari97/Kit/web/server"
+)
+
+func TestHandler_GetFaculties(t *testing.T) {
+	// Given
+	wrapper := wrapperMock{}
+	service_ := serviceMock{}
+	service_.On("GetFaculties").Return([]byte(`[{"id":1,"name":"Exactas","uri":null}]`), nil)
+
+	h := NewHandler(&wrapper, &service_)
+	h.GetFaculties()
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest("GET", "whocares", nil)
+
+	// When
+	err := wrapper.f(w, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, `[{"id":1,"name":"Exactas","uri":null}]`, w.Body.String())
+}
+
+func TestHandler_GetFaculties_ServiceError(t *testing.T) {
+	// Given
+	wrapper := wrapperMock{}
+	service_ := serviceMock{}
+	service_.On("GetFaculties").Return([]byte{}, errors.New("error"))
+
+	h := NewHandler(&wrapper, &service_)
+	h.GetFaculties()
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest("GET", "whocares", nil)
+
+	// When
+	err := wrapper.f(w, r)
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "error")
+}
+
+func TestHandler_GetFaculties_ServiceNotFoundError(t *testing.T) {
+	// Given
+	wrapper := wrapperMock{}
+	service_ := serviceMock{}
+	service_.On("GetFaculties").Return([]byte{}, service.ErrNotFound)
+
+	h := NewHandler(&wrapper, &service_)
+	h.GetFaculties()
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest("GET", "whocares", nil)
+
+	// When
+	err := wrapper.f(w, r)
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	hErr := err.(*server.Error)
+	require.Equal(t, http.StatusNotFound, hErr.StatusCode)
+	require.Equal(t, "not_found", hErr.Code)
+	require.Equal(t, "service: resource not found", hErr.Message)
+}
+
+func TestHandler_GetFacultyCareers(t *testing.T) {
+	// Given
+	wrapper := wrapperMock{}
+	service_ := serviceMock{}
+	service_.On("GetFacultyCareers", "1").Return([]byte(`[{"id":2,"faculty_id":1,"name":"Computación","uri":null}]`), nil)
+
+	h := NewHandler(&wrapper, &service_)
+	h.GetFacultyCareers()
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest("GET", "whocares", nil)
+	r = mux.SetURLVars(r, map[string]string{
+		"facultyID": "1",
+	})
+
+	// When
+	err := wrapper.f(w, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, `[{"id":2,"faculty_id":1,"name":"Computación","uri":null}]`, w.Body.String())
+}
+
+func TestHandler_GetFacultyCareers_ParamsError(t *testing.T) {
+	// Given
+	wrapper := wrapperMock{}
+
+	h := NewHandler(&wrapper, nil)
+	h.GetFacultyCareers()
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest("GET", "whocares", nil)
+	r = mux.SetURLVars(r, map[string]string{
+		"facultyID": "",
+	})
+
+	// When
+	err := wrapper.f(w, r)
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	hErr := err.(*server.Error)
+	require.Equal(t, http.StatusBadRequest, hErr.StatusCode)
+	require.Equal(t, "bad_request", hErr.Code)
+	require.Equal(t, "faculty id is required", hErr.Message)
+}
+
+func TestHandler_GetFacultyCareers_ServiceNotFoundError(t *testing.T) {
+	// Given
+	wrapper := wrapperMock{}
+	service_ := serviceMock{}
+	service_.On("GetFacultyCareers", "1").Return([]byte{}, service.ErrNotFound)
+
+	h := NewHandler(&wrapper, &service_)
+	h.GetFacultyCareers()
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest("GET", "whocares", nil)
+	r = mux.SetURLVars(r, map[string]string{
+		"facultyID": "1",
+	})
+
+	// When
+	err := wrapper.f(w, r)
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	hErr := err.(*server.Error)
+	require.Equal(t, http.StatusNotFound, hErr.StatusCode)
+	require.Equal(t, "not_found", hErr.Code)
+	require.Equal(t, "service: resource not found", hErr.Message)
+}
+
+func TestHandler_GetCareer(t *testing.T) {
+	// Given
+	wrapper := wrapperMock{}
+	service_ := serviceMock{}
+	service_.On("GetCareer", "2").Return([]byte(`{"id":2,"faculty_id":1,"name":"Computación","uri":null}`), nil)
+
+	h := NewHandler(&wrapper, &service_)
+	h.GetCareer()
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest("GET", "whocares", nil)
+	r = mux.SetURLVars(r, map[string]string{
+		"careerID": "2",
+	})
+
+	// When
+	err := wrapper.f(w, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, `{"id":2,"faculty_id":1,"name":"Computación","uri":null}`, w.Body.String())
+}
+
+func TestHandler_GetCareer_ParamsError(t *testing.T) {
+	// Given
+	wrapper := wrapperMock{}
+
+	h := NewHandler(&wrapper, nil)
+	h.GetCareer()
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest("GET", "whocares", nil)
+	r = mux.SetURLVars(r, map[string]string{
+		"careerID": "",
+	})
+
+	// When
+	err := wrapper.f(w, r)
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	hErr := err.(*server.Error)
+	require.Equal(t, http.StatusBadRequest, hErr.StatusCode)
+	require.Equal(t, "bad_request", hErr.Code)
+	require.Equal(t, "career id is required", hErr.Message)
+}
+
+func TestHandler_GetCareer_ServiceNotFoundError(t *testing.T) {
+	// Given
+	wrapper := wrapperMock{}
+	service_ := serviceMock{}
+	service_.On("GetCareer", "2").Return([]byte{}, service.ErrNotFound)
+
+	h := NewHandler(&wrapper, &service_)
+	h.GetCareer()
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest("GET", "whocares", nil)
+	r = mux.SetURLVars(r, map[string]string{
+		"careerID": "2",
+	})
+
+	// When
+	err := wrapper.f(w, r)
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	hErr := err.(*server.Error)
+	require.Equal(t, http.StatusNotFound, hErr.StatusCode)
+	require.Equal(t, "not_found", hErr.Code)
+	require.Equal(t, "service: resource not found", hErr.Message)
+}
@@ -0,0 +1,69 @@
+// Package database opens the database shared by the server and its commands.
+package database
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/migrations"
+)
+
+type Config struct {
+	Driver string
+	Source string
+}
+
+// ConfigFromEnv reads DATABASE_DRIVER and DATABASE_CONFIG, defaulting to the local MySQL database.
+func ConfigFromEnv() Config {
+	config := Config{Driver: os.Getenv("DATABASE_DRIVER"), Source: os.Getenv("DATABASE_CONFIG")}
+	if config.Driver == "" {
+		config.Driver = storage.DriverMySQL
+	}
+
+	if config.Source == "" {
+		config.Source = defaultSource(config.Driver)
+	}
+
+	return config
+}
+
+func defaultSource(driver string) string {
+	if driver == storage.DriverSQLite {
+		return "file:study_in_exactas.db"
+	}
+
+	return "root:root@tcp(localhost:3306)/study_in_exactas"
+}
+
+func Open(config Config) (*sqlx.DB, error) {
+	db, err := storage.Open(config.Driver, config.Source)
+	if err != nil {
+		return nil, fmt.Errorf("could not connect to db: %v", err)
+	}
+
+	return db, nil
+}
+
+// OpenMigrated opens the database and applies the pending migrations, so commands never write to an older schema.
+func OpenMigrated(config Config) (*sqlx.DB, error) {
+	db, err := Open(config)
+	if err != nil {
+		return nil, err
+	}
+
+	migrator, err := migrations.NewMigrator(db)
+	if err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+
+	if _, err := migrator.Up(); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("could not migrate db: %v", err)
+	}
+
+	return db, nil
+}
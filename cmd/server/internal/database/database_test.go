@@ -0,0 +1,43 @@
+package database
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/migrations"
+)
+
+func TestOpenMigrated(t *testing.T) {
+	// Given
+	config := Config{Driver: storage.DriverSQLite, Source: "file:" + filepath.Join(t.TempDir(), "test.db")}
+
+	// When
+	db, err := OpenMigrated(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	// Then
+	migrator, err := migrations.NewMigrator(db)
+	require.NoError(t, err)
+
+	all, err := migrations.Load(storage.DriverSQLite)
+	require.NoError(t, err)
+
+	version, err := migrator.Version()
+	require.NoError(t, err)
+	require.Equal(t, len(all), version)
+}
+
+func TestOpen_UnknownDriverError(t *testing.T) {
+	// When
+	_, err := Open(Config{Driver: "postgres"})
+
+	// Then
+	require.EqualError(t, err, "could not connect to db: unknown database driver postgres: expected mysql or sqlite")
+}
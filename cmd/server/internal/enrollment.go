@@ -0,0 +1,64 @@
+package internal
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service"
+	"github.com/mateoferrari97/Kit/web/server"
+)
+
+func (h *Handler) EnrollStudentInProfessorship() {
+	wrapH := func(w http.ResponseWriter, r *http.Request) error {
+		studentEmail, err := requiredParam(r, "studentEmail", "student email")
+		if err != nil {
+			return err
+		}
+
+		professorshipID, err := requiredParam(r, "professorshipID", "professorship id")
+		if err != nil {
+			return err
+		}
+
+		if err := h.service.EnrollStudentInProfessorship(studentEmail, professorshipID); err != nil {
+			switch {
+			case errors.Is(err, service.ErrNotFound):
+				return server.NewError(err.Error(), http.StatusNotFound)
+			case errors.Is(err, service.ErrAlreadyEnrolled):
+				return server.NewError(err.Error(), http.StatusConflict)
+			default:
+				return err
+			}
+		}
+
+		return server.RespondJSON(w, nil, http.StatusCreated)
+	}
+
+	h.wrapStudent(http.MethodPost, "/professorships/{professorshipID}", wrapH)
+}
+
+func (h *Handler) DeleteStudentProfessorship() {
+	wrapH := func(w http.ResponseWriter, r *http.Request) error {
+		studentEmail, err := requiredParam(r, "studentEmail", "student email")
+		if err != nil {
+			return err
+		}
+
+		professorshipID, err := requiredParam(r, "professorshipID", "professorship id")
+		if err != nil {
+			return err
+		}
+
+		if err := h.service.DeleteStudentProfessorship(studentEmail, professorshipID); err != nil {
+			if errors.Is(err, service.ErrNotFound) {
+				return server.NewError(err.Error(), http.StatusNotFound)
+			}
+
+			return err
+		}
+
+		return server.RespondJSON(w, nil, http.StatusNoContent)
+	}
+
+	h.wrapStudent(http.MethodDelete, "/professorships/{professorshipID}", wrapH)
+}
@@ -0,0 +1,186 @@
+package internal
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service"
+	"github.com/mateoferrari97/Kit/web/server"
+)
+
+func TestHandler_EnrollStudentInProfessorship(t *testing.T) {
+	// Given
+	wrapper := wrapperMock{}
+	service_ := serviceMock{}
+	service_.On("EnrollStudentInProfessorship", "example@gmail.com", "7").Return(nil)
+
+	h := NewHandler(&wrapper, &service_)
+	h.EnrollStudentInProfessorship()
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest("POST", "whocares", nil)
+	r = mux.SetURLVars(r, map[string]string{
+		"studentEmail":    "example@gmail.com",
+		"professorshipID": "7",
+	})
+
+	// When
+	err := wrapper.f(w, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Equal(t, http.StatusCreated, w.Code)
+}
+
+func TestHandler_EnrollStudentInProfessorship_ParamsError(t *testing.T) {
+	tt := []struct {
+		name          string
+		params        map[string]string
+		expectedError string
+	}{
+		{
+			name:          "student email is missing",
+			params:        map[string]string{"professorshipID": "7"},
+			expectedError: "student email is required",
+		},
+		{
+			name:          "professorship id is missing",
+			params:        map[string]string{"studentEmail": "example@gmail.com"},
+			expectedError: "professorship id is required",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// Given
+			wrapper := wrapperMock{}
+
+			h := NewHandler(&wrapper, nil)
+			h.EnrollStudentInProfessorship()
+
+			w := httptest.NewRecorder()
+			r, _ := http.NewRequest("POST", "whocares", nil)
+			r = mux.SetURLVars(r, tc.params)
+
+			// When
+			err := wrapper.f(w, r)
+			if err == nil {
+				t.Fatal("test must fail")
+			}
+
+			// Then
+			hErr := err.(*server.Error)
+			require.Equal(t, http.StatusBadRequest, hErr.StatusCode)
+			require.Equal(t, tc.expectedError, hErr.Message)
+		})
+	}
+}
+
+func TestHandler_EnrollStudentInProfessorship_ServiceError(t *testing.T) {
+	tt := []struct {
+		name               string
+		serviceError       error
+		expectedStatusCode int
+	}{
+		{
+			name:               "not found",
+			serviceError:       service.ErrNotFound,
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name:               "already enrolled",
+			serviceError:       service.ErrAlreadyEnrolled,
+			expectedStatusCode: http.StatusConflict,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// Given
+			wrapper := wrapperMock{}
+			service_ := serviceMock{}
+			service_.On("EnrollStudentInProfessorship", "example@gmail.com", "7").Return(tc.serviceError)
+
+			h := NewHandler(&wrapper, &service_)
+			h.EnrollStudentInProfessorship()
+
+			w := httptest.NewRecorder()
+			r, _ := http.NewRequest("POST", "whocares", nil)
+			r = mux.SetURLVars(r, map[string]string{
+				"studentEmail":    "example@gmail.com",
+				"professorshipID": "7",
+			})
+
+			// When
+			err := wrapper.f(w, r)
+			if err == nil {
+				t.Fatal("test must fail")
+			}
+
+			// Then
+			hErr := err.(*server.Error)
+			require.Equal(t, tc.expectedStatusCode, hErr.StatusCode)
+			require.Equal(t, tc.serviceError.Error(), hErr.Message)
+		})
+	}
+}
+
+func TestHandler_DeleteStudentProfessorship(t *testing.T) {
+	// Given
+	wrapper := wrapperMock{}
+	service_ := serviceMock{}
+	service_.On("DeleteStudentProfessorship", "example@gmail.com", "7").Return(nil)
+
+	h := NewHandler(&wrapper, &service_)
+	h.DeleteStudentProfessorship()
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest("DELETE", "whocares", nil)
+	r = mux.SetURLVars(r, map[string]string{
+		"studentEmail":    "example@gmail.com",
+		"professorshipID": "7",
+	})
+
+	// When
+	err := wrapper.f(w, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Equal(t, http.StatusNoContent, w.Code)
+}
+
+func TestHandler_DeleteStudentProfessorship_ServiceNotFoundError(t *testing.T) {
+	// Given
+	wrapper := wrapperMock{}
+	service_ := serviceMock{}
+	service_.On("DeleteStudentProfessorship", "example@gmail.com", "7").Return(service.ErrNotFound)
+
+	h := NewHandler(&wrapper, &service_)
+	h.DeleteStudentProfessorship()
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest("DELETE", "whocares", nil)
+	r = mux.SetURLVars(r, map[string]string{
+		"studentEmail":    "example@gmail.com",
+		"professorshipID": "7",
+	})
+
+	// When
+	err := wrapper.f(w, r)
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	hErr := err.(*server.Error)
+	require.Equal(t, http.StatusNotFound, hErr.StatusCode)
+	require.Equal(t, "service: resource not found", hErr.Message)
+}
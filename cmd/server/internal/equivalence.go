@@ -0,0 +1,175 @@
+package internal
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service"
+	"github.com/mateoferrari97/Kit/web/server"
+)
+
+func (h *Handler) GetEquivalences() {
+	wrapH := func(w http.ResponseWriter, r *http.Request) error {
+		careerID, err := requiredParam(r, "careerID", "career id")
+		if err != nil {
+			return err
+		}
+
+		response, err := h.service.GetEquivalences(careerID)
+		if err != nil {
+			return catalogError(err)
+		}
+
+		return server.RespondJSON(w, response, http.StatusOK)
+	}
+
+	h.wrapper.Wrap(http.MethodGet, "/careers/{careerID}/equivalences", wrapH)
+}
+
+func (h *Handler) CreateEquivalence() {
+	wrapH := func(w http.ResponseWriter, r *http.Request) error {
+		var equivalence struct {
+			CareerID            int `json:"career_id" validate:"required,min=1"`
+			SubjectID           int `json:"subject_id" validate:"required,min=1"`
+			EquivalentCareerID  int `json:"equivalent_career_id" validate:"required,min=1"`
+			EquivalentSubjectID int `json:"equivalent_subject_id" validate:"required,min=1"`
+		}
+
+		if err := decodeAndValidate(r, &equivalence); err != nil {
+			return err
+		}
+
+		response, err := h.service.CreateEquivalence(service.EquivalenceRequest{
+			CareerID:            strconv.Itoa(equivalence.CareerID),
+			SubjectID:           strconv.Itoa(equivalence.SubjectID),
+			EquivalentCareerID:  strconv.Itoa(equivalence.EquivalentCareerID),
+			EquivalentSubjectID: strconv.Itoa(equivalence.EquivalentSubjectID),
+		})
+
+		if err != nil {
+			return catalogError(err)
+		}
+
+		return server.RespondJSON(w, response, http.StatusCreated)
+	}
+
+	h.wrapAdmin(http.MethodPost, "/equivalences", wrapH)
+}
+
+func (h *Handler) DeleteEquivalence() {
+	wrapH := func(w http.ResponseWriter, r *http.Request) error {
+		equivalenceID, err := requiredParam(r, "equivalenceID", "equivalence id")
+		if err != nil {
+			return err
+		}
+
+		if err := h.service.DeleteEquivalence(equivalenceID); err != nil {
+			return catalogError(err)
+		}
+
+		return server.RespondJSON(w, nil, http.StatusNoContent)
+	}
+
+	h.wrapAdmin(http.MethodDelete, "/equivalences/{equivalenceID}", wrapH)
+}
+
+func (h *Handler) GetStudentEquivalences() {
+	wrapH := func(w http.ResponseWriter, r *http.Request) error {
+		studentEmail, err := requiredParam(r, "studentEmail", "student email")
+		if err != nil {
+			return err
+		}
+
+		careerID, err := requiredParam(r, "careerID", "career id")
+		if err != nil {
+			return err
+		}
+
+		response, err := h.service.GetStudentEquivalences(studentEmail, careerID)
+		if err != nil {
+			return err
+		}
+
+		return server.RespondJSON(w, response, http.StatusOK)
+	}
+
+	h.wrapStudent(http.MethodGet, "/careers/{careerID}/equivalences", wrapH)
+}
+
+func (h *Handler) RequestEquivalence() {
+	wrapH := func(w http.ResponseWriter, r *http.Request) error {
+		studentEmail, err := requiredParam(r, "studentEmail", "student email")
+		if err != nil {
+			return err
+		}
+
+		careerID, err := requiredParam(r, "careerID", "career id")
+		if err != nil {
+			return err
+		}
+
+		var request struct {
+			SubjectID       int `json:"subject_id" validate:"required,min=1"`
+			SourceCareerID  int `json:"source_career_id" validate:"required,min=1"`
+			SourceSubjectID int `json:"source_subject_id" validate:"required,min=1"`
+		}
+
+		if err := decodeAndValidate(r, &request); err != nil {
+			return err
+		}
+
+		response, err := h.service.RequestEquivalence(service.StudentEquivalenceRequest{
+			StudentEmail:    studentEmail,
+			CareerID:        careerID,
+			SubjectID:       strconv.Itoa(request.SubjectID),
+			SourceCareerID:  strconv.Itoa(request.SourceCareerID),
+			SourceSubjectID: strconv.Itoa(request.SourceSubjectID),
+		})
+
+		if err != nil {
+			return catalogError(err)
+		}
+
+		return server.RespondJSON(w, response, http.StatusCreated)
+	}
+
+	h.wrapStudent(http.MethodPost, "/careers/{careerID}/equivalences", wrapH)
+}
+
+func (h *Handler) GetEquivalenceRequests() {
+	wrapH := func(w http.ResponseWriter, r *http.Request) error {
+		response, err := h.service.GetEquivalenceRequests(r.URL.Query().Get("status"))
+		if err != nil {
+			return catalogError(err)
+		}
+
+		return server.RespondJSON(w, response, http.StatusOK)
+	}
+
+	h.wrapAdmin(http.MethodGet, "/equivalence-requests", wrapH)
+}
+
+func (h *Handler) ResolveEquivalenceRequest() {
+	wrapH := func(w http.ResponseWriter, r *http.Request) error {
+		requestID, err := requiredParam(r, "requestID", "request id")
+		if err != nil {
+			return err
+		}
+
+		var resolution struct {
+			Status string `json:"status" validate:"required,oneof=APROBADA RECHAZADA"`
+		}
+
+		if err := decodeAndValidate(r, &resolution); err != nil {
+			return err
+		}
+
+		if err := h.service.ResolveEquivalenceRequest(requestID, resolution.Status); err != nil {
+			return catalogError(err)
+		}
+
+		return server.RespondJSON(w, nil, http.StatusOK)
+	}
+
+	h.wrapAdmin(http.MethodPut, "/equivalence-requests/{requestID}", wrapH)
+}
@@ -0,0 +1,142 @@
+package internal
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service"
+)
+
+func TestHandler_CreateEquivalence(t *testing.T) {
+	// Given
+	service_ := serviceMock{}
+	service_.On("CreateEquivalence", service.EquivalenceRequest{CareerID: "1", SubjectID: "2", EquivalentCareerID: "3", EquivalentSubjectID: "4"}).Return([]byte(`{"id":1}`), nil)
+
+	sv, h := newAdminServer(&service_)
+	h.CreateEquivalence()
+
+	// When
+	w := serveAdmin(sv, http.MethodPost, "/admin/equivalences", "admin", `{"career_id":1,"subject_id":2,"equivalent_career_id":3,"equivalent_subject_id":4}`)
+
+	// Then
+	require.Equal(t, http.StatusCreated, w.Code)
+	require.Equal(t, `{"id":1}`, w.Body.String())
+}
+
+func TestHandler_CreateEquivalence_Error(t *testing.T) {
+	tt := []struct {
+		name               string
+		token              string
+		body               string
+		returnedError      error
+		expectedStatusCode int
+	}{
+		{name: "student token", token: "student", body: `{"career_id":1,"subject_id":2,"equivalent_career_id":3,"equivalent_subject_id":4}`, expectedStatusCode: http.StatusForbidden},
+		{name: "missing subject", token: "admin", body: `{"career_id":1,"equivalent_career_id":3,"equivalent_subject_id":4}`, expectedStatusCode: http.StatusBadRequest},
+		{name: "same career", token: "admin", body: `{"career_id":1,"subject_id":2,"equivalent_career_id":3,"equivalent_subject_id":4}`, returnedError: service.ErrInvalidEquivalence, expectedStatusCode: http.StatusBadRequest},
+		{name: "already exist", token: "admin", body: `{"career_id":1,"subject_id":2,"equivalent_career_id":3,"equivalent_subject_id":4}`, returnedError: service.ErrResourceAlreadyExist, expectedStatusCode: http.StatusConflict},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// Given
+			service_ := serviceMock{}
+			service_.On("CreateEquivalence", service.EquivalenceRequest{CareerID: "1", SubjectID: "2", EquivalentCareerID: "3", EquivalentSubjectID: "4"}).Return([]byte(nil), tc.returnedError)
+
+			sv, h := newAdminServer(&service_)
+			h.CreateEquivalence()
+
+			// When
+			w := serveAdmin(sv, http.MethodPost, "/admin/equivalences", tc.token, tc.body)
+
+			// Then
+			require.Equal(t, tc.expectedStatusCode, w.Code)
+		})
+	}
+}
+
+func TestHandler_GetEquivalences(t *testing.T) {
+	// Given
+	service_ := serviceMock{}
+	service_.On("GetEquivalences", "1").Return([]byte(`[]`), nil)
+
+	sv, h := newAdminServer(&service_)
+	h.GetEquivalences()
+
+	// When
+	w := serveAdmin(sv, http.MethodGet, "/careers/1/equivalences", "", "")
+
+	// Then
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, `[]`, w.Body.String())
+}
+
+func TestHandler_RequestEquivalence(t *testing.T) {
+	// Given
+	service_ := serviceMock{}
+	service_.On("RequestEquivalence", service.StudentEquivalenceRequest{
+		StudentEmail:    "example@gmail.com",
+		CareerID:        "2",
+		SubjectID:       "5",
+		SourceCareerID:  "1",
+		SourceSubjectID: "3",
+	}).Return([]byte(`{"id":7}`), nil)
+
+	sv, h := newAdminServer(&service_)
+	h.RequestEquivalence()
+
+	// When
+	w := serveAdmin(sv, http.MethodPost, "/me/careers/2/equivalences", "student", `{"subject_id":5,"source_career_id":1,"source_subject_id":3}`)
+
+	// Then
+	require.Equal(t, http.StatusCreated, w.Code)
+	require.Equal(t, `{"id":7}`, w.Body.String())
+}
+
+func TestHandler_GetEquivalenceRequests(t *testing.T) {
+	// Given
+	service_ := serviceMock{}
+	service_.On("GetEquivalenceRequests", "RECHAZADA").Return([]byte(`[]`), nil)
+
+	sv, h := newAdminServer(&service_)
+	h.GetEquivalenceRequests()
+
+	// When
+	w := serveAdmin(sv, http.MethodGet, "/admin/equivalence-requests?status=RECHAZADA", "admin", "")
+
+	// Then
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, `[]`, w.Body.String())
+}
+
+func TestHandler_ResolveEquivalenceRequest(t *testing.T) {
+	tt := []struct {
+		name               string
+		body               string
+		returnedError      error
+		expectedStatusCode int
+	}{
+		{name: "approved", body: `{"status":"APROBADA"}`, expectedStatusCode: http.StatusOK},
+		{name: "invalid status", body: `{"status":"PENDIENTE"}`, expectedStatusCode: http.StatusBadRequest},
+		{name: "not found", body: `{"status":"APROBADA"}`, returnedError: service.ErrNotFound, expectedStatusCode: http.StatusNotFound},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// Given
+			service_ := serviceMock{}
+			service_.On("ResolveEquivalenceRequest", "7", "APROBADA").Return(tc.returnedError)
+
+			sv, h := newAdminServer(&service_)
+			h.ResolveEquivalenceRequest()
+
+			// When
+			w := serveAdmin(sv, http.MethodPut, "/admin/equivalence-requests/7", "admin", tc.body)
+
+			// Then
+			require.Equal(t, tc.expectedStatusCode, w.Code)
+		})
+	}
+}
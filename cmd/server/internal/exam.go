@@ -0,0 +1,91 @@
+package internal
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service"
+	"github.com/mateoferrari97/Kit/web/server"
+)
+
+const examDateLayout = "2006-01-02"
+
+func (h *Handler) CreateExam() {
+	wrapH := func(w http.ResponseWriter, r *http.Request) error {
+		studentEmail, err := requiredParam(r, "studentEmail", "student email")
+		if err != nil {
+			return err
+		}
+
+		careerID, err := requiredParam(r, "careerID", "career id")
+		if err != nil {
+			return err
+		}
+
+		subjectID, err := requiredParam(r, "subjectID", "subject id")
+		if err != nil {
+			return err
+		}
+
+		var exam struct {
+			Grade int    `json:"grade" validate:"required,min=1,max=10"`
+			Date  string `json:"date" validate:"required"`
+		}
+
+		if err := decodeAndValidate(r, &exam); err != nil {
+			return err
+		}
+
+		if _, err := time.Parse(examDateLayout, exam.Date); err != nil {
+			return server.NewError("date must have YYYY-MM-DD format", http.StatusBadRequest)
+		}
+
+		response, err := h.service.CreateExam(service.CreateExamRequest{
+			StudentEmail: studentEmail,
+			CareerID:     careerID,
+			SubjectID:    subjectID,
+			Grade:        exam.Grade,
+			Date:         exam.Date,
+		})
+
+		if err != nil {
+			if errors.Is(err, service.ErrNotFound) {
+				return server.NewError(err.Error(), http.StatusNotFound)
+			}
+
+			return err
+		}
+
+		return server.RespondJSON(w, response, http.StatusCreated)
+	}
+
+	h.wrapStudent(http.MethodPost, "/careers/{careerID}/subjects/{subjectID}/exams", wrapH)
+}
+
+func (h *Handler) GetStudentCareerSummary() {
+	wrapH := func(w http.ResponseWriter, r *http.Request) error {
+		studentEmail, err := requiredParam(r, "studentEmail", "student email")
+		if err != nil {
+			return err
+		}
+
+		careerID, err := requiredParam(r, "careerID", "career id")
+		if err != nil {
+			return err
+		}
+
+		summary, err := h.service.GetStudentCareerSummary(studentEmail, careerID)
+		if err != nil {
+			if errors.Is(err, service.ErrNotFound) {
+				return server.NewError(err.Error(), http.StatusNotFound)
+			}
+
+			return err
+		}
+
+		return server.RespondJSON(w, summary, http.StatusOK)
+	}
+
+	h.wrapStudent(http.MethodGet, "/careers/{careerID}/summary", wrapH)
+}
@@ -0,0 +1,195 @@
+package internal
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service"
+	"github.com/mateoferrari97/Kit/web/server"
+)
+
+func TestHandler_CreateExam(t *testing.T) {
+	// Given
+	wrapper := wrapperMock{}
+	service_ := serviceMock{}
+	service_.On("CreateExam", service.CreateExamRequest{
+		StudentEmail: "example@gmail.com",
+		CareerID:     "1",
+		SubjectID:    "2",
+		Grade:        8,
+		Date:         "2021-07-20",
+	}).Return([]byte(`{"id":3}`), nil)
+
+	h := NewHandler(&wrapper, &service_)
+	h.CreateExam()
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest("POST", "whocares", bytes.NewReader([]byte(`{"grade":8,"date":"2021-07-20"}`)))
+	r = mux.SetURLVars(r, map[string]string{
+		"studentEmail": "example@gmail.com",
+		"careerID":     "1",
+		"subjectID":    "2",
+	})
+
+	// When
+	err := wrapper.f(w, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Equal(t, http.StatusCreated, w.Code)
+	require.Equal(t, `{"id":3}`, w.Body.String())
+}
+
+func TestHandler_CreateExam_BodyValidationError(t *testing.T) {
+	tt := []struct {
+		name          string
+		body          string
+		expectedError string
+	}{
+		{
+			name:          "grade is missing",
+			body:          `{"date":"2021-07-20"}`,
+			expectedError: "Key: 'Grade' Error:Field validation for 'Grade' failed on the 'required' tag",
+		},
+		{
+			name:          "grade is out of range",
+			body:          `{"grade":11,"date":"2021-07-20"}`,
+			expectedError: "Key: 'Grade' Error:Field validation for 'Grade' failed on the 'max' tag",
+		},
+		{
+			name:          "date is missing",
+			body:          `{"grade":8}`,
+			expectedError: "Key: 'Date' Error:Field validation for 'Date' failed on the 'required' tag",
+		},
+		{
+			name:          "date has invalid format",
+			body:          `{"grade":8,"date":"20/07/2021"}`,
+			expectedError: "date must have YYYY-MM-DD format",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// Given
+			wrapper := wrapperMock{}
+
+			h := NewHandler(&wrapper, nil)
+			h.CreateExam()
+
+			w := httptest.NewRecorder()
+			r, _ := http.NewRequest("POST", "whocares", bytes.NewReader([]byte(tc.body)))
+			r = mux.SetURLVars(r, map[string]string{
+				"studentEmail": "example@gmail.com",
+				"careerID":     "1",
+				"subjectID":    "2",
+			})
+
+			// When
+			err := wrapper.f(w, r)
+			if err == nil {
+				t.Fatal("test must fail")
+			}
+
+			// Then
+			hErr := err.(*server.Error)
+			require.Equal(t, http.StatusBadRequest, hErr.StatusCode)
+			require.Equal(t, tc.expectedError, hErr.Message)
+		})
+	}
+}
+
+func TestHandler_CreateExam_ServiceNotFoundError(t *testing.T) {
+	// Given
+	wrapper := wrapperMock{}
+	service_ := serviceMock{}
+	service_.On("CreateExam", service.CreateExamRequest{
+		StudentEmail: "example@gmail.com",
+		CareerID:     "1",
+		SubjectID:    "2",
+		Grade:        8,
+		Date:         "2021-07-20",
+	}).Return([]byte{}, service.ErrNotFound)
+
+	h := NewHandler(&wrapper, &service_)
+	h.CreateExam()
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest("POST", "whocares", bytes.NewReader([]byte(`{"grade":8,"date":"2021-07-20"}`)))
+	r = mux.SetURLVars(r, map[string]string{
+		"studentEmail": "example@gmail.com",
+		"careerID":     "1",
+		"subjectID":    "2",
+	})
+
+	// When
+	err := wrapper.f(w, r)
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	hErr := err.(*server.Error)
+	require.Equal(t, http.StatusNotFound, hErr.StatusCode)
+	require.Equal(t, "service: resource not found", hErr.Message)
+}
+
+func TestHandler_GetStudentCareerSummary(t *testing.T) {
+	// Given
+	wrapper := wrapperMock{}
+	service_ := serviceMock{}
+	service_.On("GetStudentCareerSummary", "example@gmail.com", "1").Return([]byte(`{"average":7.5}`), nil)
+
+	h := NewHandler(&wrapper, &service_)
+	h.GetStudentCareerSummary()
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest("GET", "whocares", nil)
+	r = mux.SetURLVars(r, map[string]string{
+		"studentEmail": "example@gmail.com",
+		"careerID":     "1",
+	})
+
+	// When
+	err := wrapper.f(w, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, `{"average":7.5}`, w.Body.String())
+}
+
+func TestHandler_GetStudentCareerSummary_ServiceError(t *testing.T) {
+	// Given
+	wrapper := wrapperMock{}
+	service_ := serviceMock{}
+	service_.On("GetStudentCareerSummary", "example@gmail.com", "1").Return([]byte{}, errors.New("error"))
+
+	h := NewHandler(&wrapper, &service_)
+	h.GetStudentCareerSummary()
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest("GET", "whocares", nil)
+	r = mux.SetURLVars(r, map[string]string{
+		"studentEmail": "example@gmail.com",
+		"careerID":     "1",
+	})
+
+	// When
+	err := wrapper.f(w, r)
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "error")
+}
@@ -0,0 +1,50 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service"
+	"github.com/mateoferrari97/Kit/web/server"
+)
+
+func (h *Handler) ExportStudentRecord() {
+	wrapH := func(w http.ResponseWriter, r *http.Request) error {
+		studentEmail, err := requiredParam(r, "studentEmail", "student email")
+		if err != nil {
+			return err
+		}
+
+		careerID, err := requiredParam(r, "careerID", "career id")
+		if err != nil {
+			return err
+		}
+
+		format := r.URL.Query().Get("format")
+		if format == "" {
+			format = service.ExportFormatJSON
+		}
+
+		export, err := h.service.ExportStudentRecord(studentEmail, careerID, format)
+		if err != nil {
+			switch {
+			case errors.Is(err, service.ErrInvalidExportFormat):
+				return server.NewError(err.Error(), http.StatusBadRequest)
+			case errors.Is(err, service.ErrNotFound):
+				return server.NewError(err.Error(), http.StatusNotFound)
+			default:
+				return err
+			}
+		}
+
+		w.Header().Set("Content-Type", export.ContentType)
+		w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, export.Filename))
+		w.WriteHeader(http.StatusOK)
+
+		_, err = w.Write(export.Content)
+		return err
+	}
+
+	h.wrapStudent(http.MethodGet, "/careers/{careerID}/export", wrapH)
+}
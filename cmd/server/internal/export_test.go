@@ -0,0 +1,94 @@
+package internal
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service"
+	"github.com/mateoferrari97/Kit/web/server"
+)
+
+func TestHandler_ExportStudentRecord(t *testing.T) {
+	// Given
+	wrapper := wrapperMock{}
+	service_ := serviceMock{}
+	service_.On("ExportStudentRecord", "example@gmail.com", "1", "csv").Return(service.Export{
+		ContentType: "text/csv; charset=utf-8",
+		Filename:    "record-1-20210315.csv",
+		Content:     []byte("subject_id,name\n1,Álgebra\n"),
+	}, nil)
+
+	h := NewHandler(&wrapper, &service_)
+	h.ExportStudentRecord()
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest("GET", "whocares?format=csv", nil)
+	r = mux.SetURLVars(r, map[string]string{
+		"studentEmail": "example@gmail.com",
+		"careerID":     "1",
+	})
+
+	// When
+	err := wrapper.f(w, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "text/csv; charset=utf-8", w.Header().Get("Content-Type"))
+	require.Equal(t, `attachment; filename="record-1-20210315.csv"`, w.Header().Get("Content-Disposition"))
+	require.Equal(t, "subject_id,name\n1,Álgebra\n", w.Body.String())
+}
+
+func TestHandler_ExportStudentRecord_Error(t *testing.T) {
+	tt := []struct {
+		name         string
+		serviceError error
+		expectedCode int
+	}{
+		{
+			name:         "invalid format",
+			serviceError: service.ErrInvalidExportFormat,
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "career not found",
+			serviceError: service.ErrNotFound,
+			expectedCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// Given
+			wrapper := wrapperMock{}
+			service_ := serviceMock{}
+			service_.On("ExportStudentRecord", "example@gmail.com", "1", "json").Return(service.Export{}, tc.serviceError)
+
+			h := NewHandler(&wrapper, &service_)
+			h.ExportStudentRecord()
+
+			w := httptest.NewRecorder()
+			r, _ := http.NewRequest("GET", "whocares", nil)
+			r = mux.SetURLVars(r, map[string]string{
+				"studentEmail": "example@gmail.com",
+				"careerID":     "1",
+			})
+
+			// When
+			err := wrapper.f(w, r)
+			if err == nil {
+				t.Fatal("test must fail")
+			}
+
+			// Then
+			hErr := err.(*server.Error)
+			require.Equal(t, tc.expectedCode, hErr.StatusCode)
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"gopkg.in/go-playground/validator.v9"
 	"net/http"
+	"strconv"
 
 	"github.com/gorilla/mux"
 
@@ -17,12 +18,72 @@ type Wrapper interface {
 }
 
 type Service interface {
-	CreateStudent(name, studentEmail string) error
-	AssignStudentToCareer(studentEmail, careerID string) error
+	CreateStudent(name, studentEmail, password string) error
+	Login(studentEmail, password string) ([]byte, error)
+	Authenticate(token string) (service.Identity, error)
+	GetStudentEmail(studentID string) (string, error)
+	GetStudent(studentID string) ([]byte, error)
+	UpdateStudent(req service.UpdateStudentRequest) error
+	ChangeStudentEmail(req service.ChangeStudentEmailRequest) ([]byte, error)
+	DeleteStudent(studentID string) error
+	AssignStudentToCareer(studentEmail, careerID string) ([]byte, error)
+	UnassignStudentFromCareer(studentEmail, careerID string) error
+	TransferStudentCareer(studentEmail, fromCareerID, toCareerID string) ([]byte, error)
 	GetStudentSubjects(studentEmail, careerID string) ([]byte, error)
 	UpdateStudentSubject(req service.UpdateStudentSubjectRequest) error
 	GetSubjectDetails(subjectID, careerID string) ([]byte, error)
-	GetProfessorships(subjectID, careerID string) ([]byte, error)
+	GetProfessorships(subjectID, careerID, term string, includeProfessors bool) ([]byte, error)
+	GetFaculties() ([]byte, error)
+	GetFacultyCareers(facultyID string) ([]byte, error)
+	GetCareer(careerID string) ([]byte, error)
+	GetProfessor(professorID string) ([]byte, error)
+	GetMaterials(professorshipID string) ([]byte, error)
+	CreateMaterial(req service.CreateMaterialRequest) ([]byte, error)
+	UpdateMaterial(req service.UpdateMaterialRequest) error
+	DeleteMaterial(professorshipID, materialID string) error
+	CreateExam(req service.CreateExamRequest) ([]byte, error)
+	GetStudentCareerSummary(studentEmail, careerID string) ([]byte, error)
+	GetAvailableSubjects(studentEmail, careerID string) ([]byte, error)
+	CheckScheduleConflicts(professorshipIDs []int) ([]byte, error)
+	GenerateTimetables(req service.GenerateTimetablesRequest) ([]byte, error)
+	EnrollStudentInProfessorship(studentEmail, professorshipID string) error
+	DeleteStudentProfessorship(studentEmail, professorshipID string) error
+	GetStudentCalendar(studentEmail, term string) ([]byte, error)
+	CreateCalendarToken(studentEmail string) ([]byte, error)
+	RevokeCalendarToken(studentEmail string) error
+	GetCalendarByToken(token, term string) ([]byte, error)
+	ExportStudentRecord(studentEmail, careerID, format string) (service.Export, error)
+	CreateFaculty(req service.FacultyRequest) ([]byte, error)
+	UpdateFaculty(facultyID string, req service.FacultyRequest) error
+	DeleteFaculty(facultyID string) error
+	CreateCareer(req service.CareerRequest) ([]byte, error)
+	UpdateCareer(careerID string, req service.CareerRequest) error
+	DeleteCareer(careerID string) error
+	CreateSubject(req service.SubjectRequest) ([]byte, error)
+	UpdateSubject(subjectID string, req service.SubjectRequest) error
+	DeleteSubject(subjectID string) error
+	CreateCareerSubject(req service.CareerSubjectRequest) ([]byte, error)
+	UpdateCareerSubject(req service.CareerSubjectRequest) error
+	DeleteCareerSubject(careerID, subjectID string) error
+	CreateProfessorship(req service.ProfessorshipRequest) ([]byte, error)
+	UpdateProfessorship(req service.ProfessorshipRequest) error
+	DeleteProfessorship(professorshipID string) error
+	CreateSchedule(req service.ScheduleRequest) ([]byte, error)
+	UpdateSchedule(req service.ScheduleRequest) error
+	DeleteSchedule(professorshipID, scheduleID string) error
+	CreateTerm(req service.TermRequest) ([]byte, error)
+	UpdateTerm(termID string, req service.TermRequest) error
+	ImportCareerPlan(careerID string, plan service.CareerPlan, dryRun bool) ([]byte, error)
+	CreateEquivalence(req service.EquivalenceRequest) ([]byte, error)
+	DeleteEquivalence(equivalenceID string) error
+	GetEquivalences(careerID string) ([]byte, error)
+	RequestEquivalence(req service.StudentEquivalenceRequest) ([]byte, error)
+	GetStudentEquivalences(studentEmail, careerID string) ([]byte, error)
+	GetEquivalenceRequests(status string) ([]byte, error)
+	ResolveEquivalenceRequest(requestID, status string) error
+	GetFacultyPolicy(facultyID string) ([]byte, error)
+	UpdateFacultyPolicy(facultyID string, req service.FacultyPolicy) error
+	Search(req service.SearchRequest) ([]byte, error)
 }
 
 type Handler struct {
@@ -42,17 +103,14 @@ func (h *Handler) CreateStudent() {
 		var studentInformation struct {
 			Name         string `json:"name" validate:"required"`
 			StudentEmail string `json:"student_email" validate:"required"`
+			Password     string `json:"password" validate:"required,min=8"`
 		}
 
-		if err := json.NewDecoder(r.Body).Decode(&studentInformation); err != nil {
-			return server.NewError(err.Error(), http.StatusUnprocessableEntity)
-		}
-
-		if err := validate.Struct(studentInformation); err != nil {
-			return server.NewError(err.Error(), http.StatusBadRequest)
+		if err := decodeAndValidate(r, &studentInformation); err != nil {
+			return err
 		}
 
-		if err := h.service.CreateStudent(studentInformation.Name, studentInformation.StudentEmail); err != nil {
+		if err := h.service.CreateStudent(studentInformation.Name, studentInformation.StudentEmail, studentInformation.Password); err != nil {
 			if errors.Is(err, service.ErrStudentAlreadyExist) {
 				return server.NewError(err.Error(), http.StatusConflict)
 			}
@@ -68,18 +126,18 @@ func (h *Handler) CreateStudent() {
 
 func (h *Handler) AssignStudentToCareer() {
 	wrapH := func(w http.ResponseWriter, r *http.Request) error {
-		params := mux.Vars(r)
-		studentEmail, exist := params["studentEmail"]
-		if !exist || studentEmail == "" {
-			return server.NewError("student email is required", http.StatusBadRequest)
+		studentEmail, err := requiredParam(r, "studentEmail", "student email")
+		if err != nil {
+			return err
 		}
 
-		careerID, exist := params["careerID"]
-		if !exist || careerID == "" {
-			return server.NewError("career id is required", http.StatusBadRequest)
+		careerID, err := requiredParam(r, "careerID", "career id")
+		if err != nil {
+			return err
 		}
 
-		if err := h.service.AssignStudentToCareer(studentEmail, careerID); err != nil {
+		response, err := h.service.AssignStudentToCareer(studentEmail, careerID)
+		if err != nil {
 			switch {
 			case errors.Is(err, service.ErrNotFound):
 				return server.NewError(err.Error(), http.StatusNotFound)
@@ -92,27 +150,25 @@ func (h *Handler) AssignStudentToCareer() {
 			}
 		}
 
-		return nil
+		return server.RespondJSON(w, response, http.StatusOK)
 	}
 
-	h.wrapper.Wrap(http.MethodPost, "/students/{studentEmail}/careers/{careerID}", wrapH)
+	h.wrapStudent(http.MethodPost, "/careers/{careerID}", wrapH)
 }
 
-func (h *Handler) GetStudentSubjects() {
+func (h *Handler) UnassignStudentFromCareer() {
 	wrapH := func(w http.ResponseWriter, r *http.Request) error {
-		params := mux.Vars(r)
-		studentEmail, exist := params["studentEmail"]
-		if !exist || studentEmail == "" {
-			return server.NewError("student email is required", http.StatusBadRequest)
+		studentEmail, err := requiredParam(r, "studentEmail", "student email")
+		if err != nil {
+			return err
 		}
 
-		careerID, exist := params["careerID"]
-		if !exist || careerID == "" {
-			return server.NewError("career id is required", http.StatusBadRequest)
+		careerID, err := requiredParam(r, "careerID", "career id")
+		if err != nil {
+			return err
 		}
 
-		studentSubjects, err := h.service.GetStudentSubjects(studentEmail, careerID)
-		if err != nil {
+		if err := h.service.UnassignStudentFromCareer(studentEmail, careerID); err != nil {
 			if errors.Is(err, service.ErrNotFound) {
 				return server.NewError(err.Error(), http.StatusNotFound)
 			}
@@ -120,76 +176,179 @@ func (h *Handler) GetStudentSubjects() {
 			return err
 		}
 
+		return server.RespondJSON(w, nil, http.StatusNoContent)
+	}
+
+	h.wrapStudent(http.MethodDelete, "/careers/{careerID}", wrapH)
+}
+
+func (h *Handler) TransferStudentCareer() {
+	wrapH := func(w http.ResponseWriter, r *http.Request) error {
+		studentEmail, err := requiredParam(r, "studentEmail", "student email")
+		if err != nil {
+			return err
+		}
+
+		careerID, err := requiredParam(r, "careerID", "career id")
+		if err != nil {
+			return err
+		}
+
+		var transfer struct {
+			CareerID int `json:"career_id" validate:"required"`
+		}
+
+		if err := decodeAndValidate(r, &transfer); err != nil {
+			return err
+		}
+
+		response, err := h.service.TransferStudentCareer(studentEmail, careerID, strconv.Itoa(transfer.CareerID))
+		if err != nil {
+			switch {
+			case errors.Is(err, service.ErrNotFound):
+				return server.NewError(err.Error(), http.StatusNotFound)
+			case errors.Is(err, service.ErrSameCareerTransfer), errors.Is(err, service.ErrStatusNotAllowed):
+				return server.NewError(err.Error(), http.StatusBadRequest)
+			case errors.Is(err, service.ErrMaxCareerReached):
+				return server.NewError(err.Error(), http.StatusConflict)
+			default:
+				return err
+			}
+		}
+
+		return server.RespondJSON(w, response, http.StatusOK)
+	}
+
+	h.wrapStudent(http.MethodPost, "/careers/{careerID}/transfer", wrapH)
+}
+
+func (h *Handler) GetStudentSubjects() {
+	wrapH := func(w http.ResponseWriter, r *http.Request) error {
+		studentEmail, err := requiredParam(r, "studentEmail", "student email")
+		if err != nil {
+			return err
+		}
+
+		careerID, err := requiredParam(r, "careerID", "career id")
+		if err != nil {
+			return err
+		}
+
+		studentSubjects, err := h.service.GetStudentSubjects(studentEmail, careerID)
+		if err != nil {
+			switch {
+			case errors.Is(err, service.ErrNotFound):
+				return server.NewError(err.Error(), http.StatusNotFound)
+			case errors.Is(err, service.ErrCorrelativesCycle):
+				return server.NewError(err.Error(), http.StatusConflict)
+			default:
+				return err
+			}
+		}
+
 		return server.RespondJSON(w, studentSubjects, http.StatusOK)
 	}
 
-	h.wrapper.Wrap(http.MethodGet, "/students/{studentEmail}/careers/{careerID}/subjects", wrapH)
+	h.wrapStudent(http.MethodGet, "/careers/{careerID}/subjects", wrapH)
 }
 
 var validate = validator.New()
 
+func requiredParam(r *http.Request, key, name string) (string, error) {
+	value, exist := mux.Vars(r)[key]
+	if !exist || value == "" {
+		return "", server.NewError(name+" is required", http.StatusBadRequest)
+	}
+
+	return value, nil
+}
+
+func decodeAndValidate(r *http.Request, v interface{}) error {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		return server.NewError(err.Error(), http.StatusUnprocessableEntity)
+	}
+
+	if err := validate.Struct(v); err != nil {
+		return server.NewError(err.Error(), http.StatusBadRequest)
+	}
+
+	return nil
+}
+
 func (h *Handler) UpdateStudentSubject() {
 	wrapH := func(w http.ResponseWriter, r *http.Request) error {
-		params := mux.Vars(r)
-		studentEmail, exist := params["studentEmail"]
-		if !exist || studentEmail == "" {
-			return server.NewError("student email is required", http.StatusBadRequest)
+		studentEmail, err := requiredParam(r, "studentEmail", "student email")
+		if err != nil {
+			return err
 		}
 
-		careerID, exist := params["careerID"]
-		if !exist || careerID == "" {
-			return server.NewError("career id is required", http.StatusBadRequest)
+		careerID, err := requiredParam(r, "careerID", "career id")
+		if err != nil {
+			return err
 		}
 
-		subjectID, exist := params["subjectID"]
-		if !exist || subjectID == "" {
-			return server.NewError("subject id is required", http.StatusBadRequest)
+		subjectID, err := requiredParam(r, "subjectID", "subject id")
+		if err != nil {
+			return err
 		}
 
 		var subjectInformation struct {
-			Status      string `json:"status" validate:"required,oneof=PENDIENTE APROBADA"`
+			Status      string `json:"status" validate:"required,oneof=PENDIENTE CURSANDO REGULARIZADA APROBADA LIBRE RECURSANDO"`
 			Description string `json:"description" validate:"omitempty,min=1,max=128"`
+			Term        string `json:"term"`
+
+			// OverrideCorrelatives skips the correlatives check and OverrideStatus, restricted to admins, the
+			// status transitions.
+			OverrideCorrelatives bool `json:"override_correlatives"`
+			OverrideStatus       bool `json:"override_status"`
 		}
 
-		if err := json.NewDecoder(r.Body).Decode(&subjectInformation); err != nil {
-			return server.NewError(err.Error(), http.StatusUnprocessableEntity)
+		if err := decodeAndValidate(r, &subjectInformation); err != nil {
+			return err
 		}
 
-		if err := validate.Struct(subjectInformation); err != nil {
-			return server.NewError(err.Error(), http.StatusBadRequest)
+		if identity, _ := identityFromRequest(r); subjectInformation.OverrideStatus && !identity.IsAdmin() {
+			return server.NewError("override status is restricted to admins", http.StatusForbidden)
 		}
 
 		if err := h.service.UpdateStudentSubject(service.UpdateStudentSubjectRequest{
-			StudentEmail: studentEmail,
-			CareerID:     careerID,
-			SubjectID:    subjectID,
-			Status:       subjectInformation.Status,
-			Description:  subjectInformation.Description,
+			StudentEmail:         studentEmail,
+			CareerID:             careerID,
+			SubjectID:            subjectID,
+			Status:               subjectInformation.Status,
+			Description:          subjectInformation.Description,
+			Term:                 subjectInformation.Term,
+			OverrideCorrelatives: subjectInformation.OverrideCorrelatives,
+			OverrideStatus:       subjectInformation.OverrideStatus,
 		}); err != nil {
-			if errors.Is(err, service.ErrNotFound) {
+			switch {
+			case errors.Is(err, service.ErrNotFound):
 				return server.NewError(err.Error(), http.StatusNotFound)
+			case errors.Is(err, service.ErrCorrelativesNotMet), errors.Is(err, service.ErrInvalidStatusTransition), errors.Is(err, service.ErrCorrelativesCycle):
+				return server.NewError(err.Error(), http.StatusConflict)
+			case errors.Is(err, service.ErrInvalidTerm), errors.Is(err, service.ErrStatusNotAllowed):
+				return server.NewError(err.Error(), http.StatusBadRequest)
+			default:
+				return err
 			}
-
-			return err
 		}
 
 		return server.RespondJSON(w, nil, http.StatusOK)
 	}
 
-	h.wrapper.Wrap(http.MethodPut, "/students/{studentEmail}/careers/{careerID}/subjects/{subjectID}", wrapH)
+	h.wrapStudent(http.MethodPut, "/careers/{careerID}/subjects/{subjectID}", wrapH)
 }
 
 func (h *Handler) GetSubjectDetails() {
 	wrapH := func(w http.ResponseWriter, r *http.Request) error {
-		params := mux.Vars(r)
-		careerID, exist := params["careerID"]
-		if !exist || careerID == "" {
-			return server.NewError("career id is required", http.StatusBadRequest)
+		careerID, err := requiredParam(r, "careerID", "career id")
+		if err != nil {
+			return err
 		}
 
-		subjectID, exist := params["subjectID"]
-		if !exist || subjectID == "" {
-			return server.NewError("subject id is required", http.StatusBadRequest)
+		subjectID, err := requiredParam(r, "subjectID", "subject id")
+		if err != nil {
+			return err
 		}
 
 		subjectDetails, err := h.service.GetSubjectDetails(subjectID, careerID)
@@ -209,24 +368,33 @@ func (h *Handler) GetSubjectDetails() {
 
 func (h *Handler) GetProfessorships() {
 	wrapH := func(w http.ResponseWriter, r *http.Request) error {
-		params := mux.Vars(r)
-		careerID, exist := params["careerID"]
-		if !exist || careerID == "" {
-			return server.NewError("career id is required", http.StatusBadRequest)
+		careerID, err := requiredParam(r, "careerID", "career id")
+		if err != nil {
+			return err
 		}
 
-		subjectID, exist := params["subjectID"]
-		if !exist || subjectID == "" {
-			return server.NewError("subject id is required", http.StatusBadRequest)
+		subjectID, err := requiredParam(r, "subjectID", "subject id")
+		if err != nil {
+			return err
+		}
+
+		var includeProfessors bool
+		if v := r.URL.Query().Get("include_professors"); v != "" {
+			if includeProfessors, err = strconv.ParseBool(v); err != nil {
+				return server.NewError("include_professors must be a boolean", http.StatusBadRequest)
+			}
 		}
 
-		professorships, err := h.service.GetProfessorships(subjectID, careerID)
+		professorships, err := h.service.GetProfessorships(subjectID, careerID, r.URL.Query().Get("term"), includeProfessors)
 		if err != nil {
-			if errors.Is(err, service.ErrNotFound) {
+			switch {
+			case errors.Is(err, service.ErrNotFound):
 				return server.NewError(err.Error(), http.StatusNotFound)
+			case errors.Is(err, service.ErrInvalidTerm):
+				return server.NewError(err.Error(), http.StatusBadRequest)
+			default:
+				return err
 			}
-
-			return err
 		}
 
 		return server.RespondJSON(w, professorships, http.StatusOK)
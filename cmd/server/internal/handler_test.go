@@ -2,6 +2,7 @@ package internal
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"io"
@@ -29,13 +30,55 @@ type serviceMock struct {
 	mock.Mock
 }
 
-func (s *serviceMock) CreateStudent(name, studentEmail string) error {
-	return s.Called(name, studentEmail).Error(0)
+func (s *serviceMock) CreateStudent(name, studentEmail, password string) error {
+	return s.Called(name, studentEmail, password).Error(0)
 }
 
-func (s *serviceMock) AssignStudentToCareer(studentEmail, careerID string) error {
+func (s *serviceMock) Login(studentEmail, password string) ([]byte, error) {
+	args := s.Called(studentEmail, password)
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (s *serviceMock) UnassignStudentFromCareer(studentEmail, careerID string) error {
+	return s.Called(studentEmail, careerID).Error(0)
+}
+
+func (s *serviceMock) TransferStudentCareer(studentEmail, fromCareerID, toCareerID string) ([]byte, error) {
+	args := s.Called(studentEmail, fromCareerID, toCareerID)
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (s *serviceMock) GetStudentEmail(studentID string) (string, error) {
+	args := s.Called(studentID)
+	return args.String(0), args.Error(1)
+}
+
+func (s *serviceMock) GetStudent(studentID string) ([]byte, error) {
+	args := s.Called(studentID)
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (s *serviceMock) UpdateStudent(req service.UpdateStudentRequest) error {
+	return s.Called(req).Error(0)
+}
+
+func (s *serviceMock) ChangeStudentEmail(req service.ChangeStudentEmailRequest) ([]byte, error) {
+	args := s.Called(req)
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (s *serviceMock) DeleteStudent(studentID string) error {
+	return s.Called(studentID).Error(0)
+}
+
+func (s *serviceMock) Authenticate(token string) (service.Identity, error) {
+	args := s.Called(token)
+	return args.Get(0).(service.Identity), args.Error(1)
+}
+
+func (s *serviceMock) AssignStudentToCareer(studentEmail, careerID string) ([]byte, error) {
 	args := s.Called(studentEmail, careerID)
-	return args.Error(0)
+	return args.Get(0).([]byte), args.Error(1)
 }
 
 func (s *serviceMock) GetStudentSubjects(studentEmail, careerID string) ([]byte, error) {
@@ -53,8 +96,242 @@ func (s *serviceMock) GetSubjectDetails(subjectID, careerID string) ([]byte, err
 	return args.Get(0).([]byte), args.Error(1)
 }
 
-func (s *serviceMock) GetProfessorships(subjectID, careerID string) ([]byte, error) {
-	args := s.Called(subjectID, careerID)
+func (s *serviceMock) GetProfessorships(subjectID, careerID, term string, includeProfessors bool) ([]byte, error) {
+	args := s.Called(subjectID, careerID, term, includeProfessors)
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (s *serviceMock) GetFaculties() ([]byte, error) {
+	args := s.Called()
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (s *serviceMock) GetFacultyCareers(facultyID string) ([]byte, error) {
+	args := s.Called(facultyID)
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (s *serviceMock) GetCareer(careerID string) ([]byte, error) {
+	args := s.Called(careerID)
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (s *serviceMock) GetProfessor(professorID string) ([]byte, error) {
+	args := s.Called(professorID)
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (s *serviceMock) GetMaterials(professorshipID string) ([]byte, error) {
+	args := s.Called(professorshipID)
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (s *serviceMock) CreateMaterial(req service.CreateMaterialRequest) ([]byte, error) {
+	args := s.Called(req)
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (s *serviceMock) UpdateMaterial(req service.UpdateMaterialRequest) error {
+	return s.Called(req).Error(0)
+}
+
+func (s *serviceMock) DeleteMaterial(professorshipID, materialID string) error {
+	return s.Called(professorshipID, materialID).Error(0)
+}
+
+func (s *serviceMock) CreateExam(req service.CreateExamRequest) ([]byte, error) {
+	args := s.Called(req)
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (s *serviceMock) GetStudentCareerSummary(studentEmail, careerID string) ([]byte, error) {
+	args := s.Called(studentEmail, careerID)
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (s *serviceMock) GetAvailableSubjects(studentEmail, careerID string) ([]byte, error) {
+	args := s.Called(studentEmail, careerID)
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (s *serviceMock) CheckScheduleConflicts(professorshipIDs []int) ([]byte, error) {
+	args := s.Called(professorshipIDs)
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (s *serviceMock) GenerateTimetables(req service.GenerateTimetablesRequest) ([]byte, error) {
+	args := s.Called(req)
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (s *serviceMock) EnrollStudentInProfessorship(studentEmail, professorshipID string) error {
+	return s.Called(studentEmail, professorshipID).Error(0)
+}
+
+func (s *serviceMock) DeleteStudentProfessorship(studentEmail, professorshipID string) error {
+	return s.Called(studentEmail, professorshipID).Error(0)
+}
+
+func (s *serviceMock) GetStudentCalendar(studentEmail, term string) ([]byte, error) {
+	args := s.Called(studentEmail, term)
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (s *serviceMock) CreateCalendarToken(studentEmail string) ([]byte, error) {
+	args := s.Called(studentEmail)
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (s *serviceMock) RevokeCalendarToken(studentEmail string) error {
+	return s.Called(studentEmail).Error(0)
+}
+
+func (s *serviceMock) GetCalendarByToken(token, term string) ([]byte, error) {
+	args := s.Called(token, term)
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (s *serviceMock) ExportStudentRecord(studentEmail, careerID, format string) (service.Export, error) {
+	args := s.Called(studentEmail, careerID, format)
+	return args.Get(0).(service.Export), args.Error(1)
+}
+
+func (s *serviceMock) CreateFaculty(req service.FacultyRequest) ([]byte, error) {
+	args := s.Called(req)
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (s *serviceMock) UpdateFaculty(facultyID string, req service.FacultyRequest) error {
+	return s.Called(facultyID, req).Error(0)
+}
+
+func (s *serviceMock) DeleteFaculty(facultyID string) error {
+	return s.Called(facultyID).Error(0)
+}
+
+func (s *serviceMock) CreateCareer(req service.CareerRequest) ([]byte, error) {
+	args := s.Called(req)
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (s *serviceMock) UpdateCareer(careerID string, req service.CareerRequest) error {
+	return s.Called(careerID, req).Error(0)
+}
+
+func (s *serviceMock) DeleteCareer(careerID string) error {
+	return s.Called(careerID).Error(0)
+}
+
+func (s *serviceMock) CreateSubject(req service.SubjectRequest) ([]byte, error) {
+	args := s.Called(req)
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (s *serviceMock) UpdateSubject(subjectID string, req service.SubjectRequest) error {
+	return s.Called(subjectID, req).Error(0)
+}
+
+func (s *serviceMock) DeleteSubject(subjectID string) error {
+	return s.Called(subjectID).Error(0)
+}
+
+func (s *serviceMock) CreateCareerSubject(req service.CareerSubjectRequest) ([]byte, error) {
+	args := s.Called(req)
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (s *serviceMock) UpdateCareerSubject(req service.CareerSubjectRequest) error {
+	return s.Called(req).Error(0)
+}
+
+func (s *serviceMock) DeleteCareerSubject(careerID, subjectID string) error {
+	return s.Called(careerID, subjectID).Error(0)
+}
+
+func (s *serviceMock) CreateProfessorship(req service.ProfessorshipRequest) ([]byte, error) {
+	args := s.Called(req)
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (s *serviceMock) UpdateProfessorship(req service.ProfessorshipRequest) error {
+	return s.Called(req).Error(0)
+}
+
+func (s *serviceMock) DeleteProfessorship(professorshipID string) error {
+	return s.Called(professorshipID).Error(0)
+}
+
+func (s *serviceMock) CreateSchedule(req service.ScheduleRequest) ([]byte, error) {
+	args := s.Called(req)
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (s *serviceMock) UpdateSchedule(req service.ScheduleRequest) error {
+	return s.Called(req).Error(0)
+}
+
+func (s *serviceMock) DeleteSchedule(professorshipID, scheduleID string) error {
+	return s.Called(professorshipID, scheduleID).Error(0)
+}
+
+func (s *serviceMock) CreateTerm(req service.TermRequest) ([]byte, error) {
+	args := s.Called(req)
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (s *serviceMock) UpdateTerm(termID string, req service.TermRequest) error {
+	return s.Called(termID, req).Error(0)
+}
+
+func (s *serviceMock) ImportCareerPlan(careerID string, plan service.CareerPlan, dryRun bool) ([]byte, error) {
+	args := s.Called(careerID, plan, dryRun)
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (s *serviceMock) CreateEquivalence(req service.EquivalenceRequest) ([]byte, error) {
+	args := s.Called(req)
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (s *serviceMock) DeleteEquivalence(equivalenceID string) error {
+	return s.Called(equivalenceID).Error(0)
+}
+
+func (s *serviceMock) GetEquivalences(careerID string) ([]byte, error) {
+	args := s.Called(careerID)
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (s *serviceMock) RequestEquivalence(req service.StudentEquivalenceRequest) ([]byte, error) {
+	args := s.Called(req)
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (s *serviceMock) GetStudentEquivalences(studentEmail, careerID string) ([]byte, error) {
+	args := s.Called(studentEmail, careerID)
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (s *serviceMock) GetEquivalenceRequests(status string) ([]byte, error) {
+	args := s.Called(status)
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (s *serviceMock) ResolveEquivalenceRequest(requestID, status string) error {
+	return s.Called(requestID, status).Error(0)
+}
+
+func (s *serviceMock) GetFacultyPolicy(facultyID string) ([]byte, error) {
+	args := s.Called(facultyID)
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (s *serviceMock) UpdateFacultyPolicy(facultyID string, req service.FacultyPolicy) error {
+	return s.Called(facultyID, req).Error(0)
+}
+
+func (s *serviceMock) Search(req service.SearchRequest) ([]byte, error) {
+	args := s.Called(req)
 	return args.Get(0).([]byte), args.Error(1)
 }
 
@@ -62,14 +339,15 @@ func TestHandler_CreateStudent(t *testing.T) {
 	// Given
 	wrapper := wrapperMock{}
 	service_ := serviceMock{}
-	service_.On("CreateStudent", "example", "example@gmail.com").Return(nil)
+	service_.On("CreateStudent", "example", "example@gmail.com", "secret-password").Return(nil)
 
 	h := NewHandler(&wrapper, &service_)
 	h.CreateStudent()
 
 	b := bytes.NewReader([]byte(`{
 		"name": "example",
-		"student_email": "example@gmail.com"
+		"student_email": "example@gmail.com",
+		"password": "secret-password"
 	}`))
 	w := httptest.NewRecorder()
 	r, _ := http.NewRequest("POST", "whocares", b)
@@ -94,7 +372,8 @@ func TestHandler_CreateStudent_BodyValidationError(t *testing.T) {
 			name: "name is empty",
 			b: bytes.NewReader([]byte(`{
 				"name": "",
-				"student_email": "example@gmail.com"
+				"student_email": "example@gmail.com",
+				"password": "secret-password"
 			}`)),
 			expectedError: "400 bad_request: Key: 'Name' Error:Field validation for 'Name' failed on the 'required' tag",
 		},
@@ -102,10 +381,20 @@ func TestHandler_CreateStudent_BodyValidationError(t *testing.T) {
 			name: "student email is empty",
 			b: bytes.NewReader([]byte(`{
 				"name": "example",
-				"student_email": ""
+				"student_email": "",
+				"password": "secret-password"
 			}`)),
 			expectedError: "400 bad_request: Key: 'StudentEmail' Error:Field validation for 'StudentEmail' failed on the 'required' tag",
 		},
+		{
+			name: "password is too short",
+			b: bytes.NewReader([]byte(`{
+				"name": "example",
+				"student_email": "example@gmail.com",
+				"password": "secret"
+			}`)),
+			expectedError: "400 bad_request: Key: 'Password' Error:Field validation for 'Password' failed on the 'min' tag",
+		},
 	}
 
 	for _, tc := range tt {
@@ -142,7 +431,8 @@ func TestHandler_CreateStudent_BodyError(t *testing.T) {
 
 	b := bytes.NewReader([]byte(`{
 			"name": 1,
-			"student_email": "example@gmail.com"
+			"student_email": "example@gmail.com",
+			"password": "secret-password"
 		}`))
 	w := httptest.NewRecorder()
 	r, _ := http.NewRequest("POST", "whocares", b)
@@ -161,14 +451,15 @@ func TestHandler_CreateStudent_StudentAlreadyExist(t *testing.T) {
 	// Given
 	wrapper := wrapperMock{}
 	service_ := serviceMock{}
-	service_.On("CreateStudent", "example", "example@gmail.com").Return(service.ErrStudentAlreadyExist)
+	service_.On("CreateStudent", "example", "example@gmail.com", "secret-password").Return(service.ErrStudentAlreadyExist)
 
 	h := NewHandler(&wrapper, &service_)
 	h.CreateStudent()
 
 	b := bytes.NewReader([]byte(`{
 		"name": "example",
-		"student_email": "example@gmail.com"
+		"student_email": "example@gmail.com",
+		"password": "secret-password"
 	}`))
 	w := httptest.NewRecorder()
 	r, _ := http.NewRequest("POST", "whocares", b)
@@ -188,14 +479,15 @@ func TestHandler_CreateStudent_ServiceError(t *testing.T) {
 	// Given
 	wrapper := wrapperMock{}
 	service_ := serviceMock{}
-	service_.On("CreateStudent", "example", "example@gmail.com").Return(errors.New("error"))
+	service_.On("CreateStudent", "example", "example@gmail.com", "secret-password").Return(errors.New("error"))
 
 	h := NewHandler(&wrapper, &service_)
 	h.CreateStudent()
 
 	b := bytes.NewReader([]byte(`{
 				"name": "example",
-				"student_email": "example@gmail.com"
+				"student_email": "example@gmail.com",
+				"password": "secret-password"
 			}`))
 	w := httptest.NewRecorder()
 	r, _ := http.NewRequest("POST", "whocares", b)
@@ -214,7 +506,7 @@ func TestHandler_AssignStudentToCareer(t *testing.T) {
 	// Given
 	wrapper := wrapperMock{}
 	service_ := serviceMock{}
-	service_.On("AssignStudentToCareer", "example@gmail.com", "1").Return(nil)
+	service_.On("AssignStudentToCareer", "example@gmail.com", "1").Return([]byte(`{"career_id":1,"credited_subjects":[]}`), nil)
 
 	h := NewHandler(&wrapper, &service_)
 	h.AssignStudentToCareer()
@@ -233,7 +525,8 @@ func TestHandler_AssignStudentToCareer(t *testing.T) {
 	}
 
 	// Then
-	require.Nil(t, err)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, `{"career_id":1,"credited_subjects":[]}`, w.Body.String())
 }
 
 func TestHandler_AssignStudentToCareer_ParamsError(t *testing.T) {
@@ -334,7 +627,7 @@ func TestHandler_AssignStudentToCareer_ServiceError(t *testing.T) {
 			// Given
 			wrapper := wrapperMock{}
 			service_ := serviceMock{}
-			service_.On("AssignStudentToCareer", "example@gmail.com", "1").Return(tc.returnedError)
+			service_.On("AssignStudentToCareer", "example@gmail.com", "1").Return([]byte(nil), tc.returnedError)
 
 			h := NewHandler(&wrapper, &service_)
 			h.AssignStudentToCareer()
@@ -358,6 +651,102 @@ func TestHandler_AssignStudentToCareer_ServiceError(t *testing.T) {
 	}
 }
 
+func TestHandler_UnassignStudentFromCareer(t *testing.T) {
+	// Given
+	service_ := serviceMock{}
+	service_.On("UnassignStudentFromCareer", "example@gmail.com", "1").Return(nil)
+
+	sv, h := newAdminServer(&service_)
+	h.UnassignStudentFromCareer()
+
+	// When
+	w := serveAdmin(sv, http.MethodDelete, "/me/careers/1", "student", "")
+
+	// Then
+	require.Equal(t, http.StatusNoContent, w.Code)
+}
+
+func TestHandler_UnassignStudentFromCareer_NotFoundError(t *testing.T) {
+	// Given
+	service_ := serviceMock{}
+	service_.On("UnassignStudentFromCareer", "example@gmail.com", "1").Return(service.ErrNotFound)
+
+	sv, h := newAdminServer(&service_)
+	h.UnassignStudentFromCareer()
+
+	// When
+	w := serveAdmin(sv, http.MethodDelete, "/students/example@gmail.com/careers/1", "student", "")
+
+	// Then
+	require.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestHandler_TransferStudentCareer(t *testing.T) {
+	// Given
+	service_ := serviceMock{}
+	service_.On("TransferStudentCareer", "example@gmail.com", "1", "2").Return([]byte(`{"career_id":2,"transferred_subject_ids":[3]}`), nil)
+
+	sv, h := newAdminServer(&service_)
+	h.TransferStudentCareer()
+
+	// When
+	w := serveAdmin(sv, http.MethodPost, "/me/careers/1/transfer", "student", `{"career_id":2}`)
+
+	// Then
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, `{"career_id":2,"transferred_subject_ids":[3]}`, w.Body.String())
+}
+
+func TestHandler_TransferStudentCareer_Error(t *testing.T) {
+	tt := []struct {
+		name               string
+		body               string
+		returnedError      error
+		expectedStatusCode int
+	}{
+		{
+			name:               "missing career",
+			body:               `{}`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "same career",
+			body:               `{"career_id":1}`,
+			returnedError:      service.ErrSameCareerTransfer,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "not found",
+			body:               `{"career_id":2}`,
+			returnedError:      service.ErrNotFound,
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name:               "max careers",
+			body:               `{"career_id":2}`,
+			returnedError:      service.ErrMaxCareerReached,
+			expectedStatusCode: http.StatusConflict,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// Given
+			service_ := serviceMock{}
+			service_.On("TransferStudentCareer", "example@gmail.com", "1", mock.Anything).Return([]byte(nil), tc.returnedError)
+
+			sv, h := newAdminServer(&service_)
+			h.TransferStudentCareer()
+
+			// When
+			w := serveAdmin(sv, http.MethodPost, "/me/careers/1/transfer", "student", tc.body)
+
+			// Then
+			require.Equal(t, tc.expectedStatusCode, w.Code)
+		})
+	}
+}
+
 func TestHandler_GetStudentSubjects(t *testing.T) {
 	// Given
 	wrapper := wrapperMock{}
@@ -518,6 +907,34 @@ func TestHandler_GetStudentSubjects_ServiceNotFoundError(t *testing.T) {
 	require.Equal(t, "service: resource not found", hErr.Message)
 }
 
+func TestHandler_GetStudentSubjects_ServiceCorrelativesCycleError(t *testing.T) {
+	// Given
+	wrapper := wrapperMock{}
+	service_ := serviceMock{}
+	service_.On("GetStudentSubjects", "example@gmail.com", "1").Return([]byte{}, service.ErrCorrelativesCycle)
+
+	h := NewHandler(&wrapper, &service_)
+	h.GetStudentSubjects()
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest("GET", "whocares", nil)
+	r = mux.SetURLVars(r, map[string]string{
+		"studentEmail": "example@gmail.com",
+		"careerID":     "1",
+	})
+
+	// When
+	err := wrapper.f(w, r)
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	hErr := err.(*server.Error)
+	require.Equal(t, http.StatusConflict, hErr.StatusCode)
+	require.Equal(t, "service: correlatives have a cycle", hErr.Message)
+}
+
 func TestHandler_UpdateStudentSubject(t *testing.T) {
 	// Given
 	wrapper := wrapperMock{}
@@ -756,6 +1173,172 @@ func TestHandler_UpdateStudentSubject_ServiceError(t *testing.T) {
 	require.EqualError(t, err, "error")
 }
 
+func TestHandler_UpdateStudentSubject_Override(t *testing.T) {
+	// Given
+	wrapper := wrapperMock{}
+	service_ := serviceMock{}
+	service_.On("UpdateStudentSubject", service.UpdateStudentSubjectRequest{
+		StudentEmail:         "test@gmail.com",
+		CareerID:             "2",
+		SubjectID:            "1",
+		Status:               "APROBADA",
+		OverrideCorrelatives: true,
+		OverrideStatus:       true,
+	}).Return(nil)
+
+	h := NewHandler(&wrapper, &service_)
+	h.UpdateStudentSubject()
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest("PUT", "whocares", bytes.NewReader([]byte(`{"status":"APROBADA","override_correlatives":true,"override_status":true}`)))
+	r = mux.SetURLVars(r, map[string]string{
+		"studentEmail": "test@gmail.com",
+		"careerID":     "2",
+		"subjectID":    "1",
+	})
+	r = r.WithContext(context.WithValue(r.Context(), identityKey, service.Identity{StudentEmail: "admin@gmail.com", Role: service.RoleAdmin}))
+
+	// When
+	err := wrapper.f(w, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestHandler_UpdateStudentSubject_OverrideForbiddenError(t *testing.T) {
+	// Given
+	wrapper := wrapperMock{}
+
+	h := NewHandler(&wrapper, nil)
+	h.UpdateStudentSubject()
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest("PUT", "whocares", bytes.NewReader([]byte(`{"status":"APROBADA","override_status":true}`)))
+	r = mux.SetURLVars(r, map[string]string{
+		"studentEmail": "test@gmail.com",
+		"careerID":     "2",
+		"subjectID":    "1",
+	})
+	r = r.WithContext(context.WithValue(r.Context(), identityKey, service.Identity{StudentEmail: "test@gmail.com", Role: service.RoleStudent}))
+
+	// When
+	err := wrapper.f(w, r)
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "403 forbidden: override status is restricted to admins")
+}
+
+func TestHandler_UpdateStudentSubject_OverrideCorrelatives(t *testing.T) {
+	// Given
+	wrapper := wrapperMock{}
+	service_ := serviceMock{}
+	service_.On("UpdateStudentSubject", service.UpdateStudentSubjectRequest{
+		StudentEmail:         "test@gmail.com",
+		CareerID:             "2",
+		SubjectID:            "1",
+		Status:               "APROBADA",
+		OverrideCorrelatives: true,
+	}).Return(nil)
+
+	h := NewHandler(&wrapper, &service_)
+	h.UpdateStudentSubject()
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest("PUT", "whocares", bytes.NewReader([]byte(`{"status":"APROBADA","override_correlatives":true}`)))
+	r = mux.SetURLVars(r, map[string]string{
+		"studentEmail": "test@gmail.com",
+		"careerID":     "2",
+		"subjectID":    "1",
+	})
+	r = r.WithContext(context.WithValue(r.Context(), identityKey, service.Identity{StudentEmail: "test@gmail.com", Role: service.RoleStudent}))
+
+	// When
+	err := wrapper.f(w, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestHandler_UpdateStudentSubject_ServiceCorrelativesNotMetError(t *testing.T) {
+	// Given
+	wrapper := wrapperMock{}
+	service_ := serviceMock{}
+	service_.On("UpdateStudentSubject", service.UpdateStudentSubjectRequest{
+		StudentEmail: "test@gmail.com",
+		CareerID:     "2",
+		SubjectID:    "1",
+		Status:       "APROBADA",
+	}).Return(&service.CorrelativesNotMetError{Missing: []service.MissingCorrelative{
+		{ID: 3, Name: "Algebra", Requirement: "APROBADA", Status: "PENDIENTE"},
+	}})
+
+	h := NewHandler(&wrapper, &service_)
+	h.UpdateStudentSubject()
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest("PUT", "whocares", bytes.NewReader([]byte(`{"status":"APROBADA"}`)))
+	r = mux.SetURLVars(r, map[string]string{
+		"studentEmail": "test@gmail.com",
+		"careerID":     "2",
+		"subjectID":    "1",
+	})
+
+	// When
+	err := wrapper.f(w, r)
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	hErr := err.(*server.Error)
+	require.Equal(t, http.StatusConflict, hErr.StatusCode)
+	require.Equal(t, "conflict", hErr.Code)
+	require.Equal(t, "service: correlatives not met: Algebra [subject_id: 3] must be APROBADA but is PENDIENTE", hErr.Message)
+}
+
+func TestHandler_UpdateStudentSubject_ServiceInvalidStatusTransitionError(t *testing.T) {
+	// Given
+	wrapper := wrapperMock{}
+	service_ := serviceMock{}
+	service_.On("UpdateStudentSubject", service.UpdateStudentSubjectRequest{
+		StudentEmail: "test@gmail.com",
+		CareerID:     "2",
+		SubjectID:    "1",
+		Status:       "REGULARIZADA",
+	}).Return(&service.InvalidStatusTransitionError{From: "PENDIENTE", To: "REGULARIZADA", Allowed: []string{"CURSANDO"}})
+
+	h := NewHandler(&wrapper, &service_)
+	h.UpdateStudentSubject()
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest("PUT", "whocares", bytes.NewReader([]byte(`{"status":"REGULARIZADA"}`)))
+	r = mux.SetURLVars(r, map[string]string{
+		"studentEmail": "test@gmail.com",
+		"careerID":     "2",
+		"subjectID":    "1",
+	})
+
+	// When
+	err := wrapper.f(w, r)
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	hErr := err.(*server.Error)
+	require.Equal(t, http.StatusConflict, hErr.StatusCode)
+	require.Equal(t, "service: invalid status transition: from PENDIENTE to REGULARIZADA, allowed: [CURSANDO]", hErr.Message)
+}
+
 func TestHandler_UpdateStudentSubject_ServiceNotFoundError(t *testing.T) {
 	// Given
 	wrapper := wrapperMock{}
@@ -951,7 +1534,7 @@ func TestHandler_GetProfessorships(t *testing.T) {
 	// Given
 	wrapper := wrapperMock{}
 	service_ := serviceMock{}
-	service_.On("GetProfessorships", "1", "2").Return([]byte(`{"professorship": null}`), nil)
+	service_.On("GetProfessorships", "1", "2", "", false).Return([]byte(`{"professorship": null}`), nil)
 
 	h := NewHandler(&wrapper, &service_)
 	h.GetProfessorships()
@@ -1051,7 +1634,7 @@ func TestHandler_GetProfessorships_ServiceError(t *testing.T) {
 	// Given
 	wrapper := wrapperMock{}
 	service_ := serviceMock{}
-	service_.On("GetProfessorships", "1", "2").Return([]byte{}, errors.New("error"))
+	service_.On("GetProfessorships", "1", "2", "", false).Return([]byte{}, errors.New("error"))
 
 	h := NewHandler(&wrapper, &service_)
 	h.GetProfessorships()
@@ -1077,7 +1660,7 @@ func TestHandler_GetProfessorships_ServiceNotFoundError(t *testing.T) {
 	// Given
 	wrapper := wrapperMock{}
 	service_ := serviceMock{}
-	service_.On("GetProfessorships", "1", "2").Return([]byte{}, service.ErrNotFound)
+	service_.On("GetProfessorships", "1", "2", "", false).Return([]byte{}, service.ErrNotFound)
 
 	h := NewHandler(&wrapper, &service_)
 	h.GetProfessorships()
@@ -1101,3 +1684,146 @@ func TestHandler_GetProfessorships_ServiceNotFoundError(t *testing.T) {
 	require.Equal(t, "not_found", hErr.Code)
 	require.Equal(t, "service: resource not found", hErr.Message)
 }
+
+func TestHandler_GetProfessorships_TermQuery(t *testing.T) {
+	// Given
+	wrapper := wrapperMock{}
+	service_ := serviceMock{}
+	service_.On("GetProfessorships", "1", "2", "2021-1", false).Return([]byte(`{}`), nil)
+
+	h := NewHandler(&wrapper, &service_)
+	h.GetProfessorships()
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest("GET", "whocares?term=2021-1", nil)
+	r = mux.SetURLVars(r, map[string]string{
+		"subjectID": "1",
+		"careerID":  "2",
+	})
+
+	// When
+	err := wrapper.f(w, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, `{}`, w.Body.String())
+}
+
+func TestHandler_GetProfessorships_IncludeProfessorsQuery(t *testing.T) {
+	// Given
+	wrapper := wrapperMock{}
+	service_ := serviceMock{}
+	service_.On("GetProfessorships", "1", "2", "", true).Return([]byte(`{}`), nil)
+
+	h := NewHandler(&wrapper, &service_)
+	h.GetProfessorships()
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest("GET", "whocares?include_professors=true", nil)
+	r = mux.SetURLVars(r, map[string]string{
+		"subjectID": "1",
+		"careerID":  "2",
+	})
+
+	// When
+	err := wrapper.f(w, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, `{}`, w.Body.String())
+}
+
+func TestHandler_GetProfessorships_IncludeProfessorsQueryError(t *testing.T) {
+	// Given
+	wrapper := wrapperMock{}
+	service_ := serviceMock{}
+
+	h := NewHandler(&wrapper, &service_)
+	h.GetProfessorships()
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest("GET", "whocares?include_professors=maybe", nil)
+	r = mux.SetURLVars(r, map[string]string{
+		"subjectID": "1",
+		"careerID":  "2",
+	})
+
+	// When
+	err := wrapper.f(w, r)
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	hErr := err.(*server.Error)
+	require.Equal(t, http.StatusBadRequest, hErr.StatusCode)
+	require.Equal(t, "include_professors must be a boolean", hErr.Message)
+	service_.AssertNotCalled(t, "GetProfessorships")
+}
+
+func TestHandler_GetProfessorships_ServiceInvalidTermError(t *testing.T) {
+	// Given
+	wrapper := wrapperMock{}
+	service_ := serviceMock{}
+	service_.On("GetProfessorships", "1", "2", "2021", false).Return([]byte{}, service.ErrInvalidTerm)
+
+	h := NewHandler(&wrapper, &service_)
+	h.GetProfessorships()
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest("GET", "whocares?term=2021", nil)
+	r = mux.SetURLVars(r, map[string]string{
+		"subjectID": "1",
+		"careerID":  "2",
+	})
+
+	// When
+	err := wrapper.f(w, r)
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	hErr := err.(*server.Error)
+	require.Equal(t, http.StatusBadRequest, hErr.StatusCode)
+	require.Equal(t, "service: invalid term", hErr.Message)
+}
+
+func TestHandler_UpdateStudentSubject_WithTerm(t *testing.T) {
+	// Given
+	wrapper := wrapperMock{}
+	service_ := serviceMock{}
+	service_.On("UpdateStudentSubject", service.UpdateStudentSubjectRequest{
+		StudentEmail: "example@gmail.com",
+		CareerID:     "1",
+		SubjectID:    "1",
+		Status:       "APROBADA",
+		Term:         "2021-1",
+	}).Return(nil)
+
+	h := NewHandler(&wrapper, &service_)
+	h.UpdateStudentSubject()
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest("PUT", "whocares", bytes.NewReader([]byte(`{"status":"APROBADA","term":"2021-1"}`)))
+	r = mux.SetURLVars(r, map[string]string{
+		"studentEmail": "example@gmail.com",
+		"careerID":     "1",
+		"subjectID":    "1",
+	})
+
+	// When
+	err := wrapper.f(w, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Equal(t, http.StatusOK, w.Code)
+}
@@ -0,0 +1,130 @@
+package internal
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service"
+	"github.com/mateoferrari97/Kit/web/server"
+)
+
+type materialInformation struct {
+	URI         string `json:"uri" validate:"required,url,max=128"`
+	Description string `json:"description" validate:"required,min=1,max=128"`
+}
+
+func (h *Handler) GetMaterials() {
+	wrapH := func(w http.ResponseWriter, r *http.Request) error {
+		professorshipID, err := requiredParam(r, "professorshipID", "professorship id")
+		if err != nil {
+			return err
+		}
+
+		materials, err := h.service.GetMaterials(professorshipID)
+		if err != nil {
+			if errors.Is(err, service.ErrNotFound) {
+				return server.NewError(err.Error(), http.StatusNotFound)
+			}
+
+			return err
+		}
+
+		return server.RespondJSON(w, materials, http.StatusOK)
+	}
+
+	h.wrapper.Wrap(http.MethodGet, "/professorships/{professorshipID}/materials", wrapH)
+}
+
+func (h *Handler) CreateMaterial() {
+	wrapH := func(w http.ResponseWriter, r *http.Request) error {
+		professorshipID, err := requiredParam(r, "professorshipID", "professorship id")
+		if err != nil {
+			return err
+		}
+
+		var material materialInformation
+		if err := decodeAndValidate(r, &material); err != nil {
+			return err
+		}
+
+		response, err := h.service.CreateMaterial(service.CreateMaterialRequest{
+			ProfessorshipID: professorshipID,
+			URI:             material.URI,
+			Description:     material.Description,
+		})
+
+		if err != nil {
+			if errors.Is(err, service.ErrNotFound) {
+				return server.NewError(err.Error(), http.StatusNotFound)
+			}
+
+			return err
+		}
+
+		return server.RespondJSON(w, response, http.StatusCreated)
+	}
+
+	h.wrapAdmin(http.MethodPost, "/professorships/{professorshipID}/materials", wrapH)
+}
+
+func (h *Handler) UpdateMaterial() {
+	wrapH := func(w http.ResponseWriter, r *http.Request) error {
+		professorshipID, err := requiredParam(r, "professorshipID", "professorship id")
+		if err != nil {
+			return err
+		}
+
+		materialID, err := requiredParam(r, "materialID", "material id")
+		if err != nil {
+			return err
+		}
+
+		var material materialInformation
+		if err := decodeAndValidate(r, &material); err != nil {
+			return err
+		}
+
+		if err := h.service.UpdateMaterial(service.UpdateMaterialRequest{
+			ProfessorshipID: professorshipID,
+			MaterialID:      materialID,
+			URI:             material.URI,
+			Description:     material.Description,
+		}); err != nil {
+			if errors.Is(err, service.ErrNotFound) {
+				return server.NewError(err.Error(), http.StatusNotFound)
+			}
+
+			return err
+		}
+
+		return server.RespondJSON(w, nil, http.StatusOK)
+	}
+
+	h.wrapAdmin(http.MethodPut, "/professorships/{professorshipID}/materials/{materialID}", wrapH)
+}
+
+func (h *Handler) DeleteMaterial() {
+	wrapH := func(w http.ResponseWriter, r *http.Request) error {
+		professorshipID, err := requiredParam(r, "professorshipID", "professorship id")
+		if err != nil {
+			return err
+		}
+
+		materialID, err := requiredParam(r, "materialID", "material id")
+		if err != nil {
+			return err
+		}
+
+		if err := h.service.DeleteMaterial(professorshipID, materialID); err != nil {
+			if errors.Is(err, service.ErrNotFound) {
+				return server.NewError(err.Error(), http.StatusNotFound)
+			}
+
+			return err
+		}
+
+		return server.RespondJSON(w, nil, http.StatusNoContent)
+	}
+
+	h.wrapAdmin(http.MethodDelete, "/professorships/{professorshipID}/materials/{materialID}", wrapH)
+}
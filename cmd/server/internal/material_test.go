@@ -0,0 +1,356 @@
+package internal
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service"
+	"github.com/mateoferrari97/Kit/web/server"
+)
+
+func TestHandler_GetMaterials(t *testing.T) {
+	// Given
+	wrapper := wrapperMock{}
+	service_ := serviceMock{}
+	service_.On("GetMaterials", "1").Return([]byte(`[{"id":7,"uri":"https://drive.google.com","description":"Drive"}]`), nil)
+
+	h := NewHandler(&wrapper, &service_)
+	h.GetMaterials()
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest("GET", "whocares", nil)
+	r = mux.SetURLVars(r, map[string]string{
+		"professorshipID": "1",
+	})
+
+	// When
+	err := wrapper.f(w, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, `[{"id":7,"uri":"https://drive.google.com","description":"Drive"}]`, w.Body.String())
+}
+
+func TestHandler_GetMaterials_ServiceNotFoundError(t *testing.T) {
+	// Given
+	wrapper := wrapperMock{}
+	service_ := serviceMock{}
+	service_.On("GetMaterials", "1").Return([]byte{}, service.ErrNotFound)
+
+	h := NewHandler(&wrapper, &service_)
+	h.GetMaterials()
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest("GET", "whocares", nil)
+	r = mux.SetURLVars(r, map[string]string{
+		"professorshipID": "1",
+	})
+
+	// When
+	err := wrapper.f(w, r)
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	hErr := err.(*server.Error)
+	require.Equal(t, http.StatusNotFound, hErr.StatusCode)
+	require.Equal(t, "service: resource not found", hErr.Message)
+}
+
+func TestHandler_CreateMaterial(t *testing.T) {
+	// Given
+	wrapper := wrapperMock{}
+	service_ := serviceMock{}
+	service_.On("CreateMaterial", service.CreateMaterialRequest{
+		ProfessorshipID: "1",
+		URI:             "https://drive.google.com",
+		Description:     "Drive",
+	}).Return([]byte(`{"id":7}`), nil)
+
+	h := NewHandler(&wrapper, &service_)
+	h.CreateMaterial()
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest("POST", "whocares", bytes.NewReader([]byte(`{"uri":"https://drive.google.com","description":"Drive"}`)))
+	r = mux.SetURLVars(r, map[string]string{
+		"professorshipID": "1",
+	})
+
+	// When
+	err := wrapper.f(w, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Equal(t, http.StatusCreated, w.Code)
+	require.Equal(t, `{"id":7}`, w.Body.String())
+}
+
+func TestHandler_CreateMaterial_BodyValidationError(t *testing.T) {
+	tt := []struct {
+		name          string
+		body          string
+		expectedError string
+	}{
+		{
+			name:          "uri is missing",
+			body:          `{"uri":"","description":"Drive"}`,
+			expectedError: "Key: 'materialInformation.URI' Error:Field validation for 'URI' failed on the 'required' tag",
+		},
+		{
+			name:          "uri is invalid",
+			body:          `{"uri":"drive","description":"Drive"}`,
+			expectedError: "Key: 'materialInformation.URI' Error:Field validation for 'URI' failed on the 'url' tag",
+		},
+		{
+			name:          "description is missing",
+			body:          `{"uri":"https://drive.google.com","description":""}`,
+			expectedError: "Key: 'materialInformation.Description' Error:Field validation for 'Description' failed on the 'required' tag",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// Given
+			wrapper := wrapperMock{}
+
+			h := NewHandler(&wrapper, nil)
+			h.CreateMaterial()
+
+			w := httptest.NewRecorder()
+			r, _ := http.NewRequest("POST", "whocares", bytes.NewReader([]byte(tc.body)))
+			r = mux.SetURLVars(r, map[string]string{
+				"professorshipID": "1",
+			})
+
+			// When
+			err := wrapper.f(w, r)
+			if err == nil {
+				t.Fatal("test must fail")
+			}
+
+			// Then
+			hErr := err.(*server.Error)
+			require.Equal(t, http.StatusBadRequest, hErr.StatusCode)
+			require.Equal(t, tc.expectedError, hErr.Message)
+		})
+	}
+}
+
+func TestHandler_CreateMaterial_ServiceNotFoundError(t *testing.T) {
+	// Given
+	wrapper := wrapperMock{}
+	service_ := serviceMock{}
+	service_.On("CreateMaterial", service.CreateMaterialRequest{
+		ProfessorshipID: "1",
+		URI:             "https://drive.google.com",
+		Description:     "Drive",
+	}).Return([]byte{}, service.ErrNotFound)
+
+	h := NewHandler(&wrapper, &service_)
+	h.CreateMaterial()
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest("POST", "whocares", bytes.NewReader([]byte(`{"uri":"https://drive.google.com","description":"Drive"}`)))
+	r = mux.SetURLVars(r, map[string]string{
+		"professorshipID": "1",
+	})
+
+	// When
+	err := wrapper.f(w, r)
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	hErr := err.(*server.Error)
+	require.Equal(t, http.StatusNotFound, hErr.StatusCode)
+	require.Equal(t, "service: resource not found", hErr.Message)
+}
+
+func TestHandler_UpdateMaterial(t *testing.T) {
+	// Given
+	wrapper := wrapperMock{}
+	service_ := serviceMock{}
+	service_.On("UpdateMaterial", service.UpdateMaterialRequest{
+		ProfessorshipID: "1",
+		MaterialID:      "7",
+		URI:             "https://drive.google.com",
+		Description:     "Drive",
+	}).Return(nil)
+
+	h := NewHandler(&wrapper, &service_)
+	h.UpdateMaterial()
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest("PUT", "whocares", bytes.NewReader([]byte(`{"uri":"https://drive.google.com","description":"Drive"}`)))
+	r = mux.SetURLVars(r, map[string]string{
+		"professorshipID": "1",
+		"materialID":      "7",
+	})
+
+	// When
+	err := wrapper.f(w, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestHandler_UpdateMaterial_ParamsError(t *testing.T) {
+	tt := []struct {
+		name          string
+		params        map[string]string
+		expectedError string
+	}{
+		{
+			name:          "professorship id is missing",
+			params:        map[string]string{"professorshipID": "", "materialID": "7"},
+			expectedError: "professorship id is required",
+		},
+		{
+			name:          "material id is missing",
+			params:        map[string]string{"professorshipID": "1", "materialID": ""},
+			expectedError: "material id is required",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// Given
+			wrapper := wrapperMock{}
+
+			h := NewHandler(&wrapper, nil)
+			h.UpdateMaterial()
+
+			w := httptest.NewRecorder()
+			r, _ := http.NewRequest("PUT", "whocares", bytes.NewReader([]byte(`{"uri":"https://drive.google.com","description":"Drive"}`)))
+			r = mux.SetURLVars(r, tc.params)
+
+			// When
+			err := wrapper.f(w, r)
+			if err == nil {
+				t.Fatal("test must fail")
+			}
+
+			// Then
+			hErr := err.(*server.Error)
+			require.Equal(t, http.StatusBadRequest, hErr.StatusCode)
+			require.Equal(t, tc.expectedError, hErr.Message)
+		})
+	}
+}
+
+func TestHandler_DeleteMaterial(t *testing.T) {
+	// Given
+	wrapper := wrapperMock{}
+	service_ := serviceMock{}
+	service_.On("DeleteMaterial", "1", "7").Return(nil)
+
+	h := NewHandler(&wrapper, &service_)
+	h.DeleteMaterial()
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest("DELETE", "whocares", nil)
+	r = mux.SetURLVars(r, map[string]string{
+		"professorshipID": "1",
+		"materialID":      "7",
+	})
+
+	// When
+	err := wrapper.f(w, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Equal(t, http.StatusNoContent, w.Code)
+}
+
+func TestHandler_DeleteMaterial_ServiceError(t *testing.T) {
+	// Given
+	wrapper := wrapperMock{}
+	service_ := serviceMock{}
+	service_.On("DeleteMaterial", "1", "7").Return(errors.New("error"))
+
+	h := NewHandler(&wrapper, &service_)
+	h.DeleteMaterial()
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest("DELETE", "whocares", nil)
+	r = mux.SetURLVars(r, map[string]string{
+		"professorshipID": "1",
+		"materialID":      "7",
+	})
+
+	// When
+	err := wrapper.f(w, r)
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "error")
+}
+
+func TestHandler_Materials_AuthError(t *testing.T) {
+	tt := []struct {
+		name               string
+		method             string
+		path               string
+		token              string
+		register           func(h *Handler)
+		expectedStatusCode int
+	}{
+		{name: "create without token", method: http.MethodPost, path: "/admin/professorships/1/materials", register: (*Handler).CreateMaterial, expectedStatusCode: http.StatusUnauthorized},
+		{name: "create as student", method: http.MethodPost, path: "/admin/professorships/1/materials", token: "student", register: (*Handler).CreateMaterial, expectedStatusCode: http.StatusForbidden},
+		{name: "update without token", method: http.MethodPut, path: "/admin/professorships/1/materials/7", register: (*Handler).UpdateMaterial, expectedStatusCode: http.StatusUnauthorized},
+		{name: "update as student", method: http.MethodPut, path: "/admin/professorships/1/materials/7", token: "student", register: (*Handler).UpdateMaterial, expectedStatusCode: http.StatusForbidden},
+		{name: "delete without token", method: http.MethodDelete, path: "/admin/professorships/1/materials/7", register: (*Handler).DeleteMaterial, expectedStatusCode: http.StatusUnauthorized},
+		{name: "delete as student", method: http.MethodDelete, path: "/admin/professorships/1/materials/7", token: "student", register: (*Handler).DeleteMaterial, expectedStatusCode: http.StatusForbidden},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// Given
+			service_ := serviceMock{}
+
+			sv, h := newAdminServer(&service_)
+			tc.register(h)
+
+			// When
+			w := serveAdmin(sv, tc.method, tc.path, tc.token, `{"uri":"https://drive.google.com/file","description":"Apunte"}`)
+
+			// Then
+			require.Equal(t, tc.expectedStatusCode, w.Code)
+		})
+	}
+}
+
+func TestHandler_DeleteMaterial_Admin(t *testing.T) {
+	// Given
+	service_ := serviceMock{}
+	service_.On("DeleteMaterial", "1", "7").Return(nil)
+
+	sv, h := newAdminServer(&service_)
+	h.DeleteMaterial()
+
+	// When
+	w := serveAdmin(sv, http.MethodDelete, "/admin/professorships/1/materials/7", "admin", "")
+
+	// Then
+	require.Equal(t, http.StatusNoContent, w.Code)
+}
@@ -0,0 +1,66 @@
+package internal
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service"
+	"github.com/mateoferrari97/Kit/web/server"
+)
+
+const maxCareerPlanSize = 1 << 20
+
+func careerPlanFormat(r *http.Request) string {
+	if format := r.URL.Query().Get("format"); format != "" {
+		return format
+	}
+
+	contentType := r.Header.Get("Content-Type")
+	switch {
+	case strings.Contains(contentType, "yaml"):
+		return service.PlanFormatYAML
+	case strings.Contains(contentType, "csv"):
+		return service.PlanFormatCSV
+	default:
+		return ""
+	}
+}
+
+func (h *Handler) ImportCareerPlan() {
+	wrapH := func(w http.ResponseWriter, r *http.Request) error {
+		careerID, err := requiredParam(r, "careerID", "career id")
+		if err != nil {
+			return err
+		}
+
+		var dryRun bool
+		if v := r.URL.Query().Get("dry_run"); v != "" {
+			if dryRun, err = strconv.ParseBool(v); err != nil {
+				return server.NewError("dry_run must be a boolean", http.StatusBadRequest)
+			}
+		}
+
+		plan, err := service.ParseCareerPlan(careerPlanFormat(r), http.MaxBytesReader(w, r.Body, maxCareerPlanSize))
+		if err != nil {
+			return server.NewError(err.Error(), http.StatusBadRequest)
+		}
+
+		response, err := h.service.ImportCareerPlan(careerID, plan, dryRun)
+		if err != nil {
+			switch {
+			case errors.Is(err, service.ErrInvalidCareerPlan):
+				return server.NewError(err.Error(), http.StatusBadRequest)
+			case errors.Is(err, service.ErrCorrelativesCycle):
+				return server.NewError(err.Error(), http.StatusConflict)
+			default:
+				return catalogError(err)
+			}
+		}
+
+		return server.RespondJSON(w, response, http.StatusOK)
+	}
+
+	h.wrapAdmin(http.MethodPost, "/careers/{careerID}/plan", wrapH)
+}
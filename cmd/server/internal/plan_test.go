@@ -0,0 +1,88 @@
+package internal
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service"
+)
+
+func TestHandler_ImportCareerPlan(t *testing.T) {
+	// Given
+	service_ := serviceMock{}
+	service_.On("ImportCareerPlan", "1", service.CareerPlan{Subjects: []service.PlanSubject{{Name: "Álgebra", Type: "Obligatoria"}}}, true).
+		Return([]byte(`{"career_id":"1","dry_run":true}`), nil)
+
+	sv, h := newAdminServer(&service_)
+	h.ImportCareerPlan()
+
+	// When
+	w := serveAdmin(sv, http.MethodPost, "/admin/careers/1/plan?format=csv&dry_run=true", "admin", "subject,type\nÁlgebra,Obligatoria\n")
+
+	// Then
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, `{"career_id":"1","dry_run":true}`, w.Body.String())
+}
+
+func TestHandler_ImportCareerPlan_Error(t *testing.T) {
+	tt := []struct {
+		name         string
+		path         string
+		body         string
+		serviceError error
+		expectedCode int
+	}{
+		{
+			name:         "unknown format",
+			path:         "/admin/careers/1/plan",
+			body:         "subjects: []",
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "invalid dry run",
+			path:         "/admin/careers/1/plan?format=yaml&dry_run=maybe",
+			body:         "subjects: []",
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "invalid plan",
+			path:         "/admin/careers/1/plan?format=yaml",
+			body:         "subjects: []",
+			serviceError: service.ErrInvalidCareerPlan,
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "career not found",
+			path:         "/admin/careers/1/plan?format=yaml",
+			body:         "subjects: []",
+			serviceError: service.ErrNotFound,
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:         "correlatives cycle",
+			path:         "/admin/careers/1/plan?format=yaml",
+			body:         "subjects: []",
+			serviceError: service.ErrCorrelativesCycle,
+			expectedCode: http.StatusConflict,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// Given
+			service_ := serviceMock{}
+			service_.On("ImportCareerPlan", "1", service.CareerPlan{Subjects: []service.PlanSubject{}}, false).Return([]byte{}, tc.serviceError)
+
+			sv, h := newAdminServer(&service_)
+			h.ImportCareerPlan()
+
+			// When
+			w := serveAdmin(sv, http.MethodPost, tc.path, "admin", tc.body)
+
+			// Then
+			require.Equal(t, tc.expectedCode, w.Code)
+		})
+	}
+}
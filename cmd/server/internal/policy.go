@@ -0,0 +1,57 @@
+package internal
+
+import (
+	"net/http"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service"
+	"github.com/mateoferrari97/Kit/web/server"
+)
+
+func (h *Handler) GetFacultyPolicy() {
+	wrapH := func(w http.ResponseWriter, r *http.Request) error {
+		facultyID, err := requiredParam(r, "facultyID", "faculty id")
+		if err != nil {
+			return err
+		}
+
+		response, err := h.service.GetFacultyPolicy(facultyID)
+		if err != nil {
+			return catalogError(err)
+		}
+
+		return server.RespondJSON(w, response, http.StatusOK)
+	}
+
+	h.wrapper.Wrap(http.MethodGet, "/faculties/{facultyID}/policy", wrapH)
+}
+
+func (h *Handler) UpdateFacultyPolicy() {
+	wrapH := func(w http.ResponseWriter, r *http.Request) error {
+		facultyID, err := requiredParam(r, "facultyID", "faculty id")
+		if err != nil {
+			return err
+		}
+
+		var policy struct {
+			MaxCareersPerStudent int      `json:"max_careers_per_student" validate:"required,min=1"`
+			AllowedStatuses      []string `json:"allowed_statuses" validate:"required,dive,oneof=PENDIENTE CURSANDO REGULARIZADA APROBADA LIBRE RECURSANDO"`
+			EnforceCorrelatives  *bool    `json:"enforce_correlatives" validate:"required"`
+		}
+
+		if err := decodeAndValidate(r, &policy); err != nil {
+			return err
+		}
+
+		if err := h.service.UpdateFacultyPolicy(facultyID, service.FacultyPolicy{
+			MaxCareersPerStudent: policy.MaxCareersPerStudent,
+			AllowedStatuses:      policy.AllowedStatuses,
+			EnforceCorrelatives:  *policy.EnforceCorrelatives,
+		}); err != nil {
+			return catalogError(err)
+		}
+
+		return server.RespondJSON(w, nil, http.StatusOK)
+	}
+
+	h.wrapAdmin(http.MethodPut, "/faculties/{facultyID}/policy", wrapH)
+}
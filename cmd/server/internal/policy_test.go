@@ -0,0 +1,60 @@
+package internal
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service"
+)
+
+func TestHandler_GetFacultyPolicy(t *testing.T) {
+	// Given
+	service_ := serviceMock{}
+	service_.On("GetFacultyPolicy", "1").Return([]byte(`{"faculty_id":1,"max_careers_per_student":2}`), nil)
+
+	sv, h := newAdminServer(&service_)
+	h.GetFacultyPolicy()
+
+	// When
+	w := serveAdmin(sv, http.MethodGet, "/faculties/1/policy", "", "")
+
+	// Then
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, `{"faculty_id":1,"max_careers_per_student":2}`, w.Body.String())
+}
+
+func TestHandler_UpdateFacultyPolicy(t *testing.T) {
+	tt := []struct {
+		name               string
+		token              string
+		body               string
+		returnedError      error
+		expectedStatusCode int
+	}{
+		{name: "updated", token: "admin", body: `{"max_careers_per_student":3,"allowed_statuses":["PENDIENTE","APROBADA"],"enforce_correlatives":false}`, expectedStatusCode: http.StatusOK},
+		{name: "student token", token: "student", body: `{"max_careers_per_student":3,"allowed_statuses":["PENDIENTE","APROBADA"],"enforce_correlatives":false}`, expectedStatusCode: http.StatusForbidden},
+		{name: "missing enforce correlatives", token: "admin", body: `{"max_careers_per_student":3,"allowed_statuses":["PENDIENTE","APROBADA"]}`, expectedStatusCode: http.StatusBadRequest},
+		{name: "unknown status", token: "admin", body: `{"max_careers_per_student":3,"allowed_statuses":["PENDIENTE","RECHAZADA"],"enforce_correlatives":false}`, expectedStatusCode: http.StatusBadRequest},
+		{name: "invalid policy", token: "admin", body: `{"max_careers_per_student":3,"allowed_statuses":["PENDIENTE","APROBADA"],"enforce_correlatives":false}`, returnedError: service.ErrInvalidPolicy, expectedStatusCode: http.StatusBadRequest},
+		{name: "faculty not found", token: "admin", body: `{"max_careers_per_student":3,"allowed_statuses":["PENDIENTE","APROBADA"],"enforce_correlatives":false}`, returnedError: service.ErrNotFound, expectedStatusCode: http.StatusNotFound},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// Given
+			service_ := serviceMock{}
+			service_.On("UpdateFacultyPolicy", "1", service.FacultyPolicy{MaxCareersPerStudent: 3, AllowedStatuses: []string{"PENDIENTE", "APROBADA"}}).Return(tc.returnedError)
+
+			sv, h := newAdminServer(&service_)
+			h.UpdateFacultyPolicy()
+
+			// When
+			w := serveAdmin(sv, http.MethodPut, "/admin/faculties/1/policy", tc.token, tc.body)
+
+			// Then
+			require.Equal(t, tc.expectedStatusCode, w.Code)
+		})
+	}
+}
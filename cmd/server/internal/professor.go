@@ -0,0 +1,31 @@
+package internal
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service"
+	"github.com/mateoferrari97/Kit/web/server"
+)
+
+func (h *Handler) GetProfessor() {
+	wrapH := func(w http.ResponseWriter, r *http.Request) error {
+		professorID, err := requiredParam(r, "professorID", "professor id")
+		if err != nil {
+			return err
+		}
+
+		professor, err := h.service.GetProfessor(professorID)
+		if err != nil {
+			if errors.Is(err, service.ErrNotFound) {
+				return server.NewError(err.Error(), http.StatusNotFound)
+			}
+
+			return err
+		}
+
+		return server.RespondJSON(w, professor, http.StatusOK)
+	}
+
+	h.wrapper.Wrap(http.MethodGet, "/professors/{professorID}", wrapH)
+}
@@ -0,0 +1,34 @@
+package internal
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service"
+	"github.com/mateoferrari97/Kit/web/server"
+)
+
+func (h *Handler) CheckScheduleConflicts() {
+	wrapH := func(w http.ResponseWriter, r *http.Request) error {
+		var timetable struct {
+			ProfessorshipIDs []int `json:"professorship_ids" validate:"required,min=1,max=20,dive,min=1"`
+		}
+
+		if err := decodeAndValidate(r, &timetable); err != nil {
+			return err
+		}
+
+		response, err := h.service.CheckScheduleConflicts(timetable.ProfessorshipIDs)
+		if err != nil {
+			if errors.Is(err, service.ErrNotFound) {
+				return server.NewError(err.Error(), http.StatusNotFound)
+			}
+
+			return err
+		}
+
+		return server.RespondJSON(w, response, http.StatusOK)
+	}
+
+	h.wrapper.Wrap(http.MethodPost, "/schedules/conflicts", wrapH)
+}
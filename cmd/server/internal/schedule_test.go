@@ -0,0 +1,103 @@
+package internal
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service"
+	"github.com/mateoferrari97/Kit/web/server"
+)
+
+func TestHandler_CheckScheduleConflicts(t *testing.T) {
+	// Given
+	wrapper := wrapperMock{}
+	service_ := serviceMock{}
+	service_.On("CheckScheduleConflicts", []int{1, 2}).Return([]byte(`{"conflicts":[]}`), nil)
+
+	h := NewHandler(&wrapper, &service_)
+	h.CheckScheduleConflicts()
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest("POST", "whocares", bytes.NewReader([]byte(`{"professorship_ids":[1,2]}`)))
+
+	// When
+	err := wrapper.f(w, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, `{"conflicts":[]}`, w.Body.String())
+}
+
+func TestHandler_CheckScheduleConflicts_BodyValidationError(t *testing.T) {
+	tt := []struct {
+		name          string
+		body          string
+		expectedError string
+	}{
+		{
+			name:          "professorship ids are missing",
+			body:          `{}`,
+			expectedError: "Key: 'ProfessorshipIDs' Error:Field validation for 'ProfessorshipIDs' failed on the 'required' tag",
+		},
+		{
+			name:          "professorship id is invalid",
+			body:          `{"professorship_ids":[0]}`,
+			expectedError: "Key: 'ProfessorshipIDs[0]' Error:Field validation for 'ProfessorshipIDs[0]' failed on the 'min' tag",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// Given
+			wrapper := wrapperMock{}
+
+			h := NewHandler(&wrapper, nil)
+			h.CheckScheduleConflicts()
+
+			w := httptest.NewRecorder()
+			r, _ := http.NewRequest("POST", "whocares", bytes.NewReader([]byte(tc.body)))
+
+			// When
+			err := wrapper.f(w, r)
+			if err == nil {
+				t.Fatal("test must fail")
+			}
+
+			// Then
+			hErr := err.(*server.Error)
+			require.Equal(t, http.StatusBadRequest, hErr.StatusCode)
+			require.Equal(t, tc.expectedError, hErr.Message)
+		})
+	}
+}
+
+func TestHandler_CheckScheduleConflicts_ServiceNotFoundError(t *testing.T) {
+	// Given
+	wrapper := wrapperMock{}
+	service_ := serviceMock{}
+	service_.On("CheckScheduleConflicts", []int{1}).Return([]byte{}, service.ErrNotFound)
+
+	h := NewHandler(&wrapper, &service_)
+	h.CheckScheduleConflicts()
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest("POST", "whocares", bytes.NewReader([]byte(`{"professorship_ids":[1]}`)))
+
+	// When
+	err := wrapper.f(w, r)
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	hErr := err.(*server.Error)
+	require.Equal(t, http.StatusNotFound, hErr.StatusCode)
+	require.Equal(t, "service: resource not found", hErr.Message)
+}
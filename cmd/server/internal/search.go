@@ -0,0 +1,53 @@
+package internal
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service"
+	"github.com/mateoferrari97/Kit/web/server"
+)
+
+const maxSearchQueryLength = 64
+
+func (h *Handler) Search() {
+	wrapH := func(w http.ResponseWriter, r *http.Request) error {
+		query := r.URL.Query().Get("q")
+		if query == "" {
+			return server.NewError("q is required", http.StatusBadRequest)
+		}
+
+		if len([]rune(query)) > maxSearchQueryLength {
+			return server.NewError("q is too long", http.StatusBadRequest)
+		}
+
+		req := service.SearchRequest{Query: query}
+		for param, v := range map[string]*int{"page": &req.Page, "page_size": &req.PageSize} {
+			value := r.URL.Query().Get(param)
+			if value == "" {
+				continue
+			}
+
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 1 {
+				return server.NewError(param+" must be a positive number", http.StatusBadRequest)
+			}
+
+			*v = n
+		}
+
+		response, err := h.service.Search(req)
+		if err != nil {
+			if errors.Is(err, service.ErrInvalidSearch) {
+				return server.NewError(err.Error(), http.StatusBadRequest)
+			}
+
+			return err
+		}
+
+		return server.RespondJSON(w, response, http.StatusOK)
+	}
+
+	h.wrapper.Wrap(http.MethodGet, "/search", wrapH)
+}
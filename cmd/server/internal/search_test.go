@@ -0,0 +1,57 @@
+package internal
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service"
+)
+
+func TestHandler_Search(t *testing.T) {
+	// Given
+	service_ := serviceMock{}
+	service_.On("Search", service.SearchRequest{Query: "análisis", Page: 2, PageSize: 5}).Return([]byte(`{"query":"análisis"}`), nil)
+
+	sv, h := newAdminServer(&service_)
+	h.Search()
+
+	// When
+	w := serveAdmin(sv, http.MethodGet, "/search?q=an%C3%A1lisis&page=2&page_size=5", "", "")
+
+	// Then
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, `{"query":"análisis"}`, w.Body.String())
+}
+
+func TestHandler_Search_Error(t *testing.T) {
+	tt := []struct {
+		name               string
+		url                string
+		returnedError      error
+		expectedStatusCode int
+	}{
+		{name: "missing query", url: "/search", expectedStatusCode: http.StatusBadRequest},
+		{name: "invalid page", url: "/search?q=algebra&page=first", expectedStatusCode: http.StatusBadRequest},
+		{name: "invalid page size", url: "/search?q=algebra&page_size=0", expectedStatusCode: http.StatusBadRequest},
+		{name: "invalid search", url: "/search?q=algebra", returnedError: service.ErrInvalidSearch, expectedStatusCode: http.StatusBadRequest},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// Given
+			service_ := serviceMock{}
+			service_.On("Search", service.SearchRequest{Query: "algebra"}).Return([]byte(nil), tc.returnedError)
+
+			sv, h := newAdminServer(&service_)
+			h.Search()
+
+			// When
+			w := serveAdmin(sv, http.MethodGet, tc.url, "", "")
+
+			// Then
+			require.Equal(t, tc.expectedStatusCode, w.Code)
+		})
+	}
+}
@@ -0,0 +1,350 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
+)
+
+var (
+	ErrResourceAlreadyExist = errors.New("service: resource already exist")
+	ErrResourceInUse        = errors.New("service: resource in use")
+	ErrInvalidSchedule      = errors.New("service: invalid schedule")
+)
+
+// translateCatalogError wraps the storage error of a catalog write with the matching service error.
+func translateCatalogError(action string, err error) error {
+	switch {
+	case errors.Is(err, storage.ErrNotFound):
+		return fmt.Errorf("could not %s: %w", action, ErrNotFound)
+	case errors.Is(err, storage.ErrResourceAlreadyExist):
+		return fmt.Errorf("could not %s: %w", action, ErrResourceAlreadyExist)
+	case errors.Is(err, storage.ErrResourceInUse):
+		return fmt.Errorf("could not %s: %w", action, ErrResourceInUse)
+	default:
+		return fmt.Errorf("could not %s: %v", action, err)
+	}
+}
+
+func marshalCreatedID(id int) ([]byte, error) {
+	b, err := json.Marshal(struct {
+		ID int `json:"id"`
+	}{ID: id})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal response: %v", err)
+	}
+
+	return b, nil
+}
+
+func nullableString(v string) *string {
+	if v == "" {
+		return nil
+	}
+
+	return &v
+}
+
+type FacultyRequest struct {
+	Name string
+	URI  string
+}
+
+func (s *Service) CreateFaculty(req FacultyRequest) ([]byte, error) {
+	id, err := s.storage.CreateFaculty(storage.FacultyRequest{Name: req.Name, URI: nullableString(req.URI)})
+	if err != nil {
+		return nil, translateCatalogError("create faculty", err)
+	}
+
+	return marshalCreatedID(id)
+}
+
+func (s *Service) UpdateFaculty(facultyID string, req FacultyRequest) error {
+	if err := s.storage.UpdateFaculty(facultyID, storage.FacultyRequest{Name: req.Name, URI: nullableString(req.URI)}); err != nil {
+		return translateCatalogError("update faculty", err)
+	}
+
+	return nil
+}
+
+func (s *Service) DeleteFaculty(facultyID string) error {
+	if err := s.storage.DeleteFaculty(facultyID); err != nil {
+		return translateCatalogError("delete faculty", err)
+	}
+
+	return nil
+}
+
+type CareerRequest struct {
+	FacultyID int
+	Name      string
+	URI       string
+}
+
+func (s *Service) CreateCareer(req CareerRequest) ([]byte, error) {
+	id, err := s.storage.CreateCareer(storage.CareerRequest{
+		FacultyID: req.FacultyID,
+		Name:      req.Name,
+		URI:       nullableString(req.URI),
+	})
+
+	if err != nil {
+		return nil, translateCatalogError("create career", err)
+	}
+
+	s.search.invalidate()
+	return marshalCreatedID(id)
+}
+
+func (s *Service) UpdateCareer(careerID string, req CareerRequest) error {
+	if err := s.storage.UpdateCareer(careerID, storage.CareerRequest{
+		FacultyID: req.FacultyID,
+		Name:      req.Name,
+		URI:       nullableString(req.URI),
+	}); err != nil {
+		return translateCatalogError("update career", err)
+	}
+
+	s.search.invalidate()
+	return nil
+}
+
+func (s *Service) DeleteCareer(careerID string) error {
+	if err := s.storage.DeleteCareer(careerID); err != nil {
+		return translateCatalogError("delete career", err)
+	}
+
+	s.search.invalidate()
+	return nil
+}
+
+type SubjectRequest struct {
+	Name string
+	URI  string
+	Meet string
+}
+
+func (s *Service) CreateSubject(req SubjectRequest) ([]byte, error) {
+	id, err := s.storage.CreateSubject(storage.SubjectRequest{
+		Name: req.Name,
+		URI:  nullableString(req.URI),
+		Meet: nullableString(req.Meet),
+	})
+
+	if err != nil {
+		return nil, translateCatalogError("create subject", err)
+	}
+
+	s.search.invalidate()
+	return marshalCreatedID(id)
+}
+
+func (s *Service) UpdateSubject(subjectID string, req SubjectRequest) error {
+	if err := s.storage.UpdateSubject(subjectID, storage.SubjectRequest{
+		Name: req.Name,
+		URI:  nullableString(req.URI),
+		Meet: nullableString(req.Meet),
+	}); err != nil {
+		return translateCatalogError("update subject", err)
+	}
+
+	s.search.invalidate()
+	return nil
+}
+
+func (s *Service) DeleteSubject(subjectID string) error {
+	if err := s.storage.DeleteSubject(subjectID); err != nil {
+		return translateCatalogError("delete subject", err)
+	}
+
+	s.search.invalidate()
+	return nil
+}
+
+type CareerSubjectRequest struct {
+	CareerID  string
+	SubjectID string
+	Hours     *int
+	Type      string
+	Points    *int
+}
+
+func (r CareerSubjectRequest) toStorage() storage.CareerSubjectRequest {
+	return storage.CareerSubjectRequest{
+		CareerID:  r.CareerID,
+		SubjectID: r.SubjectID,
+		Hours:     r.Hours,
+		Type:      nullableString(r.Type),
+		Points:    r.Points,
+	}
+}
+
+func (s *Service) CreateCareerSubject(req CareerSubjectRequest) ([]byte, error) {
+	id, err := s.storage.CreateCareerSubject(req.toStorage())
+	if err != nil {
+		return nil, translateCatalogError("create career subject", err)
+	}
+
+	return marshalCreatedID(id)
+}
+
+func (s *Service) UpdateCareerSubject(req CareerSubjectRequest) error {
+	if err := s.storage.UpdateCareerSubject(req.toStorage()); err != nil {
+		return translateCatalogError("update career subject", err)
+	}
+
+	return nil
+}
+
+func (s *Service) DeleteCareerSubject(careerID, subjectID string) error {
+	if err := s.storage.DeleteCareerSubject(careerID, subjectID); err != nil {
+		return translateCatalogError("delete career subject", err)
+	}
+
+	s.search.invalidate()
+	return nil
+}
+
+type ProfessorshipRequest struct {
+	CareerID        string
+	SubjectID       string
+	ProfessorshipID string
+	Name            string
+	Term            string
+}
+
+func (s *Service) getTermID(term string) (*int, error) {
+	if term == "" {
+		return nil, nil
+	}
+
+	t, err := s.getTerm(term)
+	if err != nil {
+		return nil, err
+	}
+
+	return &t.ID, nil
+}
+
+func (s *Service) CreateProfessorship(req ProfessorshipRequest) ([]byte, error) {
+	termID, err := s.getTermID(req.Term)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := s.storage.CreateProfessorship(storage.CreateProfessorshipRequest{
+		CareerID:  req.CareerID,
+		SubjectID: req.SubjectID,
+		Name:      req.Name,
+		TermID:    termID,
+	})
+
+	if err != nil {
+		return nil, translateCatalogError("create professorship", err)
+	}
+
+	s.search.invalidate()
+	return marshalCreatedID(id)
+}
+
+func (s *Service) UpdateProfessorship(req ProfessorshipRequest) error {
+	termID, err := s.getTermID(req.Term)
+	if err != nil {
+		return err
+	}
+
+	if err := s.storage.UpdateProfessorship(storage.UpdateProfessorshipRequest{
+		ProfessorshipID: req.ProfessorshipID,
+		Name:            req.Name,
+		TermID:          termID,
+	}); err != nil {
+		return translateCatalogError("update professorship", err)
+	}
+
+	s.search.invalidate()
+	return nil
+}
+
+func (s *Service) DeleteProfessorship(professorshipID string) error {
+	if err := s.storage.DeleteProfessorship(professorshipID); err != nil {
+		return translateCatalogError("delete professorship", err)
+	}
+
+	s.search.invalidate()
+	return nil
+}
+
+type ScheduleRequest struct {
+	ProfessorshipID string
+	ScheduleID      string
+	Day             string
+	Start           string
+	End             string
+}
+
+func (r ScheduleRequest) toStorage() (storage.ScheduleRequest, error) {
+	day, exist := dayToDayNumber[r.Day]
+	if !exist {
+		return storage.ScheduleRequest{}, fmt.Errorf("%w [day: %s]: unknown day", ErrInvalidSchedule, r.Day)
+	}
+
+	start, err := parseClock(r.Start)
+	if err != nil {
+		return storage.ScheduleRequest{}, fmt.Errorf("%w [start: %s]: expected format is HH:MM", ErrInvalidSchedule, r.Start)
+	}
+
+	end, err := parseClock(r.End)
+	if err != nil {
+		return storage.ScheduleRequest{}, fmt.Errorf("%w [end: %s]: expected format is HH:MM", ErrInvalidSchedule, r.End)
+	}
+
+	if start >= end {
+		return storage.ScheduleRequest{}, fmt.Errorf("%w [start: %s, end: %s]: start must be before end", ErrInvalidSchedule, r.Start, r.End)
+	}
+
+	return storage.ScheduleRequest{
+		ProfessorshipID: r.ProfessorshipID,
+		ScheduleID:      r.ScheduleID,
+		Day:             day,
+		Start:           formatClock(start),
+		End:             formatClock(end),
+	}, nil
+}
+
+func (s *Service) CreateSchedule(req ScheduleRequest) ([]byte, error) {
+	schedule, err := req.toStorage()
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := s.storage.CreateSchedule(schedule)
+	if err != nil {
+		return nil, translateCatalogError("create schedule", err)
+	}
+
+	return marshalCreatedID(id)
+}
+
+func (s *Service) UpdateSchedule(req ScheduleRequest) error {
+	schedule, err := req.toStorage()
+	if err != nil {
+		return err
+	}
+
+	if err := s.storage.UpdateSchedule(schedule); err != nil {
+		return translateCatalogError("update schedule", err)
+	}
+
+	return nil
+}
+
+func (s *Service) DeleteSchedule(professorshipID, scheduleID string) error {
+	if err := s.storage.DeleteSchedule(professorshipID, scheduleID); err != nil {
+		return translateCatalogError("delete schedule", err)
+	}
+
+	return nil
+}
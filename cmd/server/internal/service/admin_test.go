@@ -0,0 +1,204 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
+)
+
+func TestService_CreateFaculty(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("CreateFaculty", storage.FacultyRequest{Name: "Exactas"}).Return(3, nil)
+
+	s := NewService(&storage_)
+
+	// When
+	b, err := s.CreateFaculty(FacultyRequest{Name: "Exactas"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Equal(t, `{"id":3}`, string(b))
+}
+
+func TestService_CatalogErrors(t *testing.T) {
+	tt := []struct {
+		name          string
+		storageErr    error
+		expectedErr   error
+		expectedError string
+	}{
+		{
+			name:          "not found",
+			storageErr:    storage.ErrNotFound,
+			expectedErr:   ErrNotFound,
+			expectedError: "could not update career: service: resource not found",
+		},
+		{
+			name:          "already exist",
+			storageErr:    storage.ErrResourceAlreadyExist,
+			expectedErr:   ErrResourceAlreadyExist,
+			expectedError: "could not update career: service: resource already exist",
+		},
+		{
+			name:          "in use",
+			storageErr:    storage.ErrResourceInUse,
+			expectedErr:   ErrResourceInUse,
+			expectedError: "could not update career: service: resource in use",
+		},
+		{
+			name:          "storage error",
+			storageErr:    errors.New("error"),
+			expectedError: "could not update career: error",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// Given
+			uri := "https://exactas.unlp.edu.ar"
+
+			storage_ := storageMock{}
+			storage_.On("UpdateCareer", "1", storage.CareerRequest{FacultyID: 2, Name: "Sistemas", URI: &uri}).Return(tc.storageErr)
+
+			s := NewService(&storage_)
+
+			// When
+			err := s.UpdateCareer("1", CareerRequest{FacultyID: 2, Name: "Sistemas", URI: uri})
+			if err == nil {
+				t.Fatal("test must fail")
+			}
+
+			// Then
+			if tc.expectedErr != nil {
+				require.True(t, errors.Is(err, tc.expectedErr))
+			}
+
+			require.EqualError(t, err, tc.expectedError)
+		})
+	}
+}
+
+func TestService_CreateProfessorship(t *testing.T) {
+	// Given
+	termID := 4
+
+	storage_ := storageMock{}
+	storage_.On("GetTerm", 2021, 1).Return(storage.Term{ID: termID}, nil)
+	storage_.On("CreateProfessorship", storage.CreateProfessorshipRequest{
+		CareerID:  "1",
+		SubjectID: "2",
+		Name:      "Cátedra A",
+		TermID:    &termID,
+	}).Return(9, nil)
+
+	s := NewService(&storage_)
+
+	// When
+	b, err := s.CreateProfessorship(ProfessorshipRequest{CareerID: "1", SubjectID: "2", Name: "Cátedra A", Term: "2021-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Equal(t, `{"id":9}`, string(b))
+}
+
+func TestService_UpdateProfessorship_InvalidTermError(t *testing.T) {
+	// Given
+	s := NewService(&storageMock{})
+
+	// When
+	err := s.UpdateProfessorship(ProfessorshipRequest{ProfessorshipID: "9", Name: "Cátedra A", Term: "2021"})
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.True(t, errors.Is(err, ErrInvalidTerm))
+}
+
+func TestService_CreateSchedule(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("CreateSchedule", storage.ScheduleRequest{
+		ProfessorshipID: "9",
+		Day:             3,
+		Start:           "08:00",
+		End:             "10:30",
+	}).Return(5, nil)
+
+	s := NewService(&storage_)
+
+	// When
+	b, err := s.CreateSchedule(ScheduleRequest{ProfessorshipID: "9", Day: "Miércoles", Start: "8:00", End: "10:30"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Equal(t, `{"id":5}`, string(b))
+}
+
+func TestService_UpdateSchedule_InvalidScheduleError(t *testing.T) {
+	tt := []struct {
+		name          string
+		req           ScheduleRequest
+		expectedError string
+	}{
+		{
+			name:          "unknown day",
+			req:           ScheduleRequest{Day: "Monday", Start: "08:00", End: "10:00"},
+			expectedError: "service: invalid schedule [day: Monday]: unknown day",
+		},
+		{
+			name:          "invalid start",
+			req:           ScheduleRequest{Day: "Lunes", Start: "8am", End: "10:00"},
+			expectedError: "service: invalid schedule [start: 8am]: expected format is HH:MM",
+		},
+		{
+			name:          "end before start",
+			req:           ScheduleRequest{Day: "Lunes", Start: "10:00", End: "08:00"},
+			expectedError: "service: invalid schedule [start: 10:00, end: 08:00]: start must be before end",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// Given
+			s := NewService(&storageMock{})
+
+			// When
+			err := s.UpdateSchedule(tc.req)
+			if err == nil {
+				t.Fatal("test must fail")
+			}
+
+			// Then
+			require.True(t, errors.Is(err, ErrInvalidSchedule))
+			require.EqualError(t, err, tc.expectedError)
+		})
+	}
+}
+
+func TestService_DeleteSubject_InUseError(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("DeleteSubject", "2").Return(storage.ErrResourceInUse)
+
+	s := NewService(&storage_)
+
+	// When
+	err := s.DeleteSubject("2")
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "could not delete subject: service: resource in use")
+}
@@ -0,0 +1,254 @@
+package service
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/argon2"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
+)
+
+const (
+	defaultTokenTTL    = 24 * time.Hour
+	passwordHashScheme = "argon2id"
+	passwordSaltLength = 16
+	passwordKeyLength  = 32
+	tokenIssuer        = "studentapi"
+)
+
+// Argon2id parameters, as recommended by RFC 9106 for memory constrained environments.
+const (
+	passwordMemory  uint32 = 64 * 1024
+	passwordTime    uint32 = 3
+	passwordThreads uint8  = 4
+)
+
+var (
+	ErrInvalidCredentials = errors.New("service: invalid credentials")
+	ErrUnauthorized       = errors.New("service: unauthorized")
+)
+
+const (
+	RoleStudent = "STUDENT"
+	RoleAdmin   = "ADMIN"
+)
+
+var tokenHeader = base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+
+type AuthConfig struct {
+	Secret   []byte
+	TokenTTL time.Duration
+}
+
+func WithAuth(config AuthConfig) Option {
+	return func(s *Service) {
+		s.auth = config
+	}
+}
+
+// Identity is the student a token was issued for, with the email and role it has now.
+type Identity struct {
+	StudentID    int
+	StudentEmail string
+	Role         string
+}
+
+func (i Identity) IsAdmin() bool {
+	return i.Role == RoleAdmin
+}
+
+type tokenClaims struct {
+	Issuer    string `json:"iss"`
+	Subject   string `json:"sub"`
+	Role      string `json:"role"`
+	IssuedAt  int64  `json:"iat"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+func (s *Service) Login(studentEmail, password string) ([]byte, error) {
+	credentials, err := s.storage.GetStudentCredentials(studentEmail)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, ErrInvalidCredentials
+		}
+
+		return nil, fmt.Errorf("could not get student credentials [student_email: %s]: %v", studentEmail, err)
+	}
+
+	if !comparePassword(credentials.PasswordHash, password) {
+		return nil, ErrInvalidCredentials
+	}
+
+	ttl := s.auth.TokenTTL
+	if ttl <= 0 {
+		ttl = defaultTokenTTL
+	}
+
+	now := s.now()
+	expiresAt := now.Add(ttl)
+	token, err := s.signToken(tokenClaims{
+		Issuer:    tokenIssuer,
+		Subject:   strconv.Itoa(credentials.StudentID),
+		Role:      credentials.Role,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: expiresAt.Unix(),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	type response struct {
+		Token     string `json:"token"`
+		TokenType string `json:"token_type"`
+		ExpiresAt string `json:"expires_at"`
+	}
+
+	return json.Marshal(response{
+		Token:     token,
+		TokenType: "Bearer",
+		ExpiresAt: expiresAt.UTC().Format(time.RFC3339),
+	})
+}
+
+// Authenticate verifies the token signature and expiration and returns the identity it was issued for. Tokens are
+// issued for the student id, which never changes, and the email and role are read on every request, so tokens stop
+// working once their student is deleted and never grant access to an account that reuses an old email.
+func (s *Service) Authenticate(token string) (Identity, error) {
+	if len(s.auth.Secret) == 0 {
+		return Identity{}, fmt.Errorf("%w: authentication is not configured", ErrUnauthorized)
+	}
+
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 || parts[0] != tokenHeader {
+		return Identity{}, fmt.Errorf("%w: malformed token", ErrUnauthorized)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil || !hmac.Equal(signature, s.tokenSignature(parts[0]+"."+parts[1])) {
+		return Identity{}, fmt.Errorf("%w: invalid token signature", ErrUnauthorized)
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return Identity{}, fmt.Errorf("%w: malformed token", ErrUnauthorized)
+	}
+
+	var claims tokenClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return Identity{}, fmt.Errorf("%w: malformed token", ErrUnauthorized)
+	}
+
+	if _, err := strconv.Atoi(claims.Subject); err != nil || claims.Issuer != tokenIssuer ||
+		(claims.Role != RoleStudent && claims.Role != RoleAdmin) {
+		return Identity{}, fmt.Errorf("%w: invalid token claims", ErrUnauthorized)
+	}
+
+	if s.now().Unix() >= claims.ExpiresAt {
+		return Identity{}, fmt.Errorf("%w: token expired", ErrUnauthorized)
+	}
+
+	student, err := s.storage.GetStudent(claims.Subject)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return Identity{}, fmt.Errorf("%w: student no longer exists", ErrUnauthorized)
+		}
+
+		return Identity{}, fmt.Errorf("could not get student [student_id: %s]: %v", claims.Subject, err)
+	}
+
+	return Identity{StudentID: student.ID, StudentEmail: student.Email, Role: student.Role}, nil
+}
+
+func (s *Service) signToken(claims tokenClaims) (string, error) {
+	if len(s.auth.Secret) == 0 {
+		return "", errors.New("could not sign token: authentication is not configured")
+	}
+
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", fmt.Errorf("could not sign token: %v", err)
+	}
+
+	unsigned := tokenHeader + "." + base64.RawURLEncoding.EncodeToString(payload)
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(s.tokenSignature(unsigned)), nil
+}
+
+func (s *Service) tokenSignature(unsigned string) []byte {
+	mac := hmac.New(sha256.New, s.auth.Secret)
+	mac.Write([]byte(unsigned))
+	return mac.Sum(nil)
+}
+
+// hashPassword encodes the password as "argon2id$v=19$m=65536,t=3,p=4$salt$key", keeping the scheme and its
+// parameters so they can change without invalidating stored hashes.
+func hashPassword(password string) (string, error) {
+	salt := make([]byte, passwordSaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("could not generate password salt: %v", err)
+	}
+
+	key := argon2.IDKey([]byte(password), salt, passwordTime, passwordMemory, passwordThreads, passwordKeyLength)
+	return strings.Join([]string{
+		passwordHashScheme,
+		fmt.Sprintf("v=%d", argon2.Version),
+		fmt.Sprintf("m=%d,t=%d,p=%d", passwordMemory, passwordTime, passwordThreads),
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	}, "$"), nil
+}
+
+// comparePassword verifies the password against the hash, using the parameters stored along with it.
+func comparePassword(passwordHash, password string) bool {
+	parts := strings.Split(passwordHash, "$")
+	if len(parts) != 5 || parts[0] != passwordHashScheme {
+		return false
+	}
+
+	return compareArgon2id(parts[1:], password)
+}
+
+func compareArgon2id(params []string, password string) bool {
+	var version int
+	if _, err := fmt.Sscanf(params[0], "v=%d", &version); err != nil || version != argon2.Version {
+		return false
+	}
+
+	var memory, passes uint32
+	var threads uint8
+	if _, err := fmt.Sscanf(params[1], "m=%d,t=%d,p=%d", &memory, &passes, &threads); err != nil || passes == 0 || threads == 0 {
+		return false
+	}
+
+	salt, key, ok := decodeSaltAndKey(params[2], params[3])
+	if !ok {
+		return false
+	}
+
+	derived := argon2.IDKey([]byte(password), salt, passes, memory, threads, uint32(len(key)))
+	return subtle.ConstantTimeCompare(derived, key) == 1
+}
+
+func decodeSaltAndKey(encodedSalt, encodedKey string) ([]byte, []byte, bool) {
+	salt, err := base64.RawStdEncoding.DecodeString(encodedSalt)
+	if err != nil {
+		return nil, nil, false
+	}
+
+	key, err := base64.RawStdEncoding.DecodeString(encodedKey)
+	if err != nil || len(key) == 0 {
+		return nil, nil, false
+	}
+
+	return salt, key, true
+}
@@ -0,0 +1,280 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage/memory"
+)
+
+func newAuthService(t *testing.T, storage_ Storage, now time.Time) *Service {
+	t.Helper()
+
+	s := NewService(storage_, WithAuth(AuthConfig{Secret: []byte("secret"), TokenTTL: time.Hour}))
+	s.now = func() time.Time { return now }
+
+	return s
+}
+
+func login(t *testing.T, s *Service, studentEmail, password string) string {
+	t.Helper()
+
+	b, err := s.Login(studentEmail, password)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var response struct {
+		Token     string `json:"token"`
+		TokenType string `json:"token_type"`
+		ExpiresAt string `json:"expires_at"`
+	}
+
+	if err := json.Unmarshal(b, &response); err != nil {
+		t.Fatal(err)
+	}
+
+	require.Equal(t, "Bearer", response.TokenType)
+	require.Equal(t, "2021-04-01T13:00:00Z", response.ExpiresAt)
+
+	return response.Token
+}
+
+func TestService_HashPassword(t *testing.T) {
+	// Given
+	password := "secret-password"
+
+	// When
+	passwordHash, err := hashPassword(password)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.True(t, strings.HasPrefix(passwordHash, "argon2id$v=19$m=65536,t=3,p=4$"))
+	require.True(t, comparePassword(passwordHash, password))
+	require.False(t, comparePassword(passwordHash, "another-password"))
+	require.False(t, comparePassword("plain-text", password))
+}
+
+func TestService_ComparePassword_InvalidHash(t *testing.T) {
+	// Then
+	require.False(t, comparePassword("pbkdf2-sha256$1000$MDEyMzQ1Njc4OWFiY2RlZg$iDT5ZpkS4f94Qx7WQcuJ9roIqZ57IyAAZWCQNDcvahI", "secret-password"))
+	require.False(t, comparePassword("argon2id$v=19$m=65536,t=0,p=4$MDEyMzQ1Njc4OWFiY2RlZg$iDT5ZpkS4f94Qx7WQcuJ9roIqZ57IyAAZWCQNDcvahI", "secret-password"))
+}
+
+func TestService_Login(t *testing.T) {
+	// Given
+	passwordHash, err := hashPassword("secret-password")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	storage_ := storageMock{}
+	storage_.On("GetStudentCredentials", "example@gmail.com").Return(storage.Credentials{StudentID: 7, PasswordHash: passwordHash, Role: RoleAdmin}, nil)
+	storage_.On("GetStudent", "7").Return(storage.Student{ID: 7, Name: "example", Email: "example@gmail.com", Role: RoleAdmin}, nil)
+
+	now := time.Date(2021, time.April, 1, 12, 0, 0, 0, time.UTC)
+	s := newAuthService(t, &storage_, now)
+
+	// When
+	token := login(t, s, "example@gmail.com", "secret-password")
+
+	// Then
+	identity, err := s.Authenticate(token)
+	require.NoError(t, err)
+	require.Equal(t, Identity{StudentID: 7, StudentEmail: "example@gmail.com", Role: RoleAdmin}, identity)
+	require.True(t, identity.IsAdmin())
+}
+
+func TestService_Login_InvalidCredentialsError(t *testing.T) {
+	passwordHash, err := hashPassword("secret-password")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tt := []struct {
+		name         string
+		passwordHash string
+		storageErr   error
+		password     string
+	}{
+		{
+			name:         "wrong password",
+			passwordHash: passwordHash,
+			password:     "another-password",
+		},
+		{
+			name:       "student not found",
+			storageErr: storage.ErrNotFound,
+			password:   "secret-password",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// Given
+			storage_ := storageMock{}
+			storage_.On("GetStudentCredentials", "example@gmail.com").Return(storage.Credentials{PasswordHash: tc.passwordHash, Role: RoleStudent}, tc.storageErr)
+
+			s := newAuthService(t, &storage_, time.Now())
+
+			// When
+			_, err := s.Login("example@gmail.com", tc.password)
+
+			// Then
+			require.True(t, errors.Is(err, ErrInvalidCredentials))
+		})
+	}
+}
+
+func TestService_Login_StorageError(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("GetStudentCredentials", "example@gmail.com").Return(storage.Credentials{}, errors.New("error"))
+
+	s := newAuthService(t, &storage_, time.Now())
+
+	// When
+	_, err := s.Login("example@gmail.com", "secret-password")
+
+	// Then
+	require.EqualError(t, err, "could not get student credentials [student_email: example@gmail.com]: error")
+}
+
+func TestService_Authenticate_UnauthorizedError(t *testing.T) {
+	passwordHash, err := hashPassword("secret-password")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	storage_ := storageMock{}
+	storage_.On("GetStudentCredentials", "example@gmail.com").Return(storage.Credentials{StudentID: 7, PasswordHash: passwordHash, Role: RoleAdmin}, nil)
+
+	now := time.Date(2021, time.April, 1, 12, 0, 0, 0, time.UTC)
+	token := login(t, newAuthService(t, &storage_, now), "example@gmail.com", "secret-password")
+	parts := strings.Split(token, ".")
+
+	emailToken, err := newAuthService(t, &storage_, now).signToken(tokenClaims{
+		Issuer:    tokenIssuer,
+		Subject:   "example@gmail.com",
+		Role:      RoleAdmin,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: now.Add(time.Hour).Unix(),
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tt := []struct {
+		name          string
+		token         string
+		secret        string
+		now           time.Time
+		expectedError string
+	}{
+		{
+			name:          "expired token",
+			token:         token,
+			secret:        "secret",
+			now:           now.Add(time.Hour),
+			expectedError: "service: unauthorized: token expired",
+		},
+		{
+			name:          "different secret",
+			token:         token,
+			secret:        "another-secret",
+			now:           now,
+			expectedError: "service: unauthorized: invalid token signature",
+		},
+		{
+			name:          "tampered payload",
+			token:         parts[0] + ".eyJzdWIiOiJvdGhlckBnbWFpbC5jb20ifQ." + parts[2],
+			secret:        "secret",
+			now:           now,
+			expectedError: "service: unauthorized: invalid token signature",
+		},
+		{
+			name:          "email subject",
+			token:         emailToken,
+			secret:        "secret",
+			now:           now,
+			expectedError: "service: unauthorized: invalid token claims",
+		},
+		{
+			name:          "malformed token",
+			token:         "token",
+			secret:        "secret",
+			now:           now,
+			expectedError: "service: unauthorized: malformed token",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// Given
+			s := NewService(&storageMock{}, WithAuth(AuthConfig{Secret: []byte(tc.secret)}))
+			s.now = func() time.Time { return tc.now }
+
+			// When
+			_, err := s.Authenticate(tc.token)
+
+			// Then
+			require.True(t, errors.Is(err, ErrUnauthorized))
+			require.EqualError(t, err, tc.expectedError)
+		})
+	}
+}
+
+func TestService_Authenticate_StudentNotFoundError(t *testing.T) {
+	// Given
+	passwordHash, err := hashPassword("secret-password")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	storage_ := storageMock{}
+	storage_.On("GetStudentCredentials", "example@gmail.com").Return(storage.Credentials{StudentID: 7, PasswordHash: passwordHash, Role: RoleStudent}, nil)
+	storage_.On("GetStudent", "7").Return(storage.Student{}, storage.ErrNotFound)
+
+	s := newAuthService(t, &storage_, time.Date(2021, time.April, 1, 12, 0, 0, 0, time.UTC))
+	token := login(t, s, "example@gmail.com", "secret-password")
+
+	// When
+	_, err = s.Authenticate(token)
+
+	// Then
+	require.True(t, errors.Is(err, ErrUnauthorized))
+	require.EqualError(t, err, "service: unauthorized: student no longer exists")
+}
+
+func TestService_Authenticate_ReusedEmail_MemoryStorage(t *testing.T) {
+	// Given
+	storage_ := memory.NewStorage()
+	s := newAuthService(t, storage_, time.Date(2021, time.April, 1, 12, 0, 0, 0, time.UTC))
+
+	require.NoError(t, s.CreateStudent("example", "example@gmail.com", "secret-password"))
+	token := login(t, s, "example@gmail.com", "secret-password")
+
+	_, err := s.ChangeStudentEmail(ChangeStudentEmailRequest{StudentID: "1", StudentEmail: "new@gmail.com", IsAdmin: true})
+	require.NoError(t, err)
+	require.NoError(t, s.CreateStudent("impostor", "example@gmail.com", "another-password"))
+
+	// When
+	identity, err := s.Authenticate(token)
+	require.NoError(t, err)
+
+	require.NoError(t, s.DeleteStudent("1"))
+	_, deletedErr := s.Authenticate(token)
+
+	// Then
+	require.Equal(t, Identity{StudentID: 1, StudentEmail: "new@gmail.com", Role: RoleStudent}, identity)
+	require.True(t, errors.Is(deletedErr, ErrUnauthorized))
+}
@@ -0,0 +1,196 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
+)
+
+func (s *Service) GetAvailableSubjects(studentEmail, careerID string) ([]byte, error) {
+	type (
+		missingCorrelative struct {
+			ID          int    `json:"id"`
+			Name        string `json:"name"`
+			Requirement string `json:"requirement"`
+			Status      string `json:"status"`
+		}
+
+		availableSubject struct {
+			ID     int    `json:"id"`
+			Name   string `json:"name"`
+			Type   string `json:"type"`
+			Status string `json:"status"`
+		}
+
+		blockedSubject struct {
+			availableSubject
+			MissingCorrelatives []missingCorrelative `json:"missing_correlatives"`
+		}
+
+		pathSubject struct {
+			ID     int    `json:"id"`
+			Name   string `json:"name"`
+			Status string `json:"status"`
+		}
+
+		remainingPath struct {
+			Length   int           `json:"length"`
+			Subjects []pathSubject `json:"subjects"`
+		}
+
+		getAvailableSubjectsResponse struct {
+			Available     []availableSubject `json:"available"`
+			Blocked       []blockedSubject   `json:"blocked"`
+			RemainingPath remainingPath      `json:"remaining_path"`
+		}
+	)
+
+	studentSubjects, err := s.storage.GetStudentSubjects(studentEmail, careerID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, fmt.Errorf("could not get subjects: %w", ErrNotFound)
+		}
+
+		return nil, fmt.Errorf("could not get subjects: %v", err)
+	}
+
+	correlatives, err := s.storage.GetCorrelatives(careerID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get correlatives: %v", err)
+	}
+
+	subjects, subjectIDs := indexStudentSubjects(studentSubjects)
+	graph, err := newCorrelativeGraph(subjectIDs, correlatives)
+	if err != nil {
+		return nil, fmt.Errorf("could not build correlatives: %w", err)
+	}
+
+	response := getAvailableSubjectsResponse{
+		Available: []availableSubject{},
+		Blocked:   []blockedSubject{},
+		RemainingPath: remainingPath{
+			Subjects: []pathSubject{},
+		},
+	}
+
+	for _, subject := range studentSubjects {
+		if !isEnrollable(subject.Status) {
+			continue
+		}
+
+		available := availableSubject{
+			ID:     subject.ID,
+			Name:   subject.Name,
+			Type:   subject.Type,
+			Status: subject.Status,
+		}
+
+		missing := graph.missingCorrelatives(subject.ID, subjects)
+		if len(missing) == 0 {
+			response.Available = append(response.Available, available)
+			continue
+		}
+
+		blocked := blockedSubject{
+			availableSubject:    available,
+			MissingCorrelatives: make([]missingCorrelative, 0, len(missing)),
+		}
+
+		for _, m := range missing {
+			blocked.MissingCorrelatives = append(blocked.MissingCorrelatives, missingCorrelative{
+				ID:          m.ID,
+				Name:        m.Name,
+				Requirement: m.Requirement,
+				Status:      m.Status,
+			})
+		}
+
+		response.Blocked = append(response.Blocked, blocked)
+	}
+
+	for _, id := range graph.longestRemainingChain(subjects) {
+		response.RemainingPath.Subjects = append(response.RemainingPath.Subjects, pathSubject{
+			ID:     id,
+			Name:   subjects[id].Name,
+			Status: subjects[id].Status,
+		})
+	}
+
+	response.RemainingPath.Length = len(response.RemainingPath.Subjects)
+
+	b, err := json.Marshal(response)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal response: %v", err)
+	}
+
+	return b, nil
+}
+
+func indexStudentSubjects(studentSubjects []storage.StudentSubject) (map[int]storage.StudentSubject, []int) {
+	subjects := make(map[int]storage.StudentSubject, len(studentSubjects))
+	subjectIDs := make([]int, 0, len(studentSubjects))
+	for _, subject := range studentSubjects {
+		subjects[subject.ID] = subject
+		subjectIDs = append(subjectIDs, subject.ID)
+	}
+
+	return subjects, subjectIDs
+}
+
+func isEnrollable(status string) bool {
+	return status == statusPendiente || status == statusLibre
+}
+
+// longestRemainingChain returns the longest chain of not approved subjects, ordered from the first
+// one to take to the last. Its length is the minimum number of terms left to graduate.
+func (g correlativeGraph) longestRemainingChain(subjects map[int]storage.StudentSubject) []int {
+	depths := make(map[int]int, len(g))
+	next := make(map[int]int, len(g))
+
+	var depth func(id int) int
+	depth = func(id int) int {
+		if d, ok := depths[id]; ok {
+			return d
+		}
+
+		d := 1
+		for _, c := range g[id] {
+			if subjects[c.ID].Status == statusAprobada {
+				continue
+			}
+
+			if cd := depth(c.ID) + 1; cd > d {
+				d = cd
+				next[id] = c.ID
+			}
+		}
+
+		depths[id] = d
+		return d
+	}
+
+	var last, longest int
+	for _, id := range g.subjectIDs() {
+		if subjects[id].Status == statusAprobada {
+			continue
+		}
+
+		if d := depth(id); d > longest {
+			last, longest = id, d
+		}
+	}
+
+	chain := make([]int, 0, longest)
+	for i := 0; i < longest; i++ {
+		chain = append(chain, last)
+		last = next[last]
+	}
+
+	for i, j := 0, len(chain)-1; i < j; i, j = i+1, j-1 {
+		chain[i], chain[j] = chain[j], chain[i]
+	}
+
+	return chain
+}
@@ -0,0 +1,137 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
+)
+
+func TestService_GetAvailableSubjects(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("GetStudentSubjects", "example@gmail.com", "1").Return([]storage.StudentSubject{
+		{ID: 1, Name: "Análisis Matemático I", Type: "CUATRIMESTRAL", Status: "APROBADA"},
+		{ID: 2, Name: "Álgebra", Type: "CUATRIMESTRAL", Status: "REGULARIZADA"},
+		{ID: 3, Name: "Análisis Matemático II", Type: "CUATRIMESTRAL", Status: "PENDIENTE"},
+		{ID: 4, Name: "Probabilidad", Type: "CUATRIMESTRAL", Status: "LIBRE"},
+		{ID: 5, Name: "Estadística", Type: "CUATRIMESTRAL", Status: "PENDIENTE"},
+		{ID: 6, Name: "Investigación Operativa", Type: "ANUAL", Status: "PENDIENTE"},
+	}, nil)
+
+	storage_.On("GetCorrelatives", "1").Return([]storage.Correlative{
+		{SubjectID: 3, CorrelativeID: 1, Requirement: "APROBADA"},
+		{SubjectID: 3, CorrelativeID: 2, Requirement: "REGULARIZADA"},
+		{SubjectID: 4, CorrelativeID: 2, Requirement: "APROBADA"},
+		{SubjectID: 5, CorrelativeID: 4, Requirement: "REGULARIZADA"},
+		{SubjectID: 6, CorrelativeID: 5, Requirement: "REGULARIZADA"},
+	}, nil)
+
+	s := NewService(&storage_)
+
+	// When
+	b, err := s.GetAvailableSubjects("example@gmail.com", "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.JSONEq(t, `{
+		"available": [
+			{"id": 3, "name": "Análisis Matemático II", "type": "CUATRIMESTRAL", "status": "PENDIENTE"}
+		],
+		"blocked": [
+			{
+				"id": 4,
+				"name": "Probabilidad",
+				"type": "CUATRIMESTRAL",
+				"status": "LIBRE",
+				"missing_correlatives": [{"id": 2, "name": "Álgebra", "requirement": "APROBADA", "status": "REGULARIZADA"}]
+			},
+			{
+				"id": 5,
+				"name": "Estadística",
+				"type": "CUATRIMESTRAL",
+				"status": "PENDIENTE",
+				"missing_correlatives": [{"id": 4, "name": "Probabilidad", "requirement": "REGULARIZADA", "status": "LIBRE"}]
+			},
+			{
+				"id": 6,
+				"name": "Investigación Operativa",
+				"type": "ANUAL",
+				"status": "PENDIENTE",
+				"missing_correlatives": [{"id": 5, "name": "Estadística", "requirement": "REGULARIZADA", "status": "PENDIENTE"}]
+			}
+		],
+		"remaining_path": {
+			"length": 4,
+			"subjects": [
+				{"id": 2, "name": "Álgebra", "status": "REGULARIZADA"},
+				{"id": 4, "name": "Probabilidad", "status": "LIBRE"},
+				{"id": 5, "name": "Estadística", "status": "PENDIENTE"},
+				{"id": 6, "name": "Investigación Operativa", "status": "PENDIENTE"}
+			]
+		}
+	}`, string(b))
+}
+
+func TestService_GetAvailableSubjects_AllApproved(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("GetStudentSubjects", "example@gmail.com", "1").Return([]storage.StudentSubject{
+		{ID: 1, Name: "Análisis Matemático I", Type: "CUATRIMESTRAL", Status: "APROBADA"},
+		{ID: 2, Name: "Análisis Matemático II", Type: "CUATRIMESTRAL", Status: "APROBADA"},
+	}, nil)
+
+	storage_.On("GetCorrelatives", "1").Return([]storage.Correlative{
+		{SubjectID: 2, CorrelativeID: 1, Requirement: "APROBADA"},
+	}, nil)
+
+	s := NewService(&storage_)
+
+	// When
+	b, err := s.GetAvailableSubjects("example@gmail.com", "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.JSONEq(t, `{"available":[],"blocked":[],"remaining_path":{"length":0,"subjects":[]}}`, string(b))
+}
+
+func TestService_GetAvailableSubjects_StorageNotFoundError(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("GetStudentSubjects", "example@gmail.com", "1").Return([]storage.StudentSubject{}, storage.ErrNotFound)
+
+	s := NewService(&storage_)
+
+	// When
+	_, err := s.GetAvailableSubjects("example@gmail.com", "1")
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "could not get subjects: service: resource not found")
+}
+
+func TestService_GetAvailableSubjects_GetCorrelativesError(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("GetStudentSubjects", "example@gmail.com", "1").Return([]storage.StudentSubject{}, nil)
+	storage_.On("GetCorrelatives", "1").Return([]storage.Correlative{}, errors.New("error"))
+
+	s := NewService(&storage_)
+
+	// When
+	_, err := s.GetAvailableSubjects("example@gmail.com", "1")
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "could not get correlatives: error")
+}
@@ -0,0 +1,376 @@
+package service
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
+)
+
+const (
+	defaultCalendarTimezone = "America/Argentina/Buenos_Aires"
+	calendarProductID       = "-//AnitiMonono//StudentAPI//ES"
+	calendarDateTimeLayout  = "20060102T150405"
+	calendarMaxLineLength   = 75
+	calendarTokenLength     = 32
+)
+
+type CalendarConfig struct {
+	TermStart time.Time
+	TermEnd   time.Time
+	Location  *time.Location
+}
+
+func WithCalendar(config CalendarConfig) Option {
+	return func(s *Service) {
+		s.calendar = config
+	}
+}
+
+// defaultTerm returns the cuatrimestre that contains now when no term is configured nor stored:
+// March to July for the first one and August to November for the second one.
+func defaultTerm(now time.Time, location *time.Location) (time.Time, time.Time) {
+	year := now.Year()
+	if now.Month() < time.August {
+		return time.Date(year, time.March, 1, 0, 0, 0, 0, location), time.Date(year, time.July, 31, 0, 0, 0, 0, location)
+	}
+
+	return time.Date(year, time.August, 1, 0, 0, 0, 0, location), time.Date(year, time.November, 30, 0, 0, 0, 0, location)
+}
+
+// getCalendarTerm returns the dates of the requested term or, when none is requested, of the current one, along
+// with its id to filter the schedules by. The configured dates take precedence over the ones of the current term.
+func (s *Service) getCalendarTerm(config CalendarConfig, term string, now time.Time) (time.Time, time.Time, string, error) {
+	if term != "" {
+		t, err := s.getTerm(term)
+		if err != nil {
+			return time.Time{}, time.Time{}, "", err
+		}
+
+		start, end, err := parseTermDates(t, config.Location)
+		return start, end, strconv.Itoa(t.ID), err
+	}
+
+	t, err := s.storage.GetCurrentTerm(now.Format("2006-01-02"))
+	if err != nil && !errors.Is(err, storage.ErrNotFound) {
+		return time.Time{}, time.Time{}, "", fmt.Errorf("could not get current term: %v", err)
+	}
+
+	var termID string
+	if err == nil {
+		termID = strconv.Itoa(t.ID)
+	}
+
+	switch {
+	case !config.TermStart.IsZero() && !config.TermEnd.IsZero():
+		return config.TermStart, config.TermEnd, termID, nil
+	case termID == "":
+		start, end := defaultTerm(now, config.Location)
+		return start, end, termID, nil
+	default:
+		start, end, err := parseTermDates(t, config.Location)
+		return start, end, termID, err
+	}
+}
+
+// calendarName names the calendar after the cuatrimestre, since the feed is shared and must not reveal the student.
+func calendarName(termStart time.Time) string {
+	cuatrimestre := 1
+	if termStart.Month() >= time.August {
+		cuatrimestre = 2
+	}
+
+	return fmt.Sprintf("Cursada %dC %d", cuatrimestre, termStart.Year())
+}
+
+func parseTermDates(term storage.Term, location *time.Location) (time.Time, time.Time, error) {
+	start, err := time.ParseInLocation("2006-01-02", term.Start, location)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("could not parse term start: %v", err)
+	}
+
+	end, err := time.ParseInLocation("2006-01-02", term.End, location)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("could not parse term end: %v", err)
+	}
+
+	return start, end, nil
+}
+
+// GetStudentCalendar returns the classes of the student in the requested term, or in the current one when none
+// is requested.
+func (s *Service) GetStudentCalendar(studentEmail, term string) ([]byte, error) {
+	config := s.calendar
+	if config.Location == nil {
+		var err error
+		if config.Location, err = time.LoadLocation(defaultCalendarTimezone); err != nil {
+			return nil, fmt.Errorf("could not load calendar timezone: %v", err)
+		}
+	}
+
+	now := s.now()
+	termStart, termEnd, termID, err := s.getCalendarTerm(config, term, now.In(config.Location))
+	if err != nil {
+		return nil, err
+	}
+
+	schedules, err := s.storage.GetStudentSchedules(studentEmail, termID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, fmt.Errorf("could not get student schedules [student_email: %s]: %w", studentEmail, ErrNotFound)
+		}
+
+		return nil, fmt.Errorf("could not get student schedules: %v", err)
+	}
+
+	tzid := config.Location.String()
+
+	var b bytes.Buffer
+	w := calendarWriter{&b}
+	w.line("BEGIN:VCALENDAR")
+	w.line("VERSION:2.0")
+	w.line("PRODID:" + calendarProductID)
+	w.line("CALSCALE:GREGORIAN")
+	w.line("METHOD:PUBLISH")
+	w.line("X-WR-CALNAME:" + escapeCalendarText(calendarName(termStart)))
+	w.line("X-WR-TIMEZONE:" + tzid)
+
+	until := time.Date(termEnd.Year(), termEnd.Month(), termEnd.Day(), 23, 59, 59, 0, config.Location).UTC()
+	w.timezone(config.Location, termStart, until)
+	for _, schedule := range schedules {
+		start, end, err := firstOccurrence(schedule, termStart, config.Location)
+		if err != nil {
+			return nil, err
+		}
+
+		w.line("BEGIN:VEVENT")
+		w.line(fmt.Sprintf("UID:%d-%d-%s@studentapi", schedule.ProfessorshipID, schedule.Day, start.Format("1504")))
+		w.line("DTSTAMP:" + now.UTC().Format(calendarDateTimeLayout) + "Z")
+		w.line(fmt.Sprintf("DTSTART;TZID=%s:%s", tzid, start.Format(calendarDateTimeLayout)))
+		w.line(fmt.Sprintf("DTEND;TZID=%s:%s", tzid, end.Format(calendarDateTimeLayout)))
+		w.line("RRULE:FREQ=WEEKLY;UNTIL=" + until.Format(calendarDateTimeLayout) + "Z")
+		w.line("SUMMARY:" + escapeCalendarText(fmt.Sprintf("%s (%s)", schedule.SubjectName, schedule.ProfessorshipName)))
+		if schedule.Meet != nil && *schedule.Meet != "" {
+			w.line("LOCATION:" + escapeCalendarText(*schedule.Meet))
+			w.line("URL:" + *schedule.Meet)
+		}
+
+		w.line("END:VEVENT")
+	}
+
+	w.line("END:VCALENDAR")
+
+	return b.Bytes(), nil
+}
+
+// CreateCalendarToken issues a new secret for the calendar feed of the student, replacing the previous one, so
+// calendar clients can subscribe without a session token. Only its hash is stored.
+func (s *Service) CreateCalendarToken(studentEmail string) ([]byte, error) {
+	secret := make([]byte, calendarTokenLength)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, fmt.Errorf("could not generate calendar token: %v", err)
+	}
+
+	token := base64.RawURLEncoding.EncodeToString(secret)
+	tokenHash := hashCalendarToken(token)
+	if err := s.setCalendarToken(studentEmail, &tokenHash); err != nil {
+		return nil, err
+	}
+
+	b, err := json.Marshal(struct {
+		Token string `json:"token"`
+		Path  string `json:"path"`
+	}{
+		Token: token,
+		Path:  "/calendar/" + token + ".ics",
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal response: %v", err)
+	}
+
+	return b, nil
+}
+
+// RevokeCalendarToken stops serving the calendar feed of the student by its secret.
+func (s *Service) RevokeCalendarToken(studentEmail string) error {
+	return s.setCalendarToken(studentEmail, nil)
+}
+
+func (s *Service) setCalendarToken(studentEmail string, tokenHash *string) error {
+	if err := s.storage.SetStudentCalendarToken(studentEmail, tokenHash); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return fmt.Errorf("could not set calendar token [student_email: %s]: %w", studentEmail, ErrNotFound)
+		}
+
+		return fmt.Errorf("could not set calendar token [student_email: %s]: %v", studentEmail, err)
+	}
+
+	return nil
+}
+
+// GetCalendarByToken returns the calendar of the student the feed secret was issued to.
+func (s *Service) GetCalendarByToken(token, term string) ([]byte, error) {
+	studentEmail, err := s.storage.GetStudentEmailByCalendarToken(hashCalendarToken(token))
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, fmt.Errorf("could not find calendar: %w", ErrNotFound)
+		}
+
+		return nil, fmt.Errorf("could not get calendar token: %v", err)
+	}
+
+	return s.GetStudentCalendar(studentEmail, term)
+}
+
+func hashCalendarToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func firstOccurrence(schedule storage.StudentSchedule, termStart time.Time, location *time.Location) (time.Time, time.Time, error) {
+	slot, err := newTimeSlot(schedule.ProfessorshipID, schedule.Day, schedule.Start, schedule.End)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	weekday := time.Weekday(slot.Day % 7)
+	day := time.Date(termStart.Year(), termStart.Month(), termStart.Day(), 0, 0, 0, 0, location)
+	day = day.AddDate(0, 0, (int(weekday)-int(day.Weekday())+7)%7)
+
+	start := day.Add(time.Duration(slot.Start) * time.Minute)
+	end := day.Add(time.Duration(slot.End) * time.Minute)
+
+	return start, end, nil
+}
+
+type calendarWriter struct {
+	b *bytes.Buffer
+}
+
+func (w calendarWriter) line(content string) {
+	w.b.WriteString(foldCalendarLine(content))
+}
+
+// foldCalendarLine splits a content line in lines of at most 75 octets as required by RFC 5545,
+// without breaking multi-byte characters.
+func foldCalendarLine(content string) string {
+	var b strings.Builder
+	limit := calendarMaxLineLength
+	for len(content) > limit {
+		cut := limit
+		for cut > 0 && !isRuneStart(content[cut]) {
+			cut--
+		}
+
+		b.WriteString(content[:cut])
+		b.WriteString("\r\n ")
+		content = content[cut:]
+		limit = calendarMaxLineLength - 1
+	}
+
+	b.WriteString(content)
+	b.WriteString("\r\n")
+	return b.String()
+}
+
+type zoneTransition struct {
+	at         time.Time
+	name       string
+	offsetFrom int
+	offsetTo   int
+}
+
+// zoneTransitions returns the offset in effect at start followed by every change of offset up to end.
+func zoneTransitions(location *time.Location, start, end time.Time) []zoneTransition {
+	name, offset := start.In(location).Zone()
+	transitions := []zoneTransition{{at: start, name: name, offsetFrom: offset, offsetTo: offset}}
+
+	for day := start; day.Before(end); day = day.AddDate(0, 0, 1) {
+		next := day.AddDate(0, 0, 1)
+		if _, nextOffset := next.In(location).Zone(); nextOffset == offset {
+			continue
+		}
+
+		// Offsets change at most once a day, so the change is found by bisecting the day.
+		from, to := day, next
+		for to.Sub(from) > time.Second {
+			middle := from.Add(to.Sub(from) / 2)
+			if _, middleOffset := middle.In(location).Zone(); middleOffset == offset {
+				from = middle
+			} else {
+				to = middle
+			}
+		}
+
+		nextName, nextOffset := to.In(location).Zone()
+		transitions = append(transitions, zoneTransition{at: to, name: nextName, offsetFrom: offset, offsetTo: nextOffset})
+		offset = nextOffset
+	}
+
+	return transitions
+}
+
+// standardOffset returns the offset the location uses out of daylight saving time in the year, the lowest of
+// the winter and summer ones.
+func standardOffset(location *time.Location, year int) int {
+	_, january := time.Date(year, time.January, 1, 0, 0, 0, 0, location).Zone()
+	_, july := time.Date(year, time.July, 1, 0, 0, 0, 0, location).Zone()
+	if july < january {
+		return july
+	}
+
+	return january
+}
+
+// timezone describes the location with an observance for the offset in effect when the term starts and one for
+// every daylight saving time change until it ends, so clients place the events right on both sides of a change.
+func (w calendarWriter) timezone(location *time.Location, start, end time.Time) {
+	w.line("BEGIN:VTIMEZONE")
+	w.line("TZID:" + location.String())
+	for _, transition := range zoneTransitions(location, start, end) {
+		kind := "STANDARD"
+		if transition.offsetTo > standardOffset(location, transition.at.In(location).Year()) {
+			kind = "DAYLIGHT"
+		}
+
+		// The onset is written in the local time in effect before it.
+		onset := transition.at.UTC().Add(time.Duration(transition.offsetFrom) * time.Second)
+
+		w.line("BEGIN:" + kind)
+		w.line("DTSTART:" + onset.Format(calendarDateTimeLayout))
+		w.line("TZOFFSETFROM:" + formatUTCOffset(transition.offsetFrom))
+		w.line("TZOFFSETTO:" + formatUTCOffset(transition.offsetTo))
+		w.line("TZNAME:" + transition.name)
+		w.line("END:" + kind)
+	}
+
+	w.line("END:VTIMEZONE")
+}
+
+func formatUTCOffset(offset int) string {
+	sign := "+"
+	if offset < 0 {
+		sign = "-"
+		offset = -offset
+	}
+
+	return fmt.Sprintf("%s%02d%02d", sign, offset/3600, offset%3600/60)
+}
+
+func isRuneStart(b byte) bool {
+	return b&0xC0 != 0x80
+}
+
+func escapeCalendarText(text string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`).Replace(text)
+}
@@ -0,0 +1,268 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage/memory"
+)
+
+func TestService_GetStudentCalendar(t *testing.T) {
+	// Given
+	location, err := time.LoadLocation("America/Argentina/Buenos_Aires")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	meet := "https://meet.google.com/abc-defg-hij"
+
+	storage_ := storageMock{}
+	storage_.On("GetStudentSchedules", "example@gmail.com", "").Return([]storage.StudentSchedule{
+		{ProfessorshipID: 7, ProfessorshipName: "K1021", SubjectName: "Física I", Meet: &meet, Day: 1, Start: "19:00:00", End: "22:00:00"},
+		{ProfessorshipID: 8, ProfessorshipName: "K1031", SubjectName: "Química, General", Day: 4, Start: "08:30:00", End: "10:00:00"},
+	}, nil)
+	storage_.On("GetCurrentTerm", "2021-03-01").Return(storage.Term{}, storage.ErrNotFound)
+
+	s := NewService(&storage_, WithCalendar(CalendarConfig{
+		TermStart: time.Date(2021, time.March, 17, 0, 0, 0, 0, location),
+		TermEnd:   time.Date(2021, time.July, 10, 0, 0, 0, 0, location),
+		Location:  location,
+	}))
+
+	s.now = func() time.Time {
+		return time.Date(2021, time.March, 1, 12, 0, 0, 0, time.UTC)
+	}
+
+	// When
+	b, err := s.GetStudentCalendar("example@gmail.com", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	expected := []string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//AnitiMonono//StudentAPI//ES",
+		"CALSCALE:GREGORIAN",
+		"METHOD:PUBLISH",
+		"X-WR-CALNAME:Cursada 1C 2021",
+		"X-WR-TIMEZONE:America/Argentina/Buenos_Aires",
+		"BEGIN:VTIMEZONE",
+		"TZID:America/Argentina/Buenos_Aires",
+		"BEGIN:STANDARD",
+		"DTSTART:20210317T000000",
+		"TZOFFSETFROM:-0300",
+		"TZOFFSETTO:-0300",
+		"TZNAME:-03",
+		"END:STANDARD",
+		"END:VTIMEZONE",
+		"BEGIN:VEVENT",
+		"UID:7-1-1900@studentapi",
+		"DTSTAMP:20210301T120000Z",
+		"DTSTART;TZID=America/Argentina/Buenos_Aires:20210322T190000",
+		"DTEND;TZID=America/Argentina/Buenos_Aires:20210322T220000",
+		"RRULE:FREQ=WEEKLY;UNTIL=20210711T025959Z",
+		"SUMMARY:Física I (K1021)",
+		"LOCATION:https://meet.google.com/abc-defg-hij",
+		"URL:https://meet.google.com/abc-defg-hij",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:8-4-0830@studentapi",
+		"DTSTAMP:20210301T120000Z",
+		"DTSTART;TZID=America/Argentina/Buenos_Aires:20210318T083000",
+		"DTEND;TZID=America/Argentina/Buenos_Aires:20210318T100000",
+		"RRULE:FREQ=WEEKLY;UNTIL=20210711T025959Z",
+		"SUMMARY:Química\\, General (K1031)",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}
+
+	require.Equal(t, strings.Join(expected, "\r\n")+"\r\n", string(b))
+}
+
+func TestService_GetStudentCalendar_DefaultTerm(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("GetStudentSchedules", "example@gmail.com", "").Return([]storage.StudentSchedule{
+		{ProfessorshipID: 7, ProfessorshipName: "K1021", SubjectName: "Física I", Day: 2, Start: "19:00:00", End: "22:00:00"},
+	}, nil)
+
+	storage_.On("GetCurrentTerm", "2021-09-10").Return(storage.Term{}, storage.ErrNotFound)
+
+	s := NewService(&storage_)
+	s.now = func() time.Time {
+		return time.Date(2021, time.September, 10, 12, 0, 0, 0, time.UTC)
+	}
+
+	// When
+	b, err := s.GetStudentCalendar("example@gmail.com", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	calendar := string(b)
+	require.Contains(t, calendar, "DTSTART;TZID=America/Argentina/Buenos_Aires:20210803T190000\r\n")
+	require.Contains(t, calendar, "RRULE:FREQ=WEEKLY;UNTIL=20211201T025959Z\r\n")
+}
+
+func TestService_GetStudentCalendar_DaylightSavingTime(t *testing.T) {
+	// Given
+	location, err := time.LoadLocation("Europe/Madrid")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	storage_ := storageMock{}
+	storage_.On("GetStudentSchedules", "example@gmail.com", "").Return([]storage.StudentSchedule{
+		{ProfessorshipID: 7, ProfessorshipName: "K1021", SubjectName: "Física I", Day: 1, Start: "19:00:00", End: "22:00:00"},
+	}, nil)
+	storage_.On("GetCurrentTerm", mock.Anything).Return(storage.Term{}, storage.ErrNotFound)
+
+	s := NewService(&storage_, WithCalendar(CalendarConfig{
+		TermStart: time.Date(2021, time.March, 1, 0, 0, 0, 0, location),
+		TermEnd:   time.Date(2021, time.November, 30, 0, 0, 0, 0, location),
+		Location:  location,
+	}))
+
+	// When
+	b, err := s.GetStudentCalendar("example@gmail.com", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	expected := []string{
+		"BEGIN:VTIMEZONE",
+		"TZID:Europe/Madrid",
+		"BEGIN:STANDARD",
+		"DTSTART:20210301T000000",
+		"TZOFFSETFROM:+0100",
+		"TZOFFSETTO:+0100",
+		"TZNAME:CET",
+		"END:STANDARD",
+		"BEGIN:DAYLIGHT",
+		"DTSTART:20210328T020000",
+		"TZOFFSETFROM:+0100",
+		"TZOFFSETTO:+0200",
+		"TZNAME:CEST",
+		"END:DAYLIGHT",
+		"BEGIN:STANDARD",
+		"DTSTART:20211031T030000",
+		"TZOFFSETFROM:+0200",
+		"TZOFFSETTO:+0100",
+		"TZNAME:CET",
+		"END:STANDARD",
+		"END:VTIMEZONE",
+	}
+
+	require.Contains(t, string(b), strings.Join(expected, "\r\n")+"\r\n")
+}
+
+func TestService_GetStudentCalendar_StorageError(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("GetStudentSchedules", "example@gmail.com", "").Return([]storage.StudentSchedule{}, errors.New("error"))
+	storage_.On("GetCurrentTerm", mock.Anything).Return(storage.Term{}, storage.ErrNotFound)
+
+	s := NewService(&storage_)
+
+	// When
+	_, err := s.GetStudentCalendar("example@gmail.com", "")
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "could not get student schedules: error")
+}
+
+func TestService_GetStudentCalendar_StudentNotFoundError(t *testing.T) {
+	// Given
+	s := NewService(memory.NewStorage())
+
+	// When
+	_, err := s.GetStudentCalendar("example@gmail.com", "")
+
+	// Then
+	require.True(t, errors.Is(err, ErrNotFound))
+}
+
+func TestService_CalendarToken_MemoryStorage(t *testing.T) {
+	// Given
+	storage_ := memory.NewStorage()
+	require.NoError(t, storage_.CreateStudent("example", "example@gmail.com", "hash"))
+
+	s := NewService(storage_)
+
+	var issued struct {
+		Token string `json:"token"`
+		Path  string `json:"path"`
+	}
+
+	b, err := s.CreateCalendarToken("example@gmail.com")
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(b, &issued))
+
+	// When
+	calendar, err := s.GetCalendarByToken(issued.Token, "")
+
+	// Then
+	require.NoError(t, err)
+	require.Contains(t, string(calendar), "X-WR-CALNAME:Cursada ")
+	require.NotContains(t, string(calendar), "example@gmail.com")
+	require.Equal(t, "/calendar/"+issued.Token+".ics", issued.Path)
+
+	// Rotating the token invalidates the previous one and revoking it invalidates every one.
+	b, err = s.CreateCalendarToken("example@gmail.com")
+	require.NoError(t, err)
+
+	previous := issued.Token
+	require.NoError(t, json.Unmarshal(b, &issued))
+	require.NotEqual(t, previous, issued.Token)
+
+	_, err = s.GetCalendarByToken(previous, "")
+	require.True(t, errors.Is(err, ErrNotFound))
+
+	require.NoError(t, s.RevokeCalendarToken("example@gmail.com"))
+
+	_, err = s.GetCalendarByToken(issued.Token, "")
+	require.True(t, errors.Is(err, ErrNotFound))
+}
+
+func TestService_CreateCalendarToken_NotFoundError(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("SetStudentCalendarToken", "example@gmail.com", mock.AnythingOfType("*string")).Return(storage.ErrNotFound)
+
+	s := NewService(&storage_)
+
+	// When
+	_, err := s.CreateCalendarToken("example@gmail.com")
+
+	// Then
+	require.True(t, errors.Is(err, ErrNotFound))
+}
+
+func TestFoldCalendarLine(t *testing.T) {
+	// Given
+	line := "SUMMARY:" + strings.Repeat("á", 40)
+
+	// When
+	folded := foldCalendarLine(line)
+
+	// Then
+	for _, l := range strings.Split(strings.TrimSuffix(folded, "\r\n"), "\r\n") {
+		require.LessOrEqual(t, len(l), 75)
+	}
+
+	require.Equal(t, line, strings.ReplaceAll(strings.TrimSuffix(folded, "\r\n"), "\r\n ", ""))
+}
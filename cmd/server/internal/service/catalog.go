@@ -0,0 +1,101 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
+)
+
+type career struct {
+	ID        int     `json:"id"`
+	FacultyID int     `json:"faculty_id"`
+	Name      string  `json:"name"`
+	URI       *string `json:"uri"`
+}
+
+func (s *Service) GetFaculties() ([]byte, error) {
+	type faculty struct {
+		ID   int     `json:"id"`
+		Name string  `json:"name"`
+		URI  *string `json:"uri"`
+	}
+
+	faculties, err := s.storage.GetFaculties()
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, fmt.Errorf("could not get faculties: %w", ErrNotFound)
+		}
+
+		return nil, fmt.Errorf("could not get faculties: %v", err)
+	}
+
+	response := make([]faculty, 0, len(faculties))
+	for _, f := range faculties {
+		response = append(response, faculty{
+			ID:   f.ID,
+			Name: f.Name,
+			URI:  f.URI,
+		})
+	}
+
+	b, err := json.Marshal(response)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal response: %v", err)
+	}
+
+	return b, nil
+}
+
+func (s *Service) GetFacultyCareers(facultyID string) ([]byte, error) {
+	careers, err := s.storage.GetFacultyCareers(facultyID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, fmt.Errorf("could not get careers: %w", ErrNotFound)
+		}
+
+		return nil, fmt.Errorf("could not get careers: %v", err)
+	}
+
+	response := make([]career, 0, len(careers))
+	for _, c := range careers {
+		response = append(response, career{
+			ID:        c.ID,
+			FacultyID: c.FacultyID,
+			Name:      c.Name,
+			URI:       c.URI,
+		})
+	}
+
+	b, err := json.Marshal(response)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal response: %v", err)
+	}
+
+	return b, nil
+}
+
+func (s *Service) GetCareer(careerID string) ([]byte, error) {
+	c, err := s.storage.GetCareer(careerID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, fmt.Errorf("could not get career: %w", ErrNotFound)
+		}
+
+		return nil, fmt.Errorf("could not get career: %v", err)
+	}
+
+	b, err := json.Marshal(career{
+		ID:        c.ID,
+		FacultyID: c.FacultyID,
+		Name:      c.Name,
+		URI:       c.URI,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal response: %v", err)
+	}
+
+	return b, nil
+}
@@ -0,0 +1,163 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
+)
+
+func TestService_GetFaculties(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("GetFaculties").Return([]storage.Faculty{
+		{
+			ID:   1,
+			Name: "Ciencias Exactas y Naturales",
+			URI:  stringToPtr("https://exactas.uba.ar"),
+		},
+	}, nil)
+
+	s := NewService(&storage_)
+
+	// When
+	faculties, err := s.GetFaculties()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Equal(t, []byte(`[{"id":1,"name":"Ciencias Exactas y Naturales","uri":"https://exactas.uba.ar"}]`), faculties)
+}
+
+func TestService_GetFaculties_StorageError(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("GetFaculties").Return([]storage.Faculty{}, errors.New("error"))
+
+	s := NewService(&storage_)
+
+	// When
+	_, err := s.GetFaculties()
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "could not get faculties: error")
+}
+
+func TestService_GetFaculties_StorageNotFoundError(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("GetFaculties").Return([]storage.Faculty{}, storage.ErrNotFound)
+
+	s := NewService(&storage_)
+
+	// When
+	_, err := s.GetFaculties()
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "could not get faculties: service: resource not found")
+}
+
+func TestService_GetFacultyCareers(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("GetFacultyCareers", "1").Return([]storage.Career{
+		{
+			ID:        2,
+			FacultyID: 1,
+			Name:      "Ciencias de la Computación",
+		},
+	}, nil)
+
+	s := NewService(&storage_)
+
+	// When
+	careers, err := s.GetFacultyCareers("1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Equal(t, []byte(`[{"id":2,"faculty_id":1,"name":"Ciencias de la Computación","uri":null}]`), careers)
+}
+
+func TestService_GetFacultyCareers_StorageNotFoundError(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("GetFacultyCareers", "1").Return([]storage.Career{}, storage.ErrNotFound)
+
+	s := NewService(&storage_)
+
+	// When
+	_, err := s.GetFacultyCareers("1")
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "could not get careers: service: resource not found")
+}
+
+func TestService_GetCareer(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("GetCareer", "2").Return(storage.Career{
+		ID:        2,
+		FacultyID: 1,
+		Name:      "Ciencias de la Computación",
+		URI:       stringToPtr("https://dc.uba.ar"),
+	}, nil)
+
+	s := NewService(&storage_)
+
+	// When
+	career, err := s.GetCareer("2")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Equal(t, []byte(`{"id":2,"faculty_id":1,"name":"Ciencias de la Computación","uri":"https://dc.uba.ar"}`), career)
+}
+
+func TestService_GetCareer_StorageError(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("GetCareer", "2").Return(storage.Career{}, errors.New("error"))
+
+	s := NewService(&storage_)
+
+	// When
+	_, err := s.GetCareer("2")
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "could not get career: error")
+}
+
+func TestService_GetCareer_StorageNotFoundError(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("GetCareer", "2").Return(storage.Career{}, storage.ErrNotFound)
+
+	s := NewService(&storage_)
+
+	// When
+	_, err := s.GetCareer("2")
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "could not get career: service: resource not found")
+}
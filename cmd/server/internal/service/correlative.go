@@ -0,0 +1,241 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
+)
+
+const (
+	requirementRegularizada = "REGULARIZADA"
+	requirementAprobada     = "APROBADA"
+)
+
+var ErrCorrelativesCycle = errors.New("service: correlatives have a cycle")
+
+// CorrelativesCycleError names the subject where the cycle was found.
+type CorrelativesCycleError struct {
+	SubjectID int
+}
+
+func (e *CorrelativesCycleError) Error() string {
+	return fmt.Sprintf("%v [subject_id: %d]", ErrCorrelativesCycle, e.SubjectID)
+}
+
+func (e *CorrelativesCycleError) Unwrap() error {
+	return ErrCorrelativesCycle
+}
+
+type correlative struct {
+	ID          int    `json:"id"`
+	Requirement string `json:"requirement"`
+}
+
+// correlativeGraph maps every subject of a career to the subjects it requires.
+type correlativeGraph map[int][]correlative
+
+func newCorrelativeGraph(subjectIDs []int, correlatives []storage.Correlative) (correlativeGraph, error) {
+	requirements := make(map[int]map[int]string, len(subjectIDs))
+	for _, c := range correlatives {
+		if requirements[c.SubjectID] == nil {
+			requirements[c.SubjectID] = map[int]string{}
+		}
+
+		requirement := normalizeRequirement(c.Requirement)
+		if requirements[c.SubjectID][c.CorrelativeID] == requirementAprobada {
+			continue
+		}
+
+		requirements[c.SubjectID][c.CorrelativeID] = requirement
+	}
+
+	graph := make(correlativeGraph, len(subjectIDs))
+	for _, id := range subjectIDs {
+		graph[id] = []correlative{}
+	}
+
+	for subjectID, subjectRequirements := range requirements {
+		subjectCorrelatives := make([]correlative, 0, len(subjectRequirements))
+		for id, requirement := range subjectRequirements {
+			subjectCorrelatives = append(subjectCorrelatives, correlative{ID: id, Requirement: requirement})
+		}
+
+		sort.Slice(subjectCorrelatives, func(i, j int) bool {
+			return subjectCorrelatives[i].ID < subjectCorrelatives[j].ID
+		})
+
+		graph[subjectID] = subjectCorrelatives
+	}
+
+	if err := graph.checkAcyclic(); err != nil {
+		return nil, err
+	}
+
+	return graph, nil
+}
+
+func normalizeRequirement(requirement string) string {
+	if strings.ToUpper(requirement) == requirementRegularizada {
+		return requirementRegularizada
+	}
+
+	return requirementAprobada
+}
+
+func (g correlativeGraph) subjectIDs() []int {
+	ids := make([]int, 0, len(g))
+	for id := range g {
+		ids = append(ids, id)
+	}
+
+	sort.Ints(ids)
+	return ids
+}
+
+func (g correlativeGraph) checkAcyclic() error {
+	const (
+		visiting = iota + 1
+		visited
+	)
+
+	state := make(map[int]int, len(g))
+
+	var visit func(id int) error
+	visit = func(id int) error {
+		switch state[id] {
+		case visiting:
+			return &CorrelativesCycleError{SubjectID: id}
+		case visited:
+			return nil
+		}
+
+		state[id] = visiting
+		for _, c := range g[id] {
+			if err := visit(c.ID); err != nil {
+				return err
+			}
+		}
+
+		state[id] = visited
+		return nil
+	}
+
+	for _, id := range g.subjectIDs() {
+		if err := visit(id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+var ErrCorrelativesNotMet = errors.New("service: correlatives not met")
+
+type MissingCorrelative struct {
+	ID          int
+	Name        string
+	Requirement string
+	Status      string
+}
+
+type CorrelativesNotMetError struct {
+	Missing []MissingCorrelative
+}
+
+func (e *CorrelativesNotMetError) Error() string {
+	missing := make([]string, 0, len(e.Missing))
+	for _, m := range e.Missing {
+		missing = append(missing, fmt.Sprintf("%s [subject_id: %d] must be %s but is %s", m.Name, m.ID, m.Requirement, m.Status))
+	}
+
+	return fmt.Sprintf("%v: %s", ErrCorrelativesNotMet, strings.Join(missing, ", "))
+}
+
+func (e *CorrelativesNotMetError) Is(target error) bool {
+	return target == ErrCorrelativesNotMet
+}
+
+func (s *Service) validateStudentSubjectUpdate(policy storage.FacultyPolicy, studentEmail, careerID, subjectID, status string, enforceTransitions, enforceCorrelatives bool) error {
+	id, err := strconv.Atoi(subjectID)
+	if err != nil {
+		return fmt.Errorf("invalid subject id [subject_id: %s]: %w", subjectID, ErrNotFound)
+	}
+
+	studentSubjects, err := s.storage.GetStudentSubjects(studentEmail, careerID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return fmt.Errorf("could not get subjects: %w", ErrNotFound)
+		}
+
+		return fmt.Errorf("could not get subjects: %v", err)
+	}
+
+	subjects, subjectIDs := indexStudentSubjects(studentSubjects)
+
+	subject, exist := subjects[id]
+	if !exist {
+		return fmt.Errorf("could not find subject [subject_id: %s]: %w", subjectID, ErrNotFound)
+	}
+
+	if enforceTransitions {
+		if err := checkStatusTransition(policy, subject.Status, status); err != nil {
+			return err
+		}
+	}
+
+	if status == statusPendiente || !enforceCorrelatives {
+		return nil
+	}
+
+	correlatives, err := s.storage.GetCorrelatives(careerID)
+	if err != nil {
+		return fmt.Errorf("could not get correlatives: %v", err)
+	}
+
+	graph, err := newCorrelativeGraph(subjectIDs, correlatives)
+	if err != nil {
+		return fmt.Errorf("could not build correlatives: %w", err)
+	}
+
+	if missing := graph.missingCorrelatives(id, subjects); len(missing) > 0 {
+		return &CorrelativesNotMetError{Missing: missing}
+	}
+
+	return nil
+}
+
+func (g correlativeGraph) missingCorrelatives(subjectID int, subjects map[int]storage.StudentSubject) []MissingCorrelative {
+	var missing []MissingCorrelative
+	for _, c := range g[subjectID] {
+		subject := subjects[c.ID]
+		if satisfiesRequirement(subject.Status, c.Requirement) {
+			continue
+		}
+
+		status := subject.Status
+		if status == "" {
+			status = statusPendiente
+		}
+
+		missing = append(missing, MissingCorrelative{
+			ID:          c.ID,
+			Name:        subject.Name,
+			Requirement: c.Requirement,
+			Status:      status,
+		})
+	}
+
+	return missing
+}
+
+func satisfiesRequirement(status, requirement string) bool {
+	if status == statusAprobada {
+		return true
+	}
+
+	return requirement == requirementRegularizada && status == statusRegularizada
+}
@@ -0,0 +1,80 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
+)
+
+func TestNewCorrelativeGraph(t *testing.T) {
+	// Given
+	correlatives := []storage.Correlative{
+		{SubjectID: 3, CorrelativeID: 2, Requirement: "APROBADA"},
+		{SubjectID: 3, CorrelativeID: 1, Requirement: "regularizada"},
+		{SubjectID: 3, CorrelativeID: 1, Requirement: "REGULARIZADA"},
+		{SubjectID: 2, CorrelativeID: 1, Requirement: "APROBADA"},
+		{SubjectID: 2, CorrelativeID: 1, Requirement: "REGULARIZADA"},
+	}
+
+	// When
+	graph, err := newCorrelativeGraph([]int{1, 2, 3, 4}, correlatives)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Equal(t, correlativeGraph{
+		1: {},
+		2: {{ID: 1, Requirement: "APROBADA"}},
+		3: {{ID: 1, Requirement: "REGULARIZADA"}, {ID: 2, Requirement: "APROBADA"}},
+		4: {},
+	}, graph)
+}
+
+func TestNewCorrelativeGraph_CycleError(t *testing.T) {
+	tt := []struct {
+		name          string
+		correlatives  []storage.Correlative
+		expectedID    int
+		expectedError string
+	}{
+		{
+			name: "self reference",
+			correlatives: []storage.Correlative{
+				{SubjectID: 2, CorrelativeID: 2, Requirement: "APROBADA"},
+			},
+			expectedID:    2,
+			expectedError: "service: correlatives have a cycle [subject_id: 2]",
+		},
+		{
+			name: "indirect cycle",
+			correlatives: []storage.Correlative{
+				{SubjectID: 2, CorrelativeID: 1, Requirement: "APROBADA"},
+				{SubjectID: 3, CorrelativeID: 2, Requirement: "APROBADA"},
+				{SubjectID: 1, CorrelativeID: 3, Requirement: "REGULARIZADA"},
+			},
+			expectedID:    1,
+			expectedError: "service: correlatives have a cycle [subject_id: 1]",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// When
+			_, err := newCorrelativeGraph([]int{1, 2, 3}, tc.correlatives)
+			if err == nil {
+				t.Fatal("test must fail")
+			}
+
+			// Then
+			var cycleErr *CorrelativesCycleError
+			require.True(t, errors.As(err, &cycleErr))
+			require.Equal(t, tc.expectedID, cycleErr.SubjectID)
+			require.True(t, errors.Is(err, ErrCorrelativesCycle))
+			require.EqualError(t, err, tc.expectedError)
+		})
+	}
+}
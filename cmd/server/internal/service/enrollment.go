@@ -0,0 +1,37 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
+)
+
+var ErrAlreadyEnrolled = errors.New("service: student already enrolled in subject")
+
+func (s *Service) EnrollStudentInProfessorship(studentEmail, professorshipID string) error {
+	if err := s.storage.EnrollStudentInProfessorship(studentEmail, professorshipID); err != nil {
+		switch {
+		case errors.Is(err, storage.ErrNotFound):
+			return fmt.Errorf("could not enroll student [student_email: %s] in professorship: %w", studentEmail, ErrNotFound)
+		case errors.Is(err, storage.ErrResourceAlreadyExist):
+			return fmt.Errorf("student [student_email: %s] %w", studentEmail, ErrAlreadyEnrolled)
+		default:
+			return fmt.Errorf("could not enroll student [student_email: %s] in professorship: %v", studentEmail, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) DeleteStudentProfessorship(studentEmail, professorshipID string) error {
+	if err := s.storage.DeleteStudentProfessorship(studentEmail, professorshipID); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return fmt.Errorf("could not delete student enrollment: %w", ErrNotFound)
+		}
+
+		return fmt.Errorf("could not delete student enrollment: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
+)
+
+func TestService_EnrollStudentInProfessorship(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("EnrollStudentInProfessorship", "example@gmail.com", "7").Return(nil)
+
+	s := NewService(&storage_)
+
+	// When
+	err := s.EnrollStudentInProfessorship("example@gmail.com", "7")
+
+	// Then
+	require.NoError(t, err)
+}
+
+func TestService_EnrollStudentInProfessorship_StorageError(t *testing.T) {
+	tt := []struct {
+		name          string
+		storageError  error
+		expectedError string
+	}{
+		{
+			name:          "not found",
+			storageError:  fmt.Errorf("could not find professorship: %w", storage.ErrNotFound),
+			expectedError: "could not enroll student [student_email: example@gmail.com] in professorship: service: resource not found",
+		},
+		{
+			name:          "already enrolled",
+			storageError:  fmt.Errorf("student already enrolled in subject: %w", storage.ErrResourceAlreadyExist),
+			expectedError: "student [student_email: example@gmail.com] service: student already enrolled in subject",
+		},
+		{
+			name:          "unexpected",
+			storageError:  errors.New("error"),
+			expectedError: "could not enroll student [student_email: example@gmail.com] in professorship: error",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// Given
+			storage_ := storageMock{}
+			storage_.On("EnrollStudentInProfessorship", "example@gmail.com", "7").Return(tc.storageError)
+
+			s := NewService(&storage_)
+
+			// When
+			err := s.EnrollStudentInProfessorship("example@gmail.com", "7")
+			if err == nil {
+				t.Fatal("test must fail")
+			}
+
+			// Then
+			require.EqualError(t, err, tc.expectedError)
+		})
+	}
+}
+
+func TestService_DeleteStudentProfessorship(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("DeleteStudentProfessorship", "example@gmail.com", "7").Return(nil)
+
+	s := NewService(&storage_)
+
+	// When
+	err := s.DeleteStudentProfessorship("example@gmail.com", "7")
+
+	// Then
+	require.NoError(t, err)
+}
+
+func TestService_DeleteStudentProfessorship_StorageNotFoundError(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("DeleteStudentProfessorship", "example@gmail.com", "7").Return(storage.ErrNotFound)
+
+	s := NewService(&storage_)
+
+	// When
+	err := s.DeleteStudentProfessorship("example@gmail.com", "7")
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "could not delete student enrollment: service: resource not found")
+}
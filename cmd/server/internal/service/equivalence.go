@@ -0,0 +1,197 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
+)
+
+const statusRechazada = "RECHAZADA"
+
+var ErrInvalidEquivalence = errors.New("service: invalid equivalence")
+
+type EquivalenceRequest struct {
+	CareerID            string
+	SubjectID           string
+	EquivalentCareerID  string
+	EquivalentSubjectID string
+}
+
+// CreateEquivalence makes two subjects of different careers equivalent, so approving one credits the other when the
+// student is assigned to the other career.
+func (s *Service) CreateEquivalence(req EquivalenceRequest) ([]byte, error) {
+	if req.CareerID == req.EquivalentCareerID {
+		return nil, fmt.Errorf("could not create equivalence: subjects of the same career: %w", ErrInvalidEquivalence)
+	}
+
+	id, err := s.storage.CreateEquivalence(storage.EquivalenceRequest(req))
+	if err != nil {
+		return nil, translateCatalogError("create equivalence", err)
+	}
+
+	return marshalCreatedID(id)
+}
+
+func (s *Service) DeleteEquivalence(equivalenceID string) error {
+	if err := s.storage.DeleteEquivalence(equivalenceID); err != nil {
+		return translateCatalogError("delete equivalence", err)
+	}
+
+	return nil
+}
+
+func (s *Service) GetEquivalences(careerID string) ([]byte, error) {
+	type equivalence struct {
+		ID                    int    `json:"id"`
+		SubjectID             int    `json:"subject_id"`
+		SubjectName           string `json:"subject_name"`
+		EquivalentCareerID    int    `json:"equivalent_career_id"`
+		EquivalentSubjectID   int    `json:"equivalent_subject_id"`
+		EquivalentSubjectName string `json:"equivalent_subject_name"`
+	}
+
+	if _, err := s.storage.GetCareer(careerID); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, fmt.Errorf("could not get equivalences: %w", ErrNotFound)
+		}
+
+		return nil, fmt.Errorf("could not get equivalences: %v", err)
+	}
+
+	equivalences, err := s.storage.GetEquivalences(careerID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get equivalences: %v", err)
+	}
+
+	response := make([]equivalence, 0, len(equivalences))
+	for _, e := range equivalences {
+		response = append(response, equivalence{
+			ID:                    e.ID,
+			SubjectID:             e.SubjectID,
+			SubjectName:           e.SubjectName,
+			EquivalentCareerID:    e.EquivalentCareerID,
+			EquivalentSubjectID:   e.EquivalentSubjectID,
+			EquivalentSubjectName: e.EquivalentSubjectName,
+		})
+	}
+
+	b, err := json.Marshal(response)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal response: %v", err)
+	}
+
+	return b, nil
+}
+
+type studentEquivalence struct {
+	ID                int     `json:"id"`
+	StudentEmail      string  `json:"student_email"`
+	CareerID          int     `json:"career_id"`
+	SubjectID         int     `json:"subject_id"`
+	SubjectName       string  `json:"subject_name"`
+	SourceCareerID    int     `json:"source_career_id"`
+	SourceSubjectID   int     `json:"source_subject_id"`
+	SourceSubjectName string  `json:"source_subject_name"`
+	Status            string  `json:"status"`
+	Automatic         bool    `json:"automatic"`
+	CreatedAt         string  `json:"created_at"`
+	ResolvedAt        *string `json:"resolved_at"`
+}
+
+func newStudentEquivalences(equivalences []storage.StudentEquivalence) []studentEquivalence {
+	response := make([]studentEquivalence, 0, len(equivalences))
+	for _, e := range equivalences {
+		response = append(response, studentEquivalence(e))
+	}
+
+	return response
+}
+
+type StudentEquivalenceRequest struct {
+	StudentEmail    string
+	CareerID        string
+	SubjectID       string
+	SourceCareerID  string
+	SourceSubjectID string
+}
+
+// RequestEquivalence asks an admin to credit a subject of a career of the student with a subject it approved in
+// another career.
+func (s *Service) RequestEquivalence(req StudentEquivalenceRequest) ([]byte, error) {
+	if req.CareerID == req.SourceCareerID {
+		return nil, fmt.Errorf("could not request equivalence: subjects of the same career: %w", ErrInvalidEquivalence)
+	}
+
+	id, err := s.storage.CreateStudentEquivalence(storage.StudentEquivalenceRequest{
+		StudentEmail:    req.StudentEmail,
+		CareerID:        req.CareerID,
+		SubjectID:       req.SubjectID,
+		SourceCareerID:  req.SourceCareerID,
+		SourceSubjectID: req.SourceSubjectID,
+		CreatedAt:       s.now().UTC().Format(dateTimeLayout),
+	})
+
+	if err != nil {
+		return nil, translateCatalogError("request equivalence", err)
+	}
+
+	return marshalCreatedID(id)
+}
+
+func (s *Service) GetStudentEquivalences(studentEmail, careerID string) ([]byte, error) {
+	equivalences, err := s.storage.GetStudentEquivalences(studentEmail, careerID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get student equivalences [student_email: %s]: %v", studentEmail, err)
+	}
+
+	b, err := json.Marshal(newStudentEquivalences(equivalences))
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal response: %v", err)
+	}
+
+	return b, nil
+}
+
+// GetEquivalenceRequests returns the requests of the students with the status, the pending ones by default.
+func (s *Service) GetEquivalenceRequests(status string) ([]byte, error) {
+	if status == "" {
+		status = statusPendiente
+	}
+
+	if status != statusPendiente && status != statusAprobada && status != statusRechazada {
+		return nil, fmt.Errorf("could not get equivalence requests: unknown status %s: %w", status, ErrInvalidEquivalence)
+	}
+
+	requests, err := s.storage.GetEquivalenceRequests(status)
+	if err != nil {
+		return nil, fmt.Errorf("could not get equivalence requests: %v", err)
+	}
+
+	b, err := json.Marshal(newStudentEquivalences(requests))
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal response: %v", err)
+	}
+
+	return b, nil
+}
+
+// ResolveEquivalenceRequest approves or rejects a pending request. Approving it approves the subject of the student.
+func (s *Service) ResolveEquivalenceRequest(requestID, status string) error {
+	if status != statusAprobada && status != statusRechazada {
+		return fmt.Errorf("could not resolve equivalence request: unknown status %s: %w", status, ErrInvalidEquivalence)
+	}
+
+	err := s.storage.ResolveEquivalenceRequest(storage.ResolveEquivalenceRequest{
+		RequestID:  requestID,
+		Status:     status,
+		ResolvedAt: s.now().UTC().Format(dateTimeLayout),
+	})
+
+	if err != nil {
+		return translateCatalogError("resolve equivalence request", err)
+	}
+
+	return nil
+}
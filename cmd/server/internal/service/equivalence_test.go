@@ -0,0 +1,202 @@
+package service
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage/memory"
+)
+
+func TestService_AssignStudentToCareer_CreditEquivalentSubjects(t *testing.T) {
+	// Given
+	resolvedAt := "2021-04-01 12:00:00"
+	storage_ := storageMock{}
+	mockCareerPolicy(&storage_, defaultPolicy)
+	storage_.On("GetStudentCareerIDs", "example@gmail.com").Return([]int{1}, nil)
+	storage_.On("AssignStudentToCareerWithCredits", "example@gmail.com", "2", "2021-04-01 12:00:00").Return([]storage.StudentEquivalence{{
+		ID:                1,
+		StudentEmail:      "example@gmail.com",
+		CareerID:          2,
+		SubjectID:         5,
+		SubjectName:       "Análisis Matemático",
+		SourceCareerID:    1,
+		SourceSubjectID:   3,
+		SourceSubjectName: "Análisis I",
+		Status:            "APROBADA",
+		Automatic:         true,
+		CreatedAt:         "2021-04-01 12:00:00",
+		ResolvedAt:        &resolvedAt,
+	}}, nil)
+
+	s := NewService(&storage_)
+	s.now = func() time.Time { return time.Date(2021, 4, 1, 12, 0, 0, 0, time.UTC) }
+
+	// When
+	response, err := s.AssignStudentToCareer("example@gmail.com", "2")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.JSONEq(t, `{"career_id":2,"credited_subjects":[{"id":1,"student_email":"example@gmail.com","career_id":2,"subject_id":5,"subject_name":"Análisis Matemático","source_career_id":1,"source_subject_id":3,"source_subject_name":"Análisis I","status":"APROBADA","automatic":true,"created_at":"2021-04-01 12:00:00","resolved_at":"2021-04-01 12:00:00"}]}`, string(response))
+}
+
+func TestService_AssignStudentToCareer_CreditEquivalentSubjectsError(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	mockCareerPolicy(&storage_, defaultPolicy)
+	storage_.On("GetStudentCareerIDs", "example@gmail.com").Return([]int{1}, nil)
+	storage_.On("AssignStudentToCareerWithCredits", "example@gmail.com", "2", "2021-04-01 12:00:00").Return([]storage.StudentEquivalence(nil), errors.New("error"))
+
+	s := NewService(&storage_)
+	s.now = func() time.Time { return time.Date(2021, 4, 1, 12, 0, 0, 0, time.UTC) }
+
+	// When
+	_, err := s.AssignStudentToCareer("example@gmail.com", "2")
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "could not assign student [student_email: example@gmail.com] to career: error")
+	storage_.AssertNotCalled(t, "AssignStudentToCareer", "example@gmail.com", "2")
+}
+
+func TestService_CreateEquivalence_SameCareerError(t *testing.T) {
+	// Given
+	s := NewService(&storageMock{})
+
+	// When
+	_, err := s.CreateEquivalence(EquivalenceRequest{CareerID: "1", SubjectID: "2", EquivalentCareerID: "1", EquivalentSubjectID: "3"})
+
+	// Then
+	require.True(t, errors.Is(err, ErrInvalidEquivalence))
+}
+
+func TestService_CreateEquivalence_AlreadyExistError(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("CreateEquivalence", storage.EquivalenceRequest{CareerID: "1", SubjectID: "2", EquivalentCareerID: "3", EquivalentSubjectID: "4"}).
+		Return(0, storage.ErrResourceAlreadyExist)
+
+	s := NewService(&storage_)
+
+	// When
+	_, err := s.CreateEquivalence(EquivalenceRequest{CareerID: "1", SubjectID: "2", EquivalentCareerID: "3", EquivalentSubjectID: "4"})
+
+	// Then
+	require.True(t, errors.Is(err, ErrResourceAlreadyExist))
+}
+
+func TestService_GetEquivalences_CareerNotFoundError(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("GetCareer", "1").Return(storage.Career{}, storage.ErrNotFound)
+
+	s := NewService(&storage_)
+
+	// When
+	_, err := s.GetEquivalences("1")
+
+	// Then
+	require.True(t, errors.Is(err, ErrNotFound))
+}
+
+func TestService_GetEquivalenceRequests(t *testing.T) {
+	tt := []struct {
+		name   string
+		status string
+		err    error
+	}{
+		{name: "pending by default", status: ""},
+		{name: "rejected", status: "RECHAZADA"},
+		{name: "unknown status", status: "CURSANDO", err: ErrInvalidEquivalence},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// Given
+			storage_ := storageMock{}
+			storage_.On("GetEquivalenceRequests", "PENDIENTE").Return([]storage.StudentEquivalence{}, nil)
+			storage_.On("GetEquivalenceRequests", "RECHAZADA").Return([]storage.StudentEquivalence{}, nil)
+
+			s := NewService(&storage_)
+
+			// When
+			response, err := s.GetEquivalenceRequests(tc.status)
+
+			// Then
+			if tc.err != nil {
+				require.True(t, errors.Is(err, tc.err))
+				return
+			}
+
+			require.NoError(t, err)
+			require.JSONEq(t, `[]`, string(response))
+		})
+	}
+}
+
+func TestService_ResolveEquivalenceRequest_InvalidStatusError(t *testing.T) {
+	// Given
+	s := NewService(&storageMock{})
+
+	// When
+	err := s.ResolveEquivalenceRequest("1", "PENDIENTE")
+
+	// Then
+	require.True(t, errors.Is(err, ErrInvalidEquivalence))
+}
+
+func TestService_Equivalences_MemoryStorage(t *testing.T) {
+	// Given
+	storage_ := memory.NewStorage()
+	facultyID, _ := storage_.CreateFaculty(storage.FacultyRequest{Name: "Exactas"})
+	subjectType := "OBLIGATORIA"
+
+	var careers []string
+	for _, name := range []string{"Sistemas", "Computación"} {
+		careerID, _ := storage_.CreateCareer(storage.CareerRequest{FacultyID: facultyID, Name: name})
+		careers = append(careers, strconv.Itoa(careerID))
+	}
+
+	for _, subject := range []string{"Análisis I", "Análisis Matemático", "Física"} {
+		_, _ = storage_.CreateSubject(storage.SubjectRequest{Name: subject})
+	}
+
+	_, _ = storage_.CreateCareerSubject(storage.CareerSubjectRequest{CareerID: careers[0], SubjectID: "1", Type: &subjectType})
+	_, _ = storage_.CreateCareerSubject(storage.CareerSubjectRequest{CareerID: careers[0], SubjectID: "3", Type: &subjectType})
+	_, _ = storage_.CreateCareerSubject(storage.CareerSubjectRequest{CareerID: careers[1], SubjectID: "2", Type: &subjectType})
+	_, _ = storage_.CreateCareerSubject(storage.CareerSubjectRequest{CareerID: careers[1], SubjectID: "3", Type: &subjectType})
+
+	s := NewService(storage_)
+	s.now = func() time.Time { return time.Date(2021, 4, 1, 12, 0, 0, 0, time.UTC) }
+
+	_, err := s.CreateEquivalence(EquivalenceRequest{CareerID: careers[0], SubjectID: "1", EquivalentCareerID: careers[1], EquivalentSubjectID: "2"})
+	require.NoError(t, err)
+
+	require.NoError(t, s.CreateStudent("test", "test@gmail.com", "password"))
+	_, err = s.AssignStudentToCareer("test@gmail.com", careers[0])
+	require.NoError(t, err)
+	require.NoError(t, s.UpdateStudentSubject(UpdateStudentSubjectRequest{StudentEmail: "test@gmail.com", CareerID: careers[0], SubjectID: "1", Status: "APROBADA"}))
+
+	// When
+	response, err := s.AssignStudentToCareer("test@gmail.com", careers[1])
+	require.NoError(t, err)
+
+	_, requestErr := s.RequestEquivalence(StudentEquivalenceRequest{StudentEmail: "test@gmail.com", CareerID: careers[1], SubjectID: "3", SourceCareerID: careers[0], SourceSubjectID: "3"})
+
+	// Then
+	require.JSONEq(t, `{"career_id":2,"credited_subjects":[{"id":1,"student_email":"test@gmail.com","career_id":2,"subject_id":2,"subject_name":"Análisis Matemático","source_career_id":1,"source_subject_id":1,"source_subject_name":"Análisis I","status":"APROBADA","automatic":true,"created_at":"2021-04-01 12:00:00","resolved_at":"2021-04-01 12:00:00"}]}`, string(response))
+	require.True(t, errors.Is(requestErr, ErrNotFound))
+
+	subjects, err := storage_.GetStudentSubjects("test@gmail.com", careers[1])
+	require.NoError(t, err)
+	require.Equal(t, "APROBADA", subjects[0].Status)
+	require.Equal(t, "PENDIENTE", subjects[1].Status)
+}
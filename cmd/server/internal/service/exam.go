@@ -0,0 +1,172 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
+)
+
+const minPassingGrade = 4
+
+type CreateExamRequest struct {
+	StudentEmail string
+	CareerID     string
+	SubjectID    string
+	Grade        int
+	Date         string
+}
+
+func (s *Service) CreateExam(req CreateExamRequest) ([]byte, error) {
+	id, err := s.storage.CreateExam(storage.CreateExamRequest{
+		StudentEmail: req.StudentEmail,
+		CareerID:     req.CareerID,
+		SubjectID:    req.SubjectID,
+		Grade:        req.Grade,
+		Date:         req.Date,
+	})
+
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, fmt.Errorf("could not create exam: %w", ErrNotFound)
+		}
+
+		return nil, fmt.Errorf("could not create exam: %v", err)
+	}
+
+	b, err := json.Marshal(struct {
+		ID int `json:"id"`
+	}{ID: id})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal response: %v", err)
+	}
+
+	return b, nil
+}
+
+func (s *Service) GetStudentCareerSummary(studentEmail, careerID string) ([]byte, error) {
+	type subjectSummary struct {
+		ID       int    `json:"id"`
+		Name     string `json:"name"`
+		Status   string `json:"status"`
+		Grade    int    `json:"grade"`
+		ExamDate string `json:"exam_date"`
+		Attempts int    `json:"attempts"`
+	}
+
+	type summary struct {
+		Average             *float64         `json:"average"`
+		AverageWithoutFails *float64         `json:"average_without_fails"`
+		Exams               int              `json:"exams"`
+		FailedExams         int              `json:"failed_exams"`
+		ApprovedSubjects    int              `json:"approved_subjects"`
+		TotalSubjects       int              `json:"total_subjects"`
+		ApprovedPoints      int              `json:"approved_points"`
+		TotalPoints         int              `json:"total_points"`
+		ApprovedHours       int              `json:"approved_hours"`
+		TotalHours          int              `json:"total_hours"`
+		Progress            float64          `json:"progress"`
+		Subjects            []subjectSummary `json:"subjects"`
+	}
+
+	studentSubjects, err := s.storage.GetStudentSubjects(studentEmail, careerID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, fmt.Errorf("could not get student subjects: %w", ErrNotFound)
+		}
+
+		return nil, fmt.Errorf("could not get student subjects: %v", err)
+	}
+
+	exams, err := s.storage.GetStudentExams(studentEmail, careerID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get student exams: %v", err)
+	}
+
+	examsBySubject := make(map[int][]storage.Exam)
+	for _, exam := range exams {
+		examsBySubject[exam.SubjectID] = append(examsBySubject[exam.SubjectID], exam)
+	}
+
+	response := summary{
+		TotalSubjects: len(studentSubjects),
+		Subjects:      []subjectSummary{},
+	}
+
+	var gradesSum, passingGradesSum, passingExams int
+	for _, exam := range exams {
+		response.Exams++
+		gradesSum += exam.Grade
+
+		if exam.Grade < minPassingGrade {
+			response.FailedExams++
+			continue
+		}
+
+		passingExams++
+		passingGradesSum += exam.Grade
+	}
+
+	if response.Exams > 0 {
+		average := round(float64(gradesSum) / float64(response.Exams))
+		response.Average = &average
+	}
+
+	if passingExams > 0 {
+		average := round(float64(passingGradesSum) / float64(passingExams))
+		response.AverageWithoutFails = &average
+	}
+
+	for _, studentSubject := range studentSubjects {
+		var hours, points int
+		if studentSubject.Hours != nil {
+			hours = *studentSubject.Hours
+		}
+
+		if studentSubject.Points != nil {
+			points = *studentSubject.Points
+		}
+
+		response.TotalHours += hours
+		response.TotalPoints += points
+
+		if studentSubject.Status == statusAprobada {
+			response.ApprovedSubjects++
+			response.ApprovedHours += hours
+			response.ApprovedPoints += points
+		}
+
+		subjectExams, ok := examsBySubject[studentSubject.ID]
+		if !ok {
+			continue
+		}
+
+		lastExam := subjectExams[len(subjectExams)-1]
+		response.Subjects = append(response.Subjects, subjectSummary{
+			ID:       studentSubject.ID,
+			Name:     studentSubject.Name,
+			Status:   studentSubject.Status,
+			Grade:    lastExam.Grade,
+			ExamDate: lastExam.Date,
+			Attempts: len(subjectExams),
+		})
+	}
+
+	if response.TotalSubjects > 0 {
+		response.Progress = round(float64(response.ApprovedSubjects) * 100 / float64(response.TotalSubjects))
+	}
+
+	b, err := json.Marshal(response)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal response: %v", err)
+	}
+
+	return b, nil
+}
+
+func round(n float64) float64 {
+	return math.Round(n*100) / 100
+}
@@ -0,0 +1,169 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
+)
+
+func TestService_CreateExam(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("CreateExam", storage.CreateExamRequest{
+		StudentEmail: "example@gmail.com",
+		CareerID:     "1",
+		SubjectID:    "2",
+		Grade:        8,
+		Date:         "2021-07-20",
+	}).Return(3, nil)
+
+	s := NewService(&storage_)
+
+	// When
+	b, err := s.CreateExam(CreateExamRequest{
+		StudentEmail: "example@gmail.com",
+		CareerID:     "1",
+		SubjectID:    "2",
+		Grade:        8,
+		Date:         "2021-07-20",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Equal(t, []byte(`{"id":3}`), b)
+}
+
+func TestService_CreateExam_StorageNotFoundError(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("CreateExam", storage.CreateExamRequest{}).Return(0, storage.ErrNotFound)
+
+	s := NewService(&storage_)
+
+	// When
+	_, err := s.CreateExam(CreateExamRequest{})
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "could not create exam: service: resource not found")
+}
+
+func TestService_GetStudentCareerSummary(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("GetStudentSubjects", "example@gmail.com", "1").Return([]storage.StudentSubject{
+		{ID: 1, Name: "Análisis Matemático I", Status: "APROBADA", Hours: intToPtr(128), Points: intToPtr(8)},
+		{ID: 2, Name: "Física I", Status: "APROBADA", Hours: intToPtr(96), Points: intToPtr(6)},
+		{ID: 3, Name: "Química", Status: "REGULARIZADA", Hours: intToPtr(64), Points: intToPtr(4)},
+		{ID: 4, Name: "Inglés", Status: "PENDIENTE"},
+	}, nil)
+
+	storage_.On("GetStudentExams", "example@gmail.com", "1").Return([]storage.Exam{
+		{SubjectID: 1, Grade: 2, Date: "2021-02-10"},
+		{SubjectID: 1, Grade: 7, Date: "2021-03-02"},
+		{SubjectID: 2, Grade: 10, Date: "2021-07-20"},
+	}, nil)
+
+	s := NewService(&storage_)
+
+	// When
+	b, err := s.GetStudentCareerSummary("example@gmail.com", "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.JSONEq(t, `{
+		"average": 6.33,
+		"average_without_fails": 8.5,
+		"exams": 3,
+		"failed_exams": 1,
+		"approved_subjects": 2,
+		"total_subjects": 4,
+		"approved_points": 14,
+		"total_points": 18,
+		"approved_hours": 224,
+		"total_hours": 288,
+		"progress": 50,
+		"subjects": [
+			{"id": 1, "name": "Análisis Matemático I", "status": "APROBADA", "grade": 7, "exam_date": "2021-03-02", "attempts": 2},
+			{"id": 2, "name": "Física I", "status": "APROBADA", "grade": 10, "exam_date": "2021-07-20", "attempts": 1}
+		]
+	}`, string(b))
+}
+
+func TestService_GetStudentCareerSummary_WithoutExams(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("GetStudentSubjects", "example@gmail.com", "1").Return([]storage.StudentSubject{
+		{ID: 1, Name: "Análisis Matemático I", Status: "CURSANDO", Hours: intToPtr(128), Points: intToPtr(8)},
+	}, nil)
+
+	storage_.On("GetStudentExams", "example@gmail.com", "1").Return([]storage.Exam{}, nil)
+
+	s := NewService(&storage_)
+
+	// When
+	b, err := s.GetStudentCareerSummary("example@gmail.com", "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.JSONEq(t, `{
+		"average": null,
+		"average_without_fails": null,
+		"exams": 0,
+		"failed_exams": 0,
+		"approved_subjects": 0,
+		"total_subjects": 1,
+		"approved_points": 0,
+		"total_points": 8,
+		"approved_hours": 0,
+		"total_hours": 128,
+		"progress": 0,
+		"subjects": []
+	}`, string(b))
+}
+
+func TestService_GetStudentCareerSummary_StorageNotFoundError(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("GetStudentSubjects", "example@gmail.com", "1").Return([]storage.StudentSubject{}, storage.ErrNotFound)
+
+	s := NewService(&storage_)
+
+	// When
+	_, err := s.GetStudentCareerSummary("example@gmail.com", "1")
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "could not get student subjects: service: resource not found")
+}
+
+func TestService_GetStudentCareerSummary_GetStudentExamsError(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("GetStudentSubjects", "example@gmail.com", "1").Return([]storage.StudentSubject{}, nil)
+	storage_.On("GetStudentExams", "example@gmail.com", "1").Return([]storage.Exam{}, errors.New("error"))
+
+	s := NewService(&storage_)
+
+	// When
+	_, err := s.GetStudentCareerSummary("example@gmail.com", "1")
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "could not get student exams: error")
+}
@@ -0,0 +1,257 @@
+package service
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
+)
+
+const (
+	ExportFormatCSV  = "csv"
+	ExportFormatJSON = "json"
+	ExportFormatPDF  = "pdf"
+)
+
+var ErrInvalidExportFormat = errors.New("service: invalid export format")
+
+type Export struct {
+	ContentType string
+	Filename    string
+	Content     []byte
+}
+
+type recordSubject struct {
+	ID       int     `json:"id"`
+	Name     string  `json:"name"`
+	Type     string  `json:"type"`
+	Status   string  `json:"status"`
+	Term     *string `json:"term"`
+	Hours    *int    `json:"hours"`
+	Points   *int    `json:"points"`
+	Grade    *int    `json:"grade"`
+	ExamDate *string `json:"exam_date"`
+	Attempts int     `json:"attempts"`
+}
+
+type academicRecord struct {
+	StudentEmail     string          `json:"student_email"`
+	CareerID         int             `json:"career_id"`
+	CareerName       string          `json:"career_name"`
+	GeneratedAt      string          `json:"generated_at"`
+	ApprovedSubjects int             `json:"approved_subjects"`
+	TotalSubjects    int             `json:"total_subjects"`
+	Average          *float64        `json:"average"`
+	Subjects         []recordSubject `json:"subjects"`
+}
+
+// ExportStudentRecord renders the subjects of the student in the career, with the grade and date of their
+// last exam, as a csv, json or pdf file.
+func (s *Service) ExportStudentRecord(studentEmail, careerID, format string) (Export, error) {
+	var render func(academicRecord) ([]byte, error)
+	var contentType string
+	switch format {
+	case ExportFormatCSV:
+		render, contentType = renderRecordCSV, "text/csv; charset=utf-8"
+	case ExportFormatJSON:
+		render, contentType = renderRecordJSON, "application/json"
+	case ExportFormatPDF:
+		render, contentType = renderRecordPDF, "application/pdf"
+	default:
+		return Export{}, fmt.Errorf("%w [format: %s]: format must be csv, json or pdf", ErrInvalidExportFormat, format)
+	}
+
+	record, err := s.getAcademicRecord(studentEmail, careerID)
+	if err != nil {
+		return Export{}, err
+	}
+
+	content, err := render(record)
+	if err != nil {
+		return Export{}, fmt.Errorf("could not render %s record: %v", format, err)
+	}
+
+	return Export{
+		ContentType: contentType,
+		Filename:    fmt.Sprintf("record-%s-%s.%s", careerID, s.now().Format("20060102"), format),
+		Content:     content,
+	}, nil
+}
+
+func (s *Service) getAcademicRecord(studentEmail, careerID string) (academicRecord, error) {
+	career, err := s.storage.GetCareer(careerID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return academicRecord{}, fmt.Errorf("could not get career: %w", ErrNotFound)
+		}
+
+		return academicRecord{}, fmt.Errorf("could not get career: %v", err)
+	}
+
+	studentSubjects, err := s.storage.GetStudentSubjects(studentEmail, careerID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return academicRecord{}, fmt.Errorf("could not get student subjects: %w", ErrNotFound)
+		}
+
+		return academicRecord{}, fmt.Errorf("could not get student subjects: %v", err)
+	}
+
+	exams, err := s.storage.GetStudentExams(studentEmail, careerID)
+	if err != nil {
+		return academicRecord{}, fmt.Errorf("could not get student exams: %v", err)
+	}
+
+	examsBySubject := make(map[int][]storage.Exam)
+	var gradesSum int
+	for _, exam := range exams {
+		examsBySubject[exam.SubjectID] = append(examsBySubject[exam.SubjectID], exam)
+		gradesSum += exam.Grade
+	}
+
+	record := academicRecord{
+		StudentEmail:  studentEmail,
+		CareerID:      career.ID,
+		CareerName:    career.Name,
+		GeneratedAt:   s.now().Format("2006-01-02"),
+		TotalSubjects: len(studentSubjects),
+		Subjects:      make([]recordSubject, 0, len(studentSubjects)),
+	}
+
+	if len(exams) > 0 {
+		average := round(float64(gradesSum) / float64(len(exams)))
+		record.Average = &average
+	}
+
+	for _, studentSubject := range studentSubjects {
+		if studentSubject.Status == statusAprobada {
+			record.ApprovedSubjects++
+		}
+
+		subject := recordSubject{
+			ID:     studentSubject.ID,
+			Name:   studentSubject.Name,
+			Type:   studentSubject.Type,
+			Status: studentSubject.Status,
+			Term:   studentSubject.Term,
+			Hours:  studentSubject.Hours,
+			Points: studentSubject.Points,
+		}
+
+		if subjectExams := examsBySubject[studentSubject.ID]; len(subjectExams) > 0 {
+			lastExam := subjectExams[len(subjectExams)-1]
+			subject.Grade = &lastExam.Grade
+			subject.ExamDate = &lastExam.Date
+			subject.Attempts = len(subjectExams)
+		}
+
+		record.Subjects = append(record.Subjects, subject)
+	}
+
+	return record, nil
+}
+
+func renderRecordJSON(record academicRecord) ([]byte, error) {
+	return json.Marshal(record)
+}
+
+func renderRecordCSV(record academicRecord) ([]byte, error) {
+	var b bytes.Buffer
+	w := csv.NewWriter(&b)
+	if err := w.Write([]string{"subject_id", "name", "type", "status", "term", "hours", "points", "grade", "exam_date", "attempts"}); err != nil {
+		return nil, err
+	}
+
+	for _, subject := range record.Subjects {
+		if err := w.Write([]string{
+			strconv.Itoa(subject.ID),
+			subject.Name,
+			subject.Type,
+			subject.Status,
+			stringValue(subject.Term),
+			formatOptionalInt(subject.Hours),
+			formatOptionalInt(subject.Points),
+			formatOptionalInt(subject.Grade),
+			stringValue(subject.ExamDate),
+			strconv.Itoa(subject.Attempts),
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	w.Flush()
+	return b.Bytes(), w.Error()
+}
+
+func formatOptionalInt(v *int) string {
+	if v == nil {
+		return ""
+	}
+
+	return strconv.Itoa(*v)
+}
+
+// recordPDFColumns are the offsets of the subject table columns from the left margin.
+var recordPDFColumns = []struct {
+	title string
+	x     float64
+}{
+	{title: "Materia", x: 0},
+	{title: "Tipo", x: 190},
+	{title: "Estado", x: 270},
+	{title: "Cuatrimestre", x: 350},
+	{title: "Nota", x: 420},
+	{title: "Fecha", x: 455},
+}
+
+func renderRecordPDF(record academicRecord) ([]byte, error) {
+	const (
+		titleSize = 16
+		textSize  = 10
+	)
+
+	w := newPDFWriter()
+	w.line(pdfText{Size: titleSize, Bold: true, Text: "Historia académica"})
+	w.space(textSize)
+	w.line(pdfText{Size: textSize, Bold: true, Text: "Alumno:"}, pdfText{X: 80, Size: textSize, Text: record.StudentEmail})
+	w.line(pdfText{Size: textSize, Bold: true, Text: "Carrera:"}, pdfText{X: 80, Size: textSize, Text: record.CareerName})
+	w.line(pdfText{Size: textSize, Bold: true, Text: "Emitido:"}, pdfText{X: 80, Size: textSize, Text: record.GeneratedAt})
+
+	average := "-"
+	if record.Average != nil {
+		average = strconv.FormatFloat(*record.Average, 'f', 2, 64)
+	}
+
+	w.line(pdfText{Size: textSize, Bold: true, Text: "Aprobadas:"}, pdfText{X: 80, Size: textSize, Text: fmt.Sprintf("%d de %d", record.ApprovedSubjects, record.TotalSubjects)})
+	w.line(pdfText{Size: textSize, Bold: true, Text: "Promedio:"}, pdfText{X: 80, Size: textSize, Text: average})
+	w.space(textSize)
+
+	header := make([]pdfText, 0, len(recordPDFColumns))
+	for _, column := range recordPDFColumns {
+		header = append(header, pdfText{X: column.x, Size: textSize, Bold: true, Text: column.title})
+	}
+
+	w.line(header...)
+	for _, subject := range record.Subjects {
+		grade := formatOptionalInt(subject.Grade)
+		values := []string{subject.Name, subject.Type, subject.Status, stringValue(subject.Term), grade, stringValue(subject.ExamDate)}
+
+		row := make([]pdfText, 0, len(values))
+		for i, value := range values {
+			width := pdfPageWidth - 2*pdfMargin - recordPDFColumns[i].x
+			if i+1 < len(recordPDFColumns) {
+				width = recordPDFColumns[i+1].x - recordPDFColumns[i].x - 5
+			}
+
+			row = append(row, pdfText{X: recordPDFColumns[i].x, Size: textSize, Text: truncatePDFText(value, textSize, width)})
+		}
+
+		w.line(row...)
+	}
+
+	return w.bytes(), nil
+}
@@ -0,0 +1,148 @@
+package service
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
+)
+
+func newExportService() *Service {
+	hours, points := 96, 8
+	term := "2021-1"
+
+	storage_ := storageMock{}
+	storage_.On("GetCareer", "1").Return(storage.Career{ID: 1, FacultyID: 1, Name: "Licenciatura en Sistemas"}, nil)
+	storage_.On("GetStudentSubjects", "example@gmail.com", "1").Return([]storage.StudentSubject{
+		{ID: 1, Name: "Álgebra", Type: "Obligatoria", Status: "APROBADA", Hours: &hours, Points: &points, Term: &term},
+		{ID: 2, Name: "Análisis I", Type: "Obligatoria", Status: "PENDIENTE"},
+	}, nil)
+	storage_.On("GetStudentExams", "example@gmail.com", "1").Return([]storage.Exam{
+		{SubjectID: 1, Grade: 2, Date: "2021-07-10"},
+		{SubjectID: 1, Grade: 8, Date: "2021-08-02"},
+	}, nil)
+
+	s := NewService(&storage_)
+	s.now = func() time.Time {
+		return time.Date(2021, time.September, 1, 10, 0, 0, 0, time.UTC)
+	}
+
+	return s
+}
+
+func TestService_ExportStudentRecord_JSON(t *testing.T) {
+	// Given
+	s := newExportService()
+
+	// When
+	export, err := s.ExportStudentRecord("example@gmail.com", "1", "json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Equal(t, "application/json", export.ContentType)
+	require.Equal(t, "record-1-20210901.json", export.Filename)
+	require.JSONEq(t, `{
+		"student_email": "example@gmail.com",
+		"career_id": 1,
+		"career_name": "Licenciatura en Sistemas",
+		"generated_at": "2021-09-01",
+		"approved_subjects": 1,
+		"total_subjects": 2,
+		"average": 5,
+		"subjects": [
+			{"id": 1, "name": "Álgebra", "type": "Obligatoria", "status": "APROBADA", "term": "2021-1", "hours": 96, "points": 8, "grade": 8, "exam_date": "2021-08-02", "attempts": 2},
+			{"id": 2, "name": "Análisis I", "type": "Obligatoria", "status": "PENDIENTE", "term": null, "hours": null, "points": null, "grade": null, "exam_date": null, "attempts": 0}
+		]
+	}`, string(export.Content))
+}
+
+func TestService_ExportStudentRecord_CSV(t *testing.T) {
+	// Given
+	s := newExportService()
+
+	// When
+	export, err := s.ExportStudentRecord("example@gmail.com", "1", "csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Equal(t, "text/csv; charset=utf-8", export.ContentType)
+	require.Equal(t, "subject_id,name,type,status,term,hours,points,grade,exam_date,attempts\n"+
+		"1,Álgebra,Obligatoria,APROBADA,2021-1,96,8,8,2021-08-02,2\n"+
+		"2,Análisis I,Obligatoria,PENDIENTE,,,,,,0\n", string(export.Content))
+}
+
+func TestService_ExportStudentRecord_PDF(t *testing.T) {
+	// Given
+	s := newExportService()
+
+	// When
+	export, err := s.ExportStudentRecord("example@gmail.com", "1", "pdf")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Equal(t, "application/pdf", export.ContentType)
+	require.True(t, bytes.HasPrefix(export.Content, []byte("%PDF-1.4\n")))
+	require.True(t, bytes.HasSuffix(export.Content, []byte("%%EOF\n")))
+	require.Contains(t, string(export.Content), `(Historia acad\351mica) Tj`)
+	require.Contains(t, string(export.Content), `(\301lgebra) Tj`)
+	require.Contains(t, string(export.Content), "/Count 1")
+}
+
+func TestService_ExportStudentRecord_InvalidFormatError(t *testing.T) {
+	// Given
+	s := NewService(&storageMock{})
+
+	// When
+	_, err := s.ExportStudentRecord("example@gmail.com", "1", "xml")
+
+	// Then
+	require.EqualError(t, err, "service: invalid export format [format: xml]: format must be csv, json or pdf")
+	require.True(t, errors.Is(err, ErrInvalidExportFormat))
+}
+
+func TestService_ExportStudentRecord_CareerNotFoundError(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("GetCareer", "1").Return(storage.Career{}, storage.ErrNotFound)
+
+	s := NewService(&storage_)
+
+	// When
+	_, err := s.ExportStudentRecord("example@gmail.com", "1", "pdf")
+
+	// Then
+	require.EqualError(t, err, "could not get career: service: resource not found")
+	require.True(t, errors.Is(err, ErrNotFound))
+}
+
+func TestPDFWriter(t *testing.T) {
+	// Given
+	w := newPDFWriter()
+
+	// When
+	for i := 0; i < 80; i++ {
+		w.line(pdfText{Size: 10, Text: "Materia (optativa) \\ año"})
+	}
+
+	b := w.bytes()
+
+	// Then
+	require.Contains(t, string(b), "/Count 2")
+	require.Contains(t, string(b), `(Materia \(optativa\) \\ a\361o) Tj`)
+	require.Contains(t, string(b), "xref\n0 9\n0000000000 65535 f \n0000000009 00000 n \n")
+}
+
+func TestTruncatePDFText(t *testing.T) {
+	require.Equal(t, "Álgebra", truncatePDFText("Álgebra", 10, 100))
+	require.Equal(t, "Introducción a...", truncatePDFText("Introducción a la Programación", 10, 85))
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
+)
+
+func (s *Service) GetMaterials(professorshipID string) ([]byte, error) {
+	type material struct {
+		ID          int    `json:"id"`
+		URI         string `json:"uri"`
+		Description string `json:"description"`
+	}
+
+	materials, err := s.storage.GetMaterials(professorshipID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, fmt.Errorf("could not get materials: %w", ErrNotFound)
+		}
+
+		return nil, fmt.Errorf("could not get materials: %v", err)
+	}
+
+	response := make([]material, 0, len(materials))
+	for _, m := range materials {
+		response = append(response, material{
+			ID:          m.ID,
+			URI:         m.URI,
+			Description: m.Description,
+		})
+	}
+
+	b, err := json.Marshal(response)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal response: %v", err)
+	}
+
+	return b, nil
+}
+
+type CreateMaterialRequest struct {
+	ProfessorshipID string
+	URI             string
+	Description     string
+}
+
+func (s *Service) CreateMaterial(req CreateMaterialRequest) ([]byte, error) {
+	id, err := s.storage.CreateMaterial(storage.CreateMaterialRequest{
+		ProfessorshipID: req.ProfessorshipID,
+		URI:             req.URI,
+		Description:     req.Description,
+	})
+
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, fmt.Errorf("could not create material: %w", ErrNotFound)
+		}
+
+		return nil, fmt.Errorf("could not create material: %v", err)
+	}
+
+	b, err := json.Marshal(struct {
+		ID int `json:"id"`
+	}{ID: id})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal response: %v", err)
+	}
+
+	return b, nil
+}
+
+type UpdateMaterialRequest struct {
+	ProfessorshipID string
+	MaterialID      string
+	URI             string
+	Description     string
+}
+
+func (s *Service) UpdateMaterial(req UpdateMaterialRequest) error {
+	if err := s.storage.UpdateMaterial(storage.UpdateMaterialRequest{
+		ProfessorshipID: req.ProfessorshipID,
+		MaterialID:      req.MaterialID,
+		URI:             req.URI,
+		Description:     req.Description,
+	}); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return fmt.Errorf("could not update material: %w", ErrNotFound)
+		}
+
+		return fmt.Errorf("could not update material: %v", err)
+	}
+
+	return nil
+}
+
+func (s *Service) DeleteMaterial(professorshipID, materialID string) error {
+	if err := s.storage.DeleteMaterial(professorshipID, materialID); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return fmt.Errorf("could not delete material: %w", ErrNotFound)
+		}
+
+		return fmt.Errorf("could not delete material: %v", err)
+	}
+
+	return nil
+}
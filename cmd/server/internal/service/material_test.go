@@ -0,0 +1,185 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
+)
+
+func TestService_GetMaterials(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("GetMaterials", "1").Return([]storage.Material{
+		{
+			ID:          7,
+			URI:         "https://drive.google.com/slides",
+			Description: "Diapositivas",
+		},
+	}, nil)
+
+	s := NewService(&storage_)
+
+	// When
+	materials, err := s.GetMaterials("1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Equal(t, []byte(`[{"id":7,"uri":"https://drive.google.com/slides","description":"Diapositivas"}]`), materials)
+}
+
+func TestService_GetMaterials_StorageNotFoundError(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("GetMaterials", "1").Return([]storage.Material{}, storage.ErrNotFound)
+
+	s := NewService(&storage_)
+
+	// When
+	_, err := s.GetMaterials("1")
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "could not get materials: service: resource not found")
+}
+
+func TestService_CreateMaterial(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("CreateMaterial", storage.CreateMaterialRequest{
+		ProfessorshipID: "1",
+		URI:             "https://drive.google.com/slides",
+		Description:     "Diapositivas",
+	}).Return(7, nil)
+
+	s := NewService(&storage_)
+
+	// When
+	material, err := s.CreateMaterial(CreateMaterialRequest{
+		ProfessorshipID: "1",
+		URI:             "https://drive.google.com/slides",
+		Description:     "Diapositivas",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Equal(t, []byte(`{"id":7}`), material)
+}
+
+func TestService_CreateMaterial_StorageError(t *testing.T) {
+	tt := []struct {
+		name          string
+		err           error
+		expectedError string
+	}{
+		{
+			name:          "generic error",
+			err:           errors.New("error"),
+			expectedError: "could not create material: error",
+		},
+		{
+			name:          "professorship not found",
+			err:           storage.ErrNotFound,
+			expectedError: "could not create material: service: resource not found",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// Given
+			storage_ := storageMock{}
+			storage_.On("CreateMaterial", storage.CreateMaterialRequest{ProfessorshipID: "1"}).Return(0, tc.err)
+
+			s := NewService(&storage_)
+
+			// When
+			_, err := s.CreateMaterial(CreateMaterialRequest{ProfessorshipID: "1"})
+			if err == nil {
+				t.Fatal("test must fail")
+			}
+
+			// Then
+			require.EqualError(t, err, tc.expectedError)
+		})
+	}
+}
+
+func TestService_UpdateMaterial(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("UpdateMaterial", storage.UpdateMaterialRequest{
+		ProfessorshipID: "1",
+		MaterialID:      "7",
+		URI:             "https://drive.google.com/slides",
+		Description:     "Diapositivas",
+	}).Return(nil)
+
+	s := NewService(&storage_)
+
+	// When
+	err := s.UpdateMaterial(UpdateMaterialRequest{
+		ProfessorshipID: "1",
+		MaterialID:      "7",
+		URI:             "https://drive.google.com/slides",
+		Description:     "Diapositivas",
+	})
+
+	// Then
+	require.NoError(t, err)
+}
+
+func TestService_UpdateMaterial_StorageNotFoundError(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("UpdateMaterial", storage.UpdateMaterialRequest{ProfessorshipID: "1", MaterialID: "7"}).Return(storage.ErrNotFound)
+
+	s := NewService(&storage_)
+
+	// When
+	err := s.UpdateMaterial(UpdateMaterialRequest{ProfessorshipID: "1", MaterialID: "7"})
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "could not update material: service: resource not found")
+}
+
+func TestService_DeleteMaterial(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("DeleteMaterial", "1", "7").Return(nil)
+
+	s := NewService(&storage_)
+
+	// When
+	err := s.DeleteMaterial("1", "7")
+
+	// Then
+	require.NoError(t, err)
+}
+
+func TestService_DeleteMaterial_StorageError(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("DeleteMaterial", "1", "7").Return(errors.New("error"))
+
+	s := NewService(&storage_)
+
+	// When
+	err := s.DeleteMaterial("1", "7")
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "could not delete material: error")
+}
@@ -0,0 +1,141 @@
+package service
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+const (
+	pdfPageWidth    = 595.28
+	pdfPageHeight   = 841.89
+	pdfMargin       = 50
+	pdfLineHeight   = 1.4
+	pdfAverageGlyph = 0.5
+)
+
+type pdfText struct {
+	X    float64
+	Size float64
+	Bold bool
+	Text string
+}
+
+// pdfWriter lays out lines of text in A4 pages using the standard Helvetica fonts, so documents need no
+// embedded fonts nor external tools. Text is encoded as WinAnsi, which covers the Spanish alphabet.
+type pdfWriter struct {
+	pages [][]string
+	y     float64
+}
+
+func newPDFWriter() *pdfWriter {
+	w := &pdfWriter{}
+	w.addPage()
+	return w
+}
+
+func (w *pdfWriter) addPage() {
+	w.pages = append(w.pages, nil)
+	w.y = pdfPageHeight - pdfMargin
+}
+
+// line writes a row of texts placed at their X offsets from the left margin, starting a new page when
+// the row does not fit in the current one.
+func (w *pdfWriter) line(texts ...pdfText) {
+	var size float64
+	for _, t := range texts {
+		if t.Size > size {
+			size = t.Size
+		}
+	}
+
+	if w.y-size*pdfLineHeight < pdfMargin {
+		w.addPage()
+	}
+
+	w.y -= size * pdfLineHeight
+	page := len(w.pages) - 1
+	for _, t := range texts {
+		font := "F1"
+		if t.Bold {
+			font = "F2"
+		}
+
+		w.pages[page] = append(w.pages[page], fmt.Sprintf("BT /%s %.2f Tf %.2f %.2f Td (%s) Tj ET", font, t.Size, pdfMargin+t.X, w.y, encodePDFText(t.Text)))
+	}
+}
+
+func (w *pdfWriter) space(size float64) {
+	w.y -= size
+}
+
+// truncatePDFText shortens text that would be wider than width points, approximating the width of each glyph.
+func truncatePDFText(text string, size, width float64) string {
+	runes := []rune(text)
+	max := int(width / (size * pdfAverageGlyph))
+	if len(runes) <= max || max < 4 {
+		return text
+	}
+
+	return string(runes[:max-3]) + "..."
+}
+
+func encodePDFText(text string) string {
+	var b strings.Builder
+	for _, r := range text {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r >= 0x20 && r < 0x7f:
+			b.WriteRune(r)
+		case r >= 0xa0 && r <= 0xff:
+			fmt.Fprintf(&b, "\\%03o", r)
+		default:
+			b.WriteByte('?')
+		}
+	}
+
+	return b.String()
+}
+
+// bytes renders the document: a catalog, the page tree, both fonts and a page with its content stream per page.
+func (w *pdfWriter) bytes() []byte {
+	var objects []string
+	objects = append(objects, "<< /Type /Catalog /Pages 2 0 R >>")
+
+	kids := make([]string, 0, len(w.pages))
+	for i := range w.pages {
+		kids = append(kids, fmt.Sprintf("%d 0 R", 5+i*2))
+	}
+
+	objects = append(objects, fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(w.pages)))
+	objects = append(objects, "<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	objects = append(objects, "<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+
+	for i, page := range w.pages {
+		content := strings.Join(page, "\n")
+		objects = append(objects,
+			fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.2f %.2f] /Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>", pdfPageWidth, pdfPageHeight, 6+i*2),
+			fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", len(content), content),
+		)
+	}
+
+	var b bytes.Buffer
+	b.WriteString("%PDF-1.4\n")
+
+	offsets := make([]int, 0, len(objects))
+	for i, object := range objects {
+		offsets = append(offsets, b.Len())
+		fmt.Fprintf(&b, "%d 0 obj\n%s\nendobj\n", i+1, object)
+	}
+
+	xref := b.Len()
+	fmt.Fprintf(&b, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&b, "%010d 00000 n \n", offset)
+	}
+
+	fmt.Fprintf(&b, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+	return b.Bytes()
+}
@@ -0,0 +1,731 @@
+package service
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
+)
+
+const (
+	PlanFormatCSV  = "csv"
+	PlanFormatYAML = "yaml"
+)
+
+var ErrInvalidCareerPlan = errors.New("service: invalid career plan")
+
+type CareerPlan struct {
+	Subjects []PlanSubject `yaml:"subjects"`
+}
+
+type PlanSubject struct {
+	Name           string              `yaml:"name"`
+	Type           string              `yaml:"type"`
+	Hours          *int                `yaml:"hours"`
+	Points         *int                `yaml:"points"`
+	Correlatives   []PlanCorrelative   `yaml:"correlatives"`
+	Professorships []PlanProfessorship `yaml:"professorships"`
+}
+
+type PlanCorrelative struct {
+	Subject     string `yaml:"subject"`
+	Requirement string `yaml:"requirement"`
+}
+
+type PlanProfessorship struct {
+	Name      string         `yaml:"name"`
+	Term      string         `yaml:"term"`
+	Schedules []PlanSchedule `yaml:"schedules"`
+}
+
+type PlanSchedule struct {
+	Day   string `yaml:"day"`
+	Start string `yaml:"start"`
+	End   string `yaml:"end"`
+}
+
+// ParseCareerPlan reads a plan in YAML, or in CSV with one row per schedule and the columns subject, type, hours,
+// points, correlatives, professorship, term, day, start and end. CSV correlatives are separated by semicolons
+// and may set their requirement after a colon, e.g. "Álgebra:REGULARIZADA;Física I".
+func ParseCareerPlan(format string, r io.Reader) (CareerPlan, error) {
+	switch strings.ToLower(format) {
+	case PlanFormatYAML, "yml":
+		return parseYAMLCareerPlan(r)
+	case PlanFormatCSV:
+		return parseCSVCareerPlan(r)
+	default:
+		return CareerPlan{}, fmt.Errorf("%w [format: %s]: format must be csv or yaml", ErrInvalidCareerPlan, format)
+	}
+}
+
+func parseYAMLCareerPlan(r io.Reader) (CareerPlan, error) {
+	decoder := yaml.NewDecoder(r)
+	decoder.KnownFields(true)
+
+	var plan CareerPlan
+	if err := decoder.Decode(&plan); err != nil {
+		return CareerPlan{}, fmt.Errorf("%w: %v", ErrInvalidCareerPlan, err)
+	}
+
+	return plan, nil
+}
+
+var csvPlanColumns = []string{"subject", "type", "hours", "points", "correlatives", "professorship", "term", "day", "start", "end"}
+
+func parseCSVCareerPlan(r io.Reader) (CareerPlan, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return CareerPlan{}, fmt.Errorf("%w: could not read header: %v", ErrInvalidCareerPlan, err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, column := range header {
+		columns[strings.ToLower(strings.TrimSpace(column))] = i
+	}
+
+	if _, exist := columns["subject"]; !exist {
+		return CareerPlan{}, fmt.Errorf("%w: subject column is required", ErrInvalidCareerPlan)
+	}
+
+	for column := range columns {
+		if !containsString(csvPlanColumns, column) {
+			return CareerPlan{}, fmt.Errorf("%w: unknown column %s", ErrInvalidCareerPlan, column)
+		}
+	}
+
+	var plan CareerPlan
+	subjects := map[string]int{}
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return CareerPlan{}, fmt.Errorf("%w: %v", ErrInvalidCareerPlan, err)
+		}
+
+		value := func(column string) string {
+			i, exist := columns[column]
+			if !exist || i >= len(record) {
+				return ""
+			}
+
+			return strings.TrimSpace(record[i])
+		}
+
+		name := value("subject")
+		if name == "" {
+			return CareerPlan{}, fmt.Errorf("%w: line %d: subject is required", ErrInvalidCareerPlan, line)
+		}
+
+		i, exist := subjects[name]
+		if !exist {
+			i = len(plan.Subjects)
+			subjects[name] = i
+			plan.Subjects = append(plan.Subjects, PlanSubject{Name: name})
+		}
+
+		if err := mergeCSVSubjectRow(&plan.Subjects[i], value); err != nil {
+			return CareerPlan{}, fmt.Errorf("%w: line %d: %v", ErrInvalidCareerPlan, line, err)
+		}
+	}
+
+	return plan, nil
+}
+
+func mergeCSVSubjectRow(subject *PlanSubject, value func(column string) string) error {
+	if t := value("type"); t != "" {
+		if subject.Type != "" && subject.Type != t {
+			return fmt.Errorf("conflicting type for subject %s", subject.Name)
+		}
+
+		subject.Type = t
+	}
+
+	for _, field := range []struct {
+		column string
+		target **int
+	}{
+		{column: "hours", target: &subject.Hours},
+		{column: "points", target: &subject.Points},
+	} {
+		raw := value(field.column)
+		if raw == "" {
+			continue
+		}
+
+		n, err := strconv.Atoi(raw)
+		if err != nil {
+			return fmt.Errorf("%s must be a number", field.column)
+		}
+
+		if *field.target != nil && **field.target != n {
+			return fmt.Errorf("conflicting %s for subject %s", field.column, subject.Name)
+		}
+
+		*field.target = &n
+	}
+
+	for _, raw := range strings.Split(value("correlatives"), ";") {
+		parts := strings.SplitN(raw, ":", 2)
+		correlative := PlanCorrelative{Subject: strings.TrimSpace(parts[0])}
+		if correlative.Subject == "" {
+			continue
+		}
+
+		if len(parts) == 2 {
+			correlative.Requirement = strings.TrimSpace(parts[1])
+		}
+
+		if !containsCorrelative(subject.Correlatives, correlative) {
+			subject.Correlatives = append(subject.Correlatives, correlative)
+		}
+	}
+
+	professorshipName, term := value("professorship"), value("term")
+	schedule := PlanSchedule{Day: value("day"), Start: value("start"), End: value("end")}
+	if professorshipName == "" {
+		if schedule != (PlanSchedule{}) || term != "" {
+			return errors.New("professorship is required for terms and schedules")
+		}
+
+		return nil
+	}
+
+	i := -1
+	for j, p := range subject.Professorships {
+		if p.Name == professorshipName && p.Term == term {
+			i = j
+			break
+		}
+	}
+
+	if i < 0 {
+		i = len(subject.Professorships)
+		subject.Professorships = append(subject.Professorships, PlanProfessorship{Name: professorshipName, Term: term})
+	}
+
+	if schedule != (PlanSchedule{}) {
+		subject.Professorships[i].Schedules = append(subject.Professorships[i].Schedules, schedule)
+	}
+
+	return nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func containsCorrelative(correlatives []PlanCorrelative, correlative PlanCorrelative) bool {
+	for _, c := range correlatives {
+		if c == correlative {
+			return true
+		}
+	}
+
+	return false
+}
+
+// currentCareerPlan indexes the stored plan of a career by subject name, the key used by imports.
+type currentCareerPlan struct {
+	subjects       map[string]storage.CareerPlanSubject
+	correlatives   map[string]map[string]string
+	professorships map[string]map[string]int
+	schedules      map[int]map[string]bool
+}
+
+func newCurrentCareerPlan(plan storage.CareerPlan) currentCareerPlan {
+	current := currentCareerPlan{
+		subjects:       make(map[string]storage.CareerPlanSubject, len(plan.Subjects)),
+		correlatives:   map[string]map[string]string{},
+		professorships: map[string]map[string]int{},
+		schedules:      map[int]map[string]bool{},
+	}
+
+	names := make(map[int]string, len(plan.Subjects))
+	for _, subject := range plan.Subjects {
+		current.subjects[subject.Name] = subject
+		names[subject.SubjectID] = subject.Name
+	}
+
+	for _, c := range plan.Correlatives {
+		name := names[c.SubjectID]
+		if current.correlatives[name] == nil {
+			current.correlatives[name] = map[string]string{}
+		}
+
+		current.correlatives[name][names[c.CorrelativeID]] = normalizeRequirement(c.Requirement)
+	}
+
+	for _, p := range plan.Professorships {
+		name := names[p.SubjectID]
+		if current.professorships[name] == nil {
+			current.professorships[name] = map[string]int{}
+		}
+
+		current.professorships[name][professorshipKey(p.Name, stringValue(p.Term))] = p.ID
+	}
+
+	for _, schedule := range plan.Schedules {
+		if current.schedules[schedule.ProfessorshipID] == nil {
+			current.schedules[schedule.ProfessorshipID] = map[string]bool{}
+		}
+
+		day, _ := convertDayNumberToDay(schedule.Day)
+		current.schedules[schedule.ProfessorshipID][scheduleKey(day, schedule.Start, schedule.End)] = true
+	}
+
+	return current
+}
+
+func professorshipKey(name, term string) string {
+	if term == "" {
+		return name
+	}
+
+	return fmt.Sprintf("%s (%s)", name, term)
+}
+
+func scheduleKey(day, start, end string) string {
+	if s, err := parseClock(start); err == nil {
+		start = formatClock(s)
+	}
+
+	if e, err := parseClock(end); err == nil {
+		end = formatClock(e)
+	}
+
+	return fmt.Sprintf("%s %s-%s", day, start, end)
+}
+
+func stringValue(v *string) string {
+	if v == nil {
+		return ""
+	}
+
+	return *v
+}
+
+func validateCareerPlan(plan CareerPlan, current currentCareerPlan) error {
+	var problems []string
+	addProblem := func(format string, args ...interface{}) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+
+	if len(plan.Subjects) == 0 {
+		addProblem("plan has no subjects")
+	}
+
+	names := map[string]bool{}
+	for _, subject := range plan.Subjects {
+		if subject.Name == "" {
+			addProblem("subject name is required")
+			continue
+		}
+
+		if names[subject.Name] {
+			addProblem("subject %s is duplicated", subject.Name)
+		}
+
+		names[subject.Name] = true
+	}
+
+	for _, subject := range plan.Subjects {
+		if subject.Hours != nil && *subject.Hours < 0 {
+			addProblem("subject %s: hours must be positive", subject.Name)
+		}
+
+		if subject.Points != nil && *subject.Points < 0 {
+			addProblem("subject %s: points must be positive", subject.Name)
+		}
+
+		correlatives := map[string]bool{}
+		for _, c := range subject.Correlatives {
+			_, exist := current.subjects[c.Subject]
+			switch {
+			case c.Subject == subject.Name:
+				addProblem("subject %s: can't be its own correlative", subject.Name)
+			case !names[c.Subject] && !exist:
+				addProblem("subject %s: unknown correlative %s", subject.Name, c.Subject)
+			case correlatives[c.Subject]:
+				addProblem("subject %s: correlative %s is duplicated", subject.Name, c.Subject)
+			}
+
+			correlatives[c.Subject] = true
+			if requirement := strings.ToUpper(c.Requirement); requirement != "" && requirement != requirementAprobada && requirement != requirementRegularizada {
+				addProblem("subject %s: correlative %s requirement must be APROBADA or REGULARIZADA", subject.Name, c.Subject)
+			}
+		}
+
+		professorships := map[string]bool{}
+		for _, p := range subject.Professorships {
+			if p.Name == "" {
+				addProblem("subject %s: professorship name is required", subject.Name)
+				continue
+			}
+
+			key := professorshipKey(p.Name, p.Term)
+			if professorships[key] {
+				addProblem("subject %s: professorship %s is duplicated", subject.Name, key)
+			}
+
+			professorships[key] = true
+			if p.Term != "" {
+				if _, _, err := parseTerm(p.Term); err != nil {
+					addProblem("subject %s: professorship %s: %v", subject.Name, key, err)
+				}
+			}
+
+			for _, schedule := range p.Schedules {
+				if _, err := (ScheduleRequest{Day: schedule.Day, Start: schedule.Start, End: schedule.End}).toStorage(); err != nil {
+					addProblem("subject %s: professorship %s: %v", subject.Name, key, err)
+				}
+			}
+		}
+	}
+
+	if len(problems) == 0 {
+		if err := checkCareerPlanCycles(plan, current); err != nil {
+			addProblem("%v", err)
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("%w: %s", ErrInvalidCareerPlan, strings.Join(problems, "; "))
+	}
+
+	return nil
+}
+
+// checkCareerPlanCycles checks the correlatives the career would have after the import: the ones of the plan and
+// the stored ones of the subjects missing from it.
+func checkCareerPlanCycles(plan CareerPlan, current currentCareerPlan) error {
+	ids := map[string]int{}
+	id := func(name string) int {
+		if _, exist := ids[name]; !exist {
+			ids[name] = len(ids) + 1
+		}
+
+		return ids[name]
+	}
+
+	var correlatives []storage.Correlative
+	imported := map[string]bool{}
+	for _, subject := range plan.Subjects {
+		imported[subject.Name] = true
+		for _, c := range subject.Correlatives {
+			correlatives = append(correlatives, storage.Correlative{SubjectID: id(subject.Name), CorrelativeID: id(c.Subject), Requirement: c.Requirement})
+		}
+	}
+
+	for name, subjectCorrelatives := range current.correlatives {
+		if imported[name] {
+			continue
+		}
+
+		for correlative, requirement := range subjectCorrelatives {
+			correlatives = append(correlatives, storage.Correlative{SubjectID: id(name), CorrelativeID: id(correlative), Requirement: requirement})
+		}
+	}
+
+	subjectIDs := make([]int, 0, len(ids))
+	for _, id := range ids {
+		subjectIDs = append(subjectIDs, id)
+	}
+
+	if _, err := newCorrelativeGraph(subjectIDs, correlatives); err != nil {
+		var cycleErr *CorrelativesCycleError
+		if errors.As(err, &cycleErr) {
+			for name, id := range ids {
+				if id == cycleErr.SubjectID {
+					return fmt.Errorf("correlatives have a cycle [subject: %s]", name)
+				}
+			}
+		}
+
+		return errors.New("correlatives have a cycle")
+	}
+
+	return nil
+}
+
+type planChange struct {
+	Action   string `json:"action"`
+	Resource string `json:"resource"`
+	Subject  string `json:"subject"`
+	Name     string `json:"name,omitempty"`
+	Detail   string `json:"detail,omitempty"`
+}
+
+func diffCareerPlan(plan CareerPlan, current currentCareerPlan) []planChange {
+	var changes []planChange
+	for _, subject := range plan.Subjects {
+		stored, exist := current.subjects[subject.Name]
+		if !exist {
+			changes = append(changes, planChange{Action: "create", Resource: "subject", Subject: subject.Name, Detail: describeSubject(subject)})
+		} else if detail := describeSubjectUpdate(stored, subject); detail != "" {
+			changes = append(changes, planChange{Action: "update", Resource: "subject", Subject: subject.Name, Detail: detail})
+		}
+
+		storedCorrelatives := current.correlatives[subject.Name]
+		planned := map[string]bool{}
+		for _, c := range subject.Correlatives {
+			requirement := normalizeRequirement(c.Requirement)
+			planned[c.Subject] = true
+
+			storedRequirement, exist := storedCorrelatives[c.Subject]
+			switch {
+			case !exist:
+				changes = append(changes, planChange{Action: "create", Resource: "correlative", Subject: subject.Name, Name: c.Subject, Detail: requirement})
+			case storedRequirement != requirement:
+				changes = append(changes, planChange{Action: "update", Resource: "correlative", Subject: subject.Name, Name: c.Subject, Detail: storedRequirement + " -> " + requirement})
+			}
+		}
+
+		for _, name := range sortedKeys(storedCorrelatives) {
+			if !planned[name] {
+				changes = append(changes, planChange{Action: "delete", Resource: "correlative", Subject: subject.Name, Name: name, Detail: storedCorrelatives[name]})
+			}
+		}
+
+		for _, p := range subject.Professorships {
+			key := professorshipKey(p.Name, p.Term)
+			professorshipID, exist := current.professorships[subject.Name][key]
+			if !exist {
+				changes = append(changes, planChange{Action: "create", Resource: "professorship", Subject: subject.Name, Name: key})
+			}
+
+			storedSchedules := current.schedules[professorshipID]
+			planned := map[string]bool{}
+			for _, schedule := range p.Schedules {
+				k := scheduleKey(schedule.Day, schedule.Start, schedule.End)
+				planned[k] = true
+				if !storedSchedules[k] {
+					changes = append(changes, planChange{Action: "create", Resource: "schedule", Subject: subject.Name, Name: key, Detail: k})
+				}
+			}
+
+			for _, k := range sortedKeys(storedSchedules) {
+				if !planned[k] {
+					changes = append(changes, planChange{Action: "delete", Resource: "schedule", Subject: subject.Name, Name: key, Detail: k})
+				}
+			}
+		}
+	}
+
+	return changes
+}
+
+func sortedKeys(m interface{}) []string {
+	var keys []string
+	switch m := m.(type) {
+	case map[string]string:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	case map[string]bool:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	}
+
+	sort.Strings(keys)
+	return keys
+}
+
+func describeSubject(subject PlanSubject) string {
+	var details []string
+	if subject.Type != "" {
+		details = append(details, "type: "+subject.Type)
+	}
+
+	if subject.Hours != nil {
+		details = append(details, fmt.Sprintf("hours: %d", *subject.Hours))
+	}
+
+	if subject.Points != nil {
+		details = append(details, fmt.Sprintf("points: %d", *subject.Points))
+	}
+
+	return strings.Join(details, ", ")
+}
+
+func describeSubjectUpdate(stored storage.CareerPlanSubject, subject PlanSubject) string {
+	describe := func(v *int) string {
+		if v == nil {
+			return "none"
+		}
+
+		return strconv.Itoa(*v)
+	}
+
+	var details []string
+	if storedType := stringValue(stored.Type); storedType != subject.Type {
+		details = append(details, fmt.Sprintf("type: %s -> %s", describeText(storedType), describeText(subject.Type)))
+	}
+
+	if describe(stored.Hours) != describe(subject.Hours) {
+		details = append(details, fmt.Sprintf("hours: %s -> %s", describe(stored.Hours), describe(subject.Hours)))
+	}
+
+	if describe(stored.Points) != describe(subject.Points) {
+		details = append(details, fmt.Sprintf("points: %s -> %s", describe(stored.Points), describe(subject.Points)))
+	}
+
+	return strings.Join(details, ", ")
+}
+
+func describeText(v string) string {
+	if v == "" {
+		return "none"
+	}
+
+	return v
+}
+
+// ImportCareerPlan validates the plan against the stored curriculum of the career and upserts it. On dry runs
+// the changes are only reported.
+func (s *Service) ImportCareerPlan(careerID string, plan CareerPlan, dryRun bool) ([]byte, error) {
+	stored, err := s.storage.GetCareerPlan(careerID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, fmt.Errorf("could not find career [career_id: %s]: %w", careerID, ErrNotFound)
+		}
+
+		return nil, fmt.Errorf("could not get career plan [career_id: %s]: %v", careerID, err)
+	}
+
+	current := newCurrentCareerPlan(stored)
+	if err := validateCareerPlan(plan, current); err != nil {
+		return nil, err
+	}
+
+	req, err := s.newImportCareerPlanRequest(careerID, plan)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := diffCareerPlan(plan, current)
+	if !dryRun && len(changes) > 0 {
+		if err := s.storage.ImportCareerPlan(req); err != nil {
+			switch {
+			case errors.Is(err, storage.ErrNotFound):
+				return nil, fmt.Errorf("could not import career plan [career_id: %s]: %w", careerID, ErrNotFound)
+			case errors.Is(err, storage.ErrCorrelativesCycle):
+				// The correlatives changed since they were validated.
+				return nil, fmt.Errorf("could not import career plan [career_id: %s]: %w", careerID, ErrCorrelativesCycle)
+			default:
+				return nil, fmt.Errorf("could not import career plan [career_id: %s]: %v", careerID, err)
+			}
+		}
+
+		s.search.invalidate()
+	}
+
+	type summary struct {
+		Create int `json:"create"`
+		Update int `json:"update"`
+		Delete int `json:"delete"`
+	}
+
+	type response struct {
+		CareerID string       `json:"career_id"`
+		DryRun   bool         `json:"dry_run"`
+		Changes  []planChange `json:"changes"`
+		Summary  summary      `json:"summary"`
+	}
+
+	r := response{CareerID: careerID, DryRun: dryRun, Changes: make([]planChange, 0, len(changes))}
+	for _, change := range changes {
+		r.Changes = append(r.Changes, change)
+		switch change.Action {
+		case "create":
+			r.Summary.Create++
+		case "update":
+			r.Summary.Update++
+		case "delete":
+			r.Summary.Delete++
+		}
+	}
+
+	b, err := json.Marshal(r)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal response: %v", err)
+	}
+
+	return b, nil
+}
+
+func (s *Service) newImportCareerPlanRequest(careerID string, plan CareerPlan) (storage.ImportCareerPlanRequest, error) {
+	terms := map[string]*int{}
+	req := storage.ImportCareerPlanRequest{CareerID: careerID, Subjects: make([]storage.ImportSubject, 0, len(plan.Subjects))}
+	for _, subject := range plan.Subjects {
+		imported := storage.ImportSubject{
+			Name:   subject.Name,
+			Type:   nullableString(subject.Type),
+			Hours:  subject.Hours,
+			Points: subject.Points,
+		}
+
+		for _, c := range subject.Correlatives {
+			imported.Correlatives = append(imported.Correlatives, storage.ImportCorrelative{
+				SubjectName: c.Subject,
+				Requirement: normalizeRequirement(c.Requirement),
+			})
+		}
+
+		for _, p := range subject.Professorships {
+			termID, exist := terms[p.Term]
+			if !exist {
+				var err error
+				if termID, err = s.getTermID(p.Term); err != nil {
+					if errors.Is(err, ErrNotFound) {
+						return storage.ImportCareerPlanRequest{}, fmt.Errorf("%w: term %s does not exist", ErrInvalidCareerPlan, p.Term)
+					}
+
+					return storage.ImportCareerPlanRequest{}, err
+				}
+
+				terms[p.Term] = termID
+			}
+
+			professorship := storage.ImportProfessorship{Name: p.Name, TermID: termID}
+			for _, schedule := range p.Schedules {
+				stored, err := (ScheduleRequest{Day: schedule.Day, Start: schedule.Start, End: schedule.End}).toStorage()
+				if err != nil {
+					return storage.ImportCareerPlanRequest{}, fmt.Errorf("%w: %v", ErrInvalidCareerPlan, err)
+				}
+
+				professorship.Schedules = append(professorship.Schedules, storage.ImportSchedule{
+					Day:   stored.Day,
+					Start: stored.Start,
+					End:   stored.End,
+				})
+			}
+
+			imported.Professorships = append(imported.Professorships, professorship)
+		}
+
+		req.Subjects = append(req.Subjects, imported)
+	}
+
+	return req, nil
+}
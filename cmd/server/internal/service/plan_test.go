@@ -0,0 +1,368 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
+)
+
+func TestParseCareerPlan(t *testing.T) {
+	hours, points := 96, 8
+	expected := CareerPlan{
+		Subjects: []PlanSubject{
+			{
+				Name:   "Álgebra",
+				Type:   "Obligatoria",
+				Hours:  &hours,
+				Points: &points,
+				Professorships: []PlanProfessorship{
+					{
+						Name: "Cátedra A",
+						Term: "2021-1",
+						Schedules: []PlanSchedule{
+							{Day: "Lunes", Start: "08:00", End: "10:00"},
+							{Day: "Jueves", Start: "08:00", End: "10:00"},
+						},
+					},
+				},
+			},
+			{
+				Name: "Análisis I",
+				Correlatives: []PlanCorrelative{
+					{Subject: "Álgebra", Requirement: "REGULARIZADA"},
+				},
+			},
+		},
+	}
+
+	tt := []struct {
+		name   string
+		format string
+		input  string
+	}{
+		{
+			name:   "yaml",
+			format: "yaml",
+			input: `subjects:
+  - name: Álgebra
+    type: Obligatoria
+    hours: 96
+    points: 8
+    professorships:
+      - name: Cátedra A
+        term: 2021-1
+        schedules:
+          - {day: Lunes, start: "08:00", end: "10:00"}
+          - {day: Jueves, start: "08:00", end: "10:00"}
+  - name: Análisis I
+    correlatives:
+      - {subject: Álgebra, requirement: REGULARIZADA}
+`,
+		},
+		{
+			name:   "csv",
+			format: "CSV",
+			input: `subject,type,hours,points,correlatives,professorship,term,day,start,end
+Álgebra,Obligatoria,96,8,,Cátedra A,2021-1,Lunes,08:00,10:00
+Álgebra,,,,,Cátedra A,2021-1,Jueves,08:00,10:00
+Análisis I,,,,Álgebra:REGULARIZADA,,,,,
+`,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// When
+			plan, err := ParseCareerPlan(tc.format, strings.NewReader(tc.input))
+
+			// Then
+			require.NoError(t, err)
+			require.Equal(t, expected, plan)
+		})
+	}
+}
+
+func TestParseCareerPlan_Error(t *testing.T) {
+	tt := []struct {
+		name          string
+		format        string
+		input         string
+		expectedError string
+	}{
+		{
+			name:          "unknown format",
+			format:        "xml",
+			expectedError: "service: invalid career plan [format: xml]: format must be csv or yaml",
+		},
+		{
+			name:          "unknown yaml field",
+			format:        "yaml",
+			input:         "subjects:\n  - name: Álgebra\n    credits: 8\n",
+			expectedError: "service: invalid career plan: yaml: unmarshal errors:\n  line 3: field credits not found in type service.PlanSubject",
+		},
+		{
+			name:          "unknown csv column",
+			format:        "csv",
+			input:         "subject,credits\nÁlgebra,8\n",
+			expectedError: "service: invalid career plan: unknown column credits",
+		},
+		{
+			name:          "invalid csv hours",
+			format:        "csv",
+			input:         "subject,hours\nÁlgebra,many\n",
+			expectedError: "service: invalid career plan: line 2: hours must be a number",
+		},
+		{
+			name:          "conflicting csv hours",
+			format:        "csv",
+			input:         "subject,hours\nÁlgebra,96\nÁlgebra,64\n",
+			expectedError: "service: invalid career plan: line 3: conflicting hours for subject Álgebra",
+		},
+		{
+			name:          "csv schedule without professorship",
+			format:        "csv",
+			input:         "subject,day,start,end\nÁlgebra,Lunes,08:00,10:00\n",
+			expectedError: "service: invalid career plan: line 2: professorship is required for terms and schedules",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// When
+			_, err := ParseCareerPlan(tc.format, strings.NewReader(tc.input))
+
+			// Then
+			require.EqualError(t, err, tc.expectedError)
+			require.True(t, errors.Is(err, ErrInvalidCareerPlan))
+		})
+	}
+}
+
+func TestService_ImportCareerPlan(t *testing.T) {
+	// Given
+	storedType := "Obligatoria"
+	storedHours := 64
+	term := "2021-1"
+	storage_ := storageMock{}
+	storage_.On("GetCareerPlan", "1").Return(storage.CareerPlan{
+		Subjects: []storage.CareerPlanSubject{
+			{SubjectID: 1, Name: "Álgebra", Type: &storedType, Hours: &storedHours},
+			{SubjectID: 3, Name: "Física I"},
+		},
+		Professorships: []storage.CareerPlanProfessorship{{ID: 5, SubjectID: 1, Name: "Cátedra A", Term: &term}},
+		Schedules: []storage.CareerPlanSchedule{
+			{ProfessorshipID: 5, Day: 1, Start: "08:00:00", End: "10:00:00"},
+			{ProfessorshipID: 5, Day: 3, Start: "08:00:00", End: "10:00:00"},
+		},
+	}, nil)
+	storage_.On("GetTerm", 2021, 1).Return(storage.Term{ID: 4, Year: 2021, Cuatrimestre: 1}, nil)
+
+	hours := 96
+	termID := 4
+	storage_.On("ImportCareerPlan", storage.ImportCareerPlanRequest{
+		CareerID: "1",
+		Subjects: []storage.ImportSubject{
+			{
+				Name:  "Álgebra",
+				Type:  &storedType,
+				Hours: &hours,
+				Professorships: []storage.ImportProfessorship{
+					{Name: "Cátedra A", TermID: &termID, Schedules: []storage.ImportSchedule{{Day: 1, Start: "08:00", End: "10:00"}}},
+				},
+			},
+			{
+				Name:         "Análisis I",
+				Correlatives: []storage.ImportCorrelative{{SubjectName: "Álgebra", Requirement: "APROBADA"}, {SubjectName: "Física I", Requirement: "REGULARIZADA"}},
+			},
+		},
+	}).Return(nil)
+
+	service := NewService(&storage_)
+
+	// When
+	resp, err := service.ImportCareerPlan("1", CareerPlan{
+		Subjects: []PlanSubject{
+			{
+				Name:  "Álgebra",
+				Type:  "Obligatoria",
+				Hours: &hours,
+				Professorships: []PlanProfessorship{
+					{Name: "Cátedra A", Term: "2021-1", Schedules: []PlanSchedule{{Day: "Lunes", Start: "8:00", End: "10:00"}}},
+				},
+			},
+			{
+				Name:         "Análisis I",
+				Correlatives: []PlanCorrelative{{Subject: "Álgebra"}, {Subject: "Física I", Requirement: "regularizada"}},
+			},
+		},
+	}, false)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.JSONEq(t, `{
+		"career_id": "1",
+		"dry_run": false,
+		"changes": [
+			{"action": "update", "resource": "subject", "subject": "Álgebra", "detail": "hours: 64 -> 96"},
+			{"action": "delete", "resource": "schedule", "subject": "Álgebra", "name": "Cátedra A (2021-1)", "detail": "Miércoles 08:00-10:00"},
+			{"action": "create", "resource": "subject", "subject": "Análisis I"},
+			{"action": "create", "resource": "correlative", "subject": "Análisis I", "name": "Álgebra", "detail": "APROBADA"},
+			{"action": "create", "resource": "correlative", "subject": "Análisis I", "name": "Física I", "detail": "REGULARIZADA"}
+		],
+		"summary": {"create": 3, "update": 1, "delete": 1}
+	}`, string(resp))
+	storage_.AssertExpectations(t)
+}
+
+func TestService_ImportCareerPlan_DryRun(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("GetCareerPlan", "1").Return(storage.CareerPlan{}, nil)
+
+	service := NewService(&storage_)
+
+	// When
+	resp, err := service.ImportCareerPlan("1", CareerPlan{Subjects: []PlanSubject{{Name: "Álgebra", Type: "Obligatoria"}}}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.JSONEq(t, `{
+		"career_id": "1",
+		"dry_run": true,
+		"changes": [{"action": "create", "resource": "subject", "subject": "Álgebra", "detail": "type: Obligatoria"}],
+		"summary": {"create": 1, "update": 0, "delete": 0}
+	}`, string(resp))
+	storage_.AssertNotCalled(t, "ImportCareerPlan", storage.ImportCareerPlanRequest{
+		CareerID: "1",
+		Subjects: []storage.ImportSubject{{Name: "Álgebra", Type: nullableString("Obligatoria")}},
+	})
+}
+
+func TestService_ImportCareerPlan_ValidationError(t *testing.T) {
+	hours := -1
+	tt := []struct {
+		name          string
+		plan          CareerPlan
+		expectedError string
+	}{
+		{
+			name:          "empty plan",
+			expectedError: "service: invalid career plan: plan has no subjects",
+		},
+		{
+			name: "duplicated subject and negative hours",
+			plan: CareerPlan{Subjects: []PlanSubject{
+				{Name: "Álgebra"},
+				{Name: "Álgebra", Hours: &hours},
+			}},
+			expectedError: "service: invalid career plan: subject Álgebra is duplicated; subject Álgebra: hours must be positive",
+		},
+		{
+			name: "unknown correlative",
+			plan: CareerPlan{Subjects: []PlanSubject{
+				{Name: "Análisis I", Correlatives: []PlanCorrelative{{Subject: "Álgebra", Requirement: "CURSADA"}}},
+			}},
+			expectedError: "service: invalid career plan: subject Análisis I: unknown correlative Álgebra; subject Análisis I: correlative Álgebra requirement must be APROBADA or REGULARIZADA",
+		},
+		{
+			name: "invalid schedule",
+			plan: CareerPlan{Subjects: []PlanSubject{
+				{Name: "Álgebra", Professorships: []PlanProfessorship{
+					{Name: "Cátedra A", Schedules: []PlanSchedule{{Day: "Feriado", Start: "08:00", End: "10:00"}}},
+				}},
+			}},
+			expectedError: "service: invalid career plan: subject Álgebra: professorship Cátedra A: service: invalid schedule [day: Feriado]: unknown day",
+		},
+		{
+			name: "correlatives cycle with a stored subject",
+			plan: CareerPlan{Subjects: []PlanSubject{
+				{Name: "Análisis I", Correlatives: []PlanCorrelative{{Subject: "Física I"}}},
+			}},
+			expectedError: "service: invalid career plan: correlatives have a cycle [subject: Análisis I]",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// Given
+			storage_ := storageMock{}
+			storage_.On("GetCareerPlan", "1").Return(storage.CareerPlan{
+				Subjects: []storage.CareerPlanSubject{
+					{SubjectID: 2, Name: "Análisis I"},
+					{SubjectID: 3, Name: "Física I"},
+				},
+				Correlatives: []storage.Correlative{{SubjectID: 3, CorrelativeID: 2, Requirement: "APROBADA"}},
+			}, nil)
+
+			service := NewService(&storage_)
+
+			// When
+			_, err := service.ImportCareerPlan("1", tc.plan, false)
+
+			// Then
+			require.EqualError(t, err, tc.expectedError)
+			require.True(t, errors.Is(err, ErrInvalidCareerPlan))
+		})
+	}
+}
+
+func TestService_ImportCareerPlan_CareerNotFoundError(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("GetCareerPlan", "1").Return(storage.CareerPlan{}, storage.ErrNotFound)
+
+	service := NewService(&storage_)
+
+	// When
+	_, err := service.ImportCareerPlan("1", CareerPlan{Subjects: []PlanSubject{{Name: "Álgebra"}}}, false)
+
+	// Then
+	require.EqualError(t, err, "could not find career [career_id: 1]: service: resource not found")
+	require.True(t, errors.Is(err, ErrNotFound))
+}
+
+func TestService_ImportCareerPlan_StorageCorrelativesCycleError(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("GetCareerPlan", "1").Return(storage.CareerPlan{}, nil)
+	storage_.On("ImportCareerPlan", storage.ImportCareerPlanRequest{
+		CareerID: "1",
+		Subjects: []storage.ImportSubject{{Name: "Álgebra"}},
+	}).Return(fmt.Errorf("%w [subject_id: 1]", storage.ErrCorrelativesCycle))
+
+	service := NewService(&storage_)
+
+	// When
+	_, err := service.ImportCareerPlan("1", CareerPlan{Subjects: []PlanSubject{{Name: "Álgebra"}}}, false)
+
+	// Then
+	require.EqualError(t, err, "could not import career plan [career_id: 1]: service: correlatives have a cycle")
+	require.True(t, errors.Is(err, ErrCorrelativesCycle))
+}
+
+func TestService_ImportCareerPlan_TermNotFoundError(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("GetCareerPlan", "1").Return(storage.CareerPlan{}, nil)
+	storage_.On("GetTerm", 2030, 1).Return(storage.Term{}, storage.ErrNotFound)
+
+	service := NewService(&storage_)
+
+	// When
+	_, err := service.ImportCareerPlan("1", CareerPlan{Subjects: []PlanSubject{
+		{Name: "Álgebra", Professorships: []PlanProfessorship{{Name: "Cátedra A", Term: "2030-1"}}},
+	}}, false)
+
+	// Then
+	require.EqualError(t, err, "service: invalid career plan: term 2030-1 does not exist")
+}
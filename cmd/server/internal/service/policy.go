@@ -0,0 +1,117 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
+)
+
+var (
+	ErrStatusNotAllowed = errors.New("service: status not allowed by the faculty")
+	ErrInvalidPolicy    = errors.New("service: invalid faculty policy")
+)
+
+// FacultyPolicy holds the rules the careers of a faculty apply to their students: how many careers a student can
+// have, which statuses its subjects can take and whether correlatives are checked when updating them.
+type FacultyPolicy struct {
+	MaxCareersPerStudent int
+	AllowedStatuses      []string
+	EnforceCorrelatives  bool
+}
+
+// requiredStatuses are allowed by every policy. Subjects start as PENDIENTE, and equivalences write APROBADA in
+// storage without loading the policy, which is only correct because no policy can leave it out.
+var requiredStatuses = []string{statusPendiente, statusAprobada}
+
+// careerPolicy loads the policy of the faculty of the career.
+func (s *Service) careerPolicy(careerID string) (storage.FacultyPolicy, error) {
+	career, err := s.storage.GetCareer(careerID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return storage.FacultyPolicy{}, fmt.Errorf("could not get career policy: %w", ErrNotFound)
+		}
+
+		return storage.FacultyPolicy{}, fmt.Errorf("could not get career policy: %v", err)
+	}
+
+	policy, err := s.storage.GetFacultyPolicy(strconv.Itoa(career.FacultyID))
+	if err != nil {
+		return storage.FacultyPolicy{}, fmt.Errorf("could not get career policy: %v", err)
+	}
+
+	return policy, nil
+}
+
+func allowsStatus(policy storage.FacultyPolicy, status string) bool {
+	for _, allowed := range policy.AllowedStatuses {
+		if allowed == status {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *Service) GetFacultyPolicy(facultyID string) ([]byte, error) {
+	policy, err := s.storage.GetFacultyPolicy(facultyID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, fmt.Errorf("could not get faculty policy: %w", ErrNotFound)
+		}
+
+		return nil, fmt.Errorf("could not get faculty policy: %v", err)
+	}
+
+	b, err := json.Marshal(struct {
+		FacultyID            int      `json:"faculty_id"`
+		MaxCareersPerStudent int      `json:"max_careers_per_student"`
+		AllowedStatuses      []string `json:"allowed_statuses"`
+		EnforceCorrelatives  bool     `json:"enforce_correlatives"`
+	}(policy))
+
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal response: %v", err)
+	}
+
+	return b, nil
+}
+
+// UpdateFacultyPolicy replaces the policy of the faculty, which must allow the requiredStatuses.
+func (s *Service) UpdateFacultyPolicy(facultyID string, req FacultyPolicy) error {
+	if req.MaxCareersPerStudent < 1 {
+		return fmt.Errorf("could not update faculty policy: max careers per student must be positive: %w", ErrInvalidPolicy)
+	}
+
+	id, err := strconv.Atoi(facultyID)
+	if err != nil {
+		return fmt.Errorf("could not update faculty policy: %w", ErrNotFound)
+	}
+
+	policy := storage.FacultyPolicy{
+		FacultyID:            id,
+		MaxCareersPerStudent: req.MaxCareersPerStudent,
+		AllowedStatuses:      req.AllowedStatuses,
+		EnforceCorrelatives:  req.EnforceCorrelatives,
+	}
+
+	for _, status := range req.AllowedStatuses {
+		if _, exist := statusTransitions[status]; !exist {
+			return fmt.Errorf("could not update faculty policy: unknown status %s: %w", status, ErrInvalidPolicy)
+		}
+	}
+
+	for _, status := range requiredStatuses {
+		if !allowsStatus(policy, status) {
+			return fmt.Errorf("could not update faculty policy: status %s is required: %w", status, ErrInvalidPolicy)
+		}
+	}
+
+	if err := s.storage.UpdateFacultyPolicy(policy); err != nil {
+		return translateCatalogError("update faculty policy", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,167 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
+)
+
+var defaultPolicy = storage.FacultyPolicy{
+	FacultyID:            1,
+	MaxCareersPerStudent: 2,
+	AllowedStatuses:      []string{"PENDIENTE", "CURSANDO", "REGULARIZADA", "APROBADA", "LIBRE", "RECURSANDO"},
+	EnforceCorrelatives:  true,
+}
+
+// mockCareerPolicy makes every career belong to the faculty of the policy.
+func mockCareerPolicy(storage_ *storageMock, policy storage.FacultyPolicy) {
+	storage_.On("GetCareer", mock.Anything).Return(storage.Career{ID: 1, FacultyID: policy.FacultyID}, nil)
+	storage_.On("GetFacultyPolicy", "1").Return(policy, nil)
+}
+
+func TestService_AssignStudentToCareer_PolicyMaxCareers(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	mockCareerPolicy(&storage_, storage.FacultyPolicy{FacultyID: 1, MaxCareersPerStudent: 1, AllowedStatuses: defaultPolicy.AllowedStatuses})
+	storage_.On("GetStudentCareerIDs", "example@gmail.com").Return([]int{2}, nil)
+
+	s := NewService(&storage_)
+
+	// When
+	_, err := s.AssignStudentToCareer("example@gmail.com", "1")
+
+	// Then
+	require.True(t, errors.Is(err, ErrMaxCareerReached))
+	storage_.AssertNotCalled(t, "AssignStudentToCareer", "example@gmail.com", "1")
+}
+
+func TestService_AssignStudentToCareer_CareerNotFoundError(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("GetStudentCareerIDs", "example@gmail.com").Return([]int{}, nil)
+	storage_.On("GetCareer", "1").Return(storage.Career{}, storage.ErrNotFound)
+
+	s := NewService(&storage_)
+
+	// When
+	_, err := s.AssignStudentToCareer("example@gmail.com", "1")
+
+	// Then
+	require.True(t, errors.Is(err, ErrNotFound))
+}
+
+func TestService_UpdateStudentSubject_StatusNotAllowedError(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	mockCareerPolicy(&storage_, storage.FacultyPolicy{FacultyID: 1, MaxCareersPerStudent: 2, AllowedStatuses: []string{"PENDIENTE", "CURSANDO", "APROBADA"}})
+
+	s := NewService(&storage_)
+
+	// When
+	err := s.UpdateStudentSubject(UpdateStudentSubjectRequest{StudentEmail: "test@gmail.com", CareerID: "1", SubjectID: "3", Status: "LIBRE", OverrideCorrelatives: true, OverrideStatus: true})
+
+	// Then
+	require.True(t, errors.Is(err, ErrStatusNotAllowed))
+	storage_.AssertNotCalled(t, "UpdateStudentSubject", mock.Anything)
+}
+
+func TestService_UpdateStudentSubject_CorrelativesNotEnforced(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	mockCareerPolicy(&storage_, storage.FacultyPolicy{FacultyID: 1, MaxCareersPerStudent: 2, AllowedStatuses: defaultPolicy.AllowedStatuses})
+	storage_.On("GetStudentSubjects", "test@gmail.com", "1").Return([]storage.StudentSubject{
+		{ID: 1, Status: "PENDIENTE"},
+		{ID: 3, Status: "PENDIENTE"},
+	}, nil)
+	storage_.On("UpdateStudentSubject", storage.UpdateStudentSubjectRequest{
+		StudentEmail: "test@gmail.com",
+		CareerID:     "1",
+		SubjectID:    "3",
+		Status:       "CURSANDO",
+	}).Return(nil)
+
+	s := NewService(&storage_)
+
+	// When
+	err := s.UpdateStudentSubject(UpdateStudentSubjectRequest{StudentEmail: "test@gmail.com", CareerID: "1", SubjectID: "3", Status: "CURSANDO"})
+
+	// Then
+	require.NoError(t, err)
+	storage_.AssertNotCalled(t, "GetCorrelatives", mock.Anything)
+}
+
+func TestService_TransferStudentCareer_PolicyMaxCareers(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	mockCareerPolicy(&storage_, storage.FacultyPolicy{FacultyID: 1, MaxCareersPerStudent: 1, AllowedStatuses: defaultPolicy.AllowedStatuses})
+	storage_.On("GetStudentCareerIDs", "example@gmail.com").Return([]int{1, 3}, nil)
+
+	s := NewService(&storage_)
+
+	// When
+	_, err := s.TransferStudentCareer("example@gmail.com", "1", "2")
+
+	// Then
+	require.True(t, errors.Is(err, ErrMaxCareerReached))
+	storage_.AssertNotCalled(t, "TransferStudentCareer", mock.Anything)
+}
+
+func TestService_GetFacultyPolicy(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("GetFacultyPolicy", "1").Return(defaultPolicy, nil)
+
+	s := NewService(&storage_)
+
+	// When
+	b, err := s.GetFacultyPolicy("1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.JSONEq(t, `{"faculty_id":1,"max_careers_per_student":2,"allowed_statuses":["PENDIENTE","CURSANDO","REGULARIZADA","APROBADA","LIBRE","RECURSANDO"],"enforce_correlatives":true}`, string(b))
+}
+
+func TestService_UpdateFacultyPolicy_InvalidPolicyError(t *testing.T) {
+	tt := []struct {
+		name   string
+		policy FacultyPolicy
+	}{
+		{name: "no careers", policy: FacultyPolicy{MaxCareersPerStudent: 0, AllowedStatuses: []string{"PENDIENTE", "APROBADA"}}},
+		{name: "unknown status", policy: FacultyPolicy{MaxCareersPerStudent: 1, AllowedStatuses: []string{"PENDIENTE", "APROBADA", "RECHAZADA"}}},
+		{name: "missing approved status", policy: FacultyPolicy{MaxCareersPerStudent: 1, AllowedStatuses: []string{"PENDIENTE", "CURSANDO"}}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// Given
+			s := NewService(&storageMock{})
+
+			// When
+			err := s.UpdateFacultyPolicy("1", tc.policy)
+
+			// Then
+			require.True(t, errors.Is(err, ErrInvalidPolicy))
+		})
+	}
+}
+
+func TestService_UpdateFacultyPolicy(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("UpdateFacultyPolicy", storage.FacultyPolicy{FacultyID: 1, MaxCareersPerStudent: 3, AllowedStatuses: []string{"PENDIENTE", "APROBADA"}}).
+		Return(storage.ErrNotFound)
+
+	s := NewService(&storage_)
+
+	// When
+	err := s.UpdateFacultyPolicy("1", FacultyPolicy{MaxCareersPerStudent: 3, AllowedStatuses: []string{"PENDIENTE", "APROBADA"}})
+
+	// Then
+	require.True(t, errors.Is(err, ErrNotFound))
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
+)
+
+var roleRank = map[string]int{
+	"TITULAR":  1,
+	"ADJUNTO":  2,
+	"JTP":      3,
+	"AYUDANTE": 4,
+}
+
+type professor struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+	Role string `json:"role"`
+}
+
+func sortProfessorsByRole(professors []professor) {
+	sort.SliceStable(professors, func(i, j int) bool {
+		ri, rj := rankOfRole(professors[i].Role), rankOfRole(professors[j].Role)
+		if ri != rj {
+			return ri < rj
+		}
+
+		return professors[i].Name < professors[j].Name
+	})
+}
+
+func rankOfRole(role string) int {
+	rank, exist := roleRank[strings.ToUpper(role)]
+	if !exist {
+		return len(roleRank) + 1
+	}
+
+	return rank
+}
+
+func (s *Service) GetProfessor(professorID string) ([]byte, error) {
+	type (
+		reference struct {
+			ID   int    `json:"id"`
+			Name string `json:"name"`
+		}
+
+		professorship struct {
+			ID      int       `json:"id"`
+			Name    string    `json:"name"`
+			Role    string    `json:"role"`
+			Subject reference `json:"subject"`
+			Career  reference `json:"career"`
+		}
+
+		professorResponse struct {
+			ID             int             `json:"id"`
+			Name           string          `json:"name"`
+			Professorships []professorship `json:"professorships"`
+		}
+	)
+
+	p, err := s.storage.GetProfessor(professorID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, fmt.Errorf("could not get professor: %w", ErrNotFound)
+		}
+
+		return nil, fmt.Errorf("could not get professor: %v", err)
+	}
+
+	professorships, err := s.storage.GetProfessorProfessorships(professorID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get professor professorships: %v", err)
+	}
+
+	response := professorResponse{
+		ID:             p.ID,
+		Name:           p.Name,
+		Professorships: make([]professorship, 0, len(professorships)),
+	}
+
+	for _, ps := range professorships {
+		response.Professorships = append(response.Professorships, professorship{
+			ID:   ps.ID,
+			Name: ps.Name,
+			Role: ps.Role,
+			Subject: reference{
+				ID:   ps.SubjectID,
+				Name: ps.SubjectName,
+			},
+			Career: reference{
+				ID:   ps.CareerID,
+				Name: ps.CareerName,
+			},
+		})
+	}
+
+	b, err := json.Marshal(response)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal response: %v", err)
+	}
+
+	return b, nil
+}
@@ -0,0 +1,73 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
+)
+
+func TestService_GetProfessor(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("GetProfessor", "4").Return(storage.Professor{ID: 4, Name: "Professor 1"}, nil)
+	storage_.On("GetProfessorProfessorships", "4").Return([]storage.ProfessorProfessorship{
+		{
+			ID:          3,
+			Name:        "CATEDRA 1",
+			Role:        "TITULAR",
+			SubjectID:   1,
+			SubjectName: "Subject 1",
+			CareerID:    2,
+			CareerName:  "Career 2",
+		},
+	}, nil)
+
+	s := NewService(&storage_)
+
+	// When
+	professor, err := s.GetProfessor("4")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Equal(t, []byte(`{"id":4,"name":"Professor 1","professorships":[{"id":3,"name":"CATEDRA 1","role":"TITULAR","subject":{"id":1,"name":"Subject 1"},"career":{"id":2,"name":"Career 2"}}]}`), professor)
+}
+
+func TestService_GetProfessor_StorageNotFoundError(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("GetProfessor", "4").Return(storage.Professor{}, storage.ErrNotFound)
+
+	s := NewService(&storage_)
+
+	// When
+	_, err := s.GetProfessor("4")
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "could not get professor: service: resource not found")
+}
+
+func TestService_GetProfessor_GetProfessorProfessorshipsError(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("GetProfessor", "4").Return(storage.Professor{ID: 4, Name: "Professor 1"}, nil)
+	storage_.On("GetProfessorProfessorships", "4").Return([]storage.ProfessorProfessorship{}, errors.New("error"))
+
+	s := NewService(&storage_)
+
+	// When
+	_, err := s.GetProfessor("4")
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "could not get professor professorships: error")
+}
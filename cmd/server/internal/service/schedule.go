@@ -0,0 +1,244 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
+)
+
+type timeSlot struct {
+	ProfessorshipID int
+	Day             int
+	Start           int
+	End             int
+}
+
+func newTimeSlot(professorshipID, day int, start, end string) (timeSlot, error) {
+	if _, err := convertDayNumberToDay(day); err != nil {
+		return timeSlot{}, err
+	}
+
+	s, err := parseClock(start)
+	if err != nil {
+		return timeSlot{}, err
+	}
+
+	e, err := parseClock(end)
+	if err != nil {
+		return timeSlot{}, err
+	}
+
+	return timeSlot{ProfessorshipID: professorshipID, Day: day, Start: s, End: e}, nil
+}
+
+func (t timeSlot) overlaps(other timeSlot) bool {
+	return t.Day == other.Day && t.Start < other.End && other.Start < t.End
+}
+
+func (t timeSlot) hours() float64 {
+	return float64(t.End-t.Start) / 60
+}
+
+// parseClock converts a HH:MM[:SS] time into minutes since midnight.
+func parseClock(clock string) (int, error) {
+	parts := strings.Split(clock, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, fmt.Errorf("could not parse time [time: %s]", clock)
+	}
+
+	hours, err := strconv.Atoi(parts[0])
+	if err != nil || hours < 0 || hours > 24 {
+		return 0, fmt.Errorf("could not parse time [time: %s]", clock)
+	}
+
+	minutes, err := strconv.Atoi(parts[1])
+	if err != nil || minutes < 0 || minutes > 59 {
+		return 0, fmt.Errorf("could not parse time [time: %s]", clock)
+	}
+
+	return hours*60 + minutes, nil
+}
+
+func formatClock(minutes int) string {
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
+
+func sortTimeSlots(slots []timeSlot) {
+	sort.Slice(slots, func(i, j int) bool {
+		if slots[i].Day != slots[j].Day {
+			return slots[i].Day < slots[j].Day
+		}
+
+		if slots[i].Start != slots[j].Start {
+			return slots[i].Start < slots[j].Start
+		}
+
+		return slots[i].ProfessorshipID < slots[j].ProfessorshipID
+	})
+}
+
+// freeGaps returns the idle windows between classes of the same day. Slots must be sorted.
+func freeGaps(slots []timeSlot) []timeSlot {
+	var gaps []timeSlot
+	for i := 0; i < len(slots); {
+		day, end := slots[i].Day, slots[i].End
+		for i++; i < len(slots) && slots[i].Day == day; i++ {
+			if slots[i].Start > end {
+				gaps = append(gaps, timeSlot{Day: day, Start: end, End: slots[i].Start})
+			}
+
+			if slots[i].End > end {
+				end = slots[i].End
+			}
+		}
+	}
+
+	return gaps
+}
+
+func (s *Service) CheckScheduleConflicts(professorshipIDs []int) ([]byte, error) {
+	type (
+		schedule struct {
+			Day   string `json:"day"`
+			Start string `json:"start"`
+			End   string `json:"end"`
+		}
+
+		subject struct {
+			ID   int    `json:"id"`
+			Name string `json:"name"`
+		}
+
+		professorship struct {
+			ID        int        `json:"id"`
+			Name      string     `json:"name"`
+			Subject   subject    `json:"subject"`
+			Schedules []schedule `json:"schedules"`
+		}
+
+		conflict struct {
+			schedule
+			ProfessorshipIDs []int `json:"professorship_ids"`
+		}
+
+		checkScheduleConflictsResponse struct {
+			Professorships []professorship `json:"professorships"`
+			Conflicts      []conflict      `json:"conflicts"`
+			WeeklyHours    float64         `json:"weekly_hours"`
+			FreeGaps       []schedule      `json:"free_gaps"`
+		}
+	)
+
+	professorshipsSchedules, err := s.storage.GetProfessorshipsSchedules(professorshipIDs)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, fmt.Errorf("could not get professorships schedules: %w", ErrNotFound)
+		}
+
+		return nil, fmt.Errorf("could not get professorships schedules: %v", err)
+	}
+
+	professorships := make(map[int]*professorship, len(professorshipIDs))
+	slots := make([]timeSlot, 0, len(professorshipsSchedules))
+	for _, ps := range professorshipsSchedules {
+		if _, exist := professorships[ps.ProfessorshipID]; !exist {
+			professorships[ps.ProfessorshipID] = &professorship{
+				ID:        ps.ProfessorshipID,
+				Name:      ps.Name,
+				Subject:   subject{ID: ps.SubjectID, Name: ps.SubjectName},
+				Schedules: []schedule{},
+			}
+		}
+
+		// A professorship without schedules takes no hours.
+		if ps.Day == nil || ps.Start == nil || ps.End == nil {
+			continue
+		}
+
+		slot, err := newTimeSlot(ps.ProfessorshipID, *ps.Day, *ps.Start, *ps.End)
+		if err != nil {
+			return nil, err
+		}
+
+		slots = append(slots, slot)
+	}
+
+	var missing []string
+	for _, id := range professorshipIDs {
+		if _, exist := professorships[id]; !exist {
+			missing = append(missing, strconv.Itoa(id))
+		}
+	}
+
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("could not find professorships [professorship_ids: %s]: %w", strings.Join(missing, ", "), ErrNotFound)
+	}
+
+	sortTimeSlots(slots)
+
+	toSchedule := func(slot timeSlot) schedule {
+		return schedule{
+			Day:   dayNumberToDay[slot.Day],
+			Start: formatClock(slot.Start),
+			End:   formatClock(slot.End),
+		}
+	}
+
+	response := checkScheduleConflictsResponse{
+		Professorships: make([]professorship, 0, len(professorships)),
+		Conflicts:      []conflict{},
+		FreeGaps:       []schedule{},
+	}
+
+	var weeklyHours float64
+	for i, slot := range slots {
+		weeklyHours += slot.hours()
+		professorships[slot.ProfessorshipID].Schedules = append(professorships[slot.ProfessorshipID].Schedules, toSchedule(slot))
+
+		for _, other := range slots[i+1:] {
+			if other.Day != slot.Day || other.Start >= slot.End {
+				break
+			}
+
+			if other.ProfessorshipID == slot.ProfessorshipID || !slot.overlaps(other) {
+				continue
+			}
+
+			overlap := timeSlot{Day: slot.Day, Start: other.Start, End: slot.End}
+			if other.End < overlap.End {
+				overlap.End = other.End
+			}
+
+			response.Conflicts = append(response.Conflicts, conflict{
+				schedule:         toSchedule(overlap),
+				ProfessorshipIDs: []int{slot.ProfessorshipID, other.ProfessorshipID},
+			})
+		}
+	}
+
+	for _, p := range professorships {
+		response.Professorships = append(response.Professorships, *p)
+	}
+
+	sort.Slice(response.Professorships, func(i, j int) bool {
+		return response.Professorships[i].ID < response.Professorships[j].ID
+	})
+
+	for _, gap := range freeGaps(slots) {
+		response.FreeGaps = append(response.FreeGaps, toSchedule(gap))
+	}
+
+	response.WeeklyHours = round(weeklyHours)
+
+	b, err := json.Marshal(response)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal response: %v", err)
+	}
+
+	return b, nil
+}
@@ -0,0 +1,185 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
+)
+
+func TestService_CheckScheduleConflicts(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("GetProfessorshipsSchedules", []int{1, 2, 3}).Return([]storage.ProfessorshipSchedule{
+		professorshipSchedule(2, "K1022", 4, "Química", 1, "18:00:00", "20:00:00"),
+		professorshipSchedule(1, "K1021", 3, "Física I", 1, "19:00:00", "22:00:00"),
+		professorshipSchedule(3, "K1023", 5, "Inglés", 3, "08:00:00", "09:30:00"),
+		professorshipSchedule(1, "K1021", 3, "Física I", 3, "11:00:00", "13:00:00"),
+	}, nil)
+
+	s := NewService(&storage_)
+
+	// When
+	b, err := s.CheckScheduleConflicts([]int{1, 2, 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.JSONEq(t, `{
+		"professorships": [
+			{
+				"id": 1,
+				"name": "K1021",
+				"subject": {"id": 3, "name": "Física I"},
+				"schedules": [
+					{"day": "Lunes", "start": "19:00", "end": "22:00"},
+					{"day": "Miércoles", "start": "11:00", "end": "13:00"}
+				]
+			},
+			{
+				"id": 2,
+				"name": "K1022",
+				"subject": {"id": 4, "name": "Química"},
+				"schedules": [{"day": "Lunes", "start": "18:00", "end": "20:00"}]
+			},
+			{
+				"id": 3,
+				"name": "K1023",
+				"subject": {"id": 5, "name": "Inglés"},
+				"schedules": [{"day": "Miércoles", "start": "08:00", "end": "09:30"}]
+			}
+		],
+		"conflicts": [
+			{"day": "Lunes", "start": "19:00", "end": "20:00", "professorship_ids": [2, 1]}
+		],
+		"weekly_hours": 8.5,
+		"free_gaps": [
+			{"day": "Miércoles", "start": "09:30", "end": "11:00"}
+		]
+	}`, string(b))
+}
+
+func TestService_CheckScheduleConflicts_ProfessorshipWithoutSchedules(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("GetProfessorshipsSchedules", []int{1, 2}).Return([]storage.ProfessorshipSchedule{
+		{ProfessorshipID: 2, Name: "K1022", SubjectID: 4, SubjectName: "Química"},
+		professorshipSchedule(1, "K1021", 3, "Física I", 1, "19:00:00", "22:00:00"),
+	}, nil)
+
+	s := NewService(&storage_)
+
+	// When
+	b, err := s.CheckScheduleConflicts([]int{1, 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.JSONEq(t, `{
+		"professorships": [
+			{
+				"id": 1,
+				"name": "K1021",
+				"subject": {"id": 3, "name": "Física I"},
+				"schedules": [{"day": "Lunes", "start": "19:00", "end": "22:00"}]
+			},
+			{
+				"id": 2,
+				"name": "K1022",
+				"subject": {"id": 4, "name": "Química"},
+				"schedules": []
+			}
+		],
+		"conflicts": [],
+		"weekly_hours": 3,
+		"free_gaps": []
+	}`, string(b))
+}
+
+func TestService_CheckScheduleConflicts_ProfessorshipNotFoundError(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("GetProfessorshipsSchedules", []int{1, 2}).Return([]storage.ProfessorshipSchedule{
+		professorshipSchedule(1, "K1021", 3, "Física I", 1, "19:00:00", "22:00:00"),
+	}, nil)
+
+	s := NewService(&storage_)
+
+	// When
+	_, err := s.CheckScheduleConflicts([]int{1, 2})
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "could not find professorships [professorship_ids: 2]: service: resource not found")
+}
+
+func TestService_CheckScheduleConflicts_StorageNotFoundError(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("GetProfessorshipsSchedules", []int{1}).Return([]storage.ProfessorshipSchedule{}, storage.ErrNotFound)
+
+	s := NewService(&storage_)
+
+	// When
+	_, err := s.CheckScheduleConflicts([]int{1})
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "could not get professorships schedules: service: resource not found")
+}
+
+func TestService_CheckScheduleConflicts_InvalidDayError(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("GetProfessorshipsSchedules", []int{1}).Return([]storage.ProfessorshipSchedule{
+		professorshipSchedule(1, "K1021", 0, "", 8, "19:00:00", "22:00:00"),
+	}, nil)
+
+	s := NewService(&storage_)
+
+	// When
+	_, err := s.CheckScheduleConflicts([]int{1})
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "could not convert day number [dayNumber: 8] to day")
+}
+
+func TestFreeGaps(t *testing.T) {
+	// Given
+	slots := []timeSlot{
+		{Day: 1, Start: 480, End: 600},
+		{Day: 1, Start: 540, End: 660},
+		{Day: 1, Start: 720, End: 780},
+		{Day: 2, Start: 480, End: 600},
+		{Day: 3, Start: 480, End: 600},
+		{Day: 3, Start: 600, End: 660},
+	}
+
+	// When
+	gaps := freeGaps(slots)
+
+	// Then
+	require.Equal(t, []timeSlot{{Day: 1, Start: 660, End: 720}}, gaps)
+}
+
+func professorshipSchedule(professorshipID int, name string, subjectID int, subjectName string, day int, start, end string) storage.ProfessorshipSchedule {
+	return storage.ProfessorshipSchedule{
+		ProfessorshipID: professorshipID,
+		Name:            name,
+		SubjectID:       subjectID,
+		SubjectName:     subjectName,
+		Day:             &day,
+		Start:           &start,
+		End:             &end,
+	}
+}
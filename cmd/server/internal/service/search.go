@@ -0,0 +1,329 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
+)
+
+const (
+	searchDefaultPageSize = 10
+	searchMaxPageSize     = 50
+
+	// searchIndexMaxAge bounds how long the index lives, so catalog changes made outside the service, like new
+	// professors, show up too.
+	searchIndexMaxAge = 5 * time.Minute
+)
+
+var ErrInvalidSearch = errors.New("service: invalid search")
+
+var searchAccents = strings.NewReplacer(
+	"á", "a", "à", "a", "ä", "a", "â", "a",
+	"é", "e", "è", "e", "ë", "e", "ê", "e",
+	"í", "i", "ì", "i", "ï", "i", "î", "i",
+	"ó", "o", "ò", "o", "ö", "o", "ô", "o",
+	"ú", "u", "ù", "u", "ü", "u", "û", "u",
+	"ñ", "n", "ç", "c",
+)
+
+// searchWords lowercases the text, strips its accents and splits it in words.
+func searchWords(text string) []string {
+	return strings.FieldsFunc(searchAccents.Replace(strings.ToLower(text)), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+}
+
+type SearchRequest struct {
+	Query    string
+	Page     int
+	PageSize int
+}
+
+type searchResult struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	FacultyID   int    `json:"faculty_id,omitempty"`
+	CareerID    int    `json:"career_id,omitempty"`
+	SubjectID   int    `json:"subject_id,omitempty"`
+	SubjectName string `json:"subject_name,omitempty"`
+}
+
+type searchDocument struct {
+	result searchResult
+	words  []string
+
+	// key sorts documents by name ignoring case and accents.
+	key string
+}
+
+type searchGroups struct {
+	subjects       []searchDocument
+	careers        []searchDocument
+	professors     []searchDocument
+	professorships []searchDocument
+}
+
+func newSearchDocument(result searchResult) searchDocument {
+	words := searchWords(result.Name)
+	return searchDocument{result: result, words: words, key: strings.Join(words, " ")}
+}
+
+func newSearchGroups(catalog storage.SearchCatalog) searchGroups {
+	var groups searchGroups
+	for _, subject := range catalog.Subjects {
+		groups.subjects = append(groups.subjects, newSearchDocument(searchResult{ID: subject.ID, Name: subject.Name}))
+	}
+
+	for _, career := range catalog.Careers {
+		groups.careers = append(groups.careers, newSearchDocument(searchResult{ID: career.ID, Name: career.Name, FacultyID: career.FacultyID}))
+	}
+
+	for _, professor := range catalog.Professors {
+		groups.professors = append(groups.professors, newSearchDocument(searchResult{ID: professor.ID, Name: professor.Name}))
+	}
+
+	for _, professorship := range catalog.Professorships {
+		groups.professorships = append(groups.professorships, newSearchDocument(searchResult{
+			ID:          professorship.ID,
+			Name:        professorship.Name,
+			CareerID:    professorship.CareerID,
+			SubjectID:   professorship.SubjectID,
+			SubjectName: professorship.SubjectName,
+		}))
+	}
+
+	return groups
+}
+
+// searchIndex keeps the catalog names in memory. Catalog changes made through the service invalidate it and the
+// next search rebuilds it from the storage.
+type searchIndex struct {
+	mu      sync.RWMutex
+	version int
+	builtAt time.Time
+	groups  searchGroups
+}
+
+func (i *searchIndex) invalidate() {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.version++
+	i.builtAt = time.Time{}
+}
+
+func (s *Service) searchGroups() (searchGroups, error) {
+	now := s.now()
+
+	s.search.mu.RLock()
+	groups, builtAt, version := s.search.groups, s.search.builtAt, s.search.version
+	s.search.mu.RUnlock()
+
+	if !builtAt.IsZero() && now.Sub(builtAt) < searchIndexMaxAge {
+		return groups, nil
+	}
+
+	catalog, err := s.storage.GetSearchCatalog()
+	if err != nil {
+		return searchGroups{}, fmt.Errorf("could not get search catalog: %v", err)
+	}
+
+	groups = newSearchGroups(catalog)
+
+	s.search.mu.Lock()
+	defer s.search.mu.Unlock()
+
+	// The catalog could have changed while it was read, in which case the next search reads it again.
+	if s.search.version == version {
+		s.search.groups, s.search.builtAt = groups, now
+	}
+
+	return groups, nil
+}
+
+// searchWordScore scores how well a query word matches a name word: exact words score the most, then prefixes,
+// then substrings and finally words, or their prefixes, with a few typos. Zero means no match.
+func searchWordScore(query, word string) float64 {
+	switch {
+	case word == query:
+		return 1
+	case strings.HasPrefix(word, query):
+		return 0.8
+	case strings.Contains(word, query):
+		return 0.6
+	}
+
+	q, w := []rune(query), []rune(word)
+	typos := len(q) / 4
+	if typos > 2 {
+		typos = 2
+	}
+
+	if typos == 0 {
+		return 0
+	}
+
+	distance := levenshtein(q, w)
+	if len(w) > len(q) {
+		if d := levenshtein(q, w[:len(q)]); d < distance {
+			distance = d
+		}
+	}
+
+	if distance > typos {
+		return 0
+	}
+
+	return 0.5 - 0.1*float64(distance)
+}
+
+// searchScore scores a document with the best match of every query word. Documents must match all of them.
+func searchScore(query []string, document searchDocument) float64 {
+	var total float64
+	for _, q := range query {
+		var best float64
+		for _, w := range document.words {
+			if score := searchWordScore(q, w); score > best {
+				best = score
+			}
+		}
+
+		if best == 0 {
+			return 0
+		}
+
+		total += best
+	}
+
+	return total
+}
+
+func levenshtein(a, b []rune) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+
+			current[j] = minInt(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+
+		previous, current = current, previous
+	}
+
+	return previous[len(b)]
+}
+
+func minInt(values ...int) int {
+	m := values[0]
+	for _, v := range values[1:] {
+		if v < m {
+			m = v
+		}
+	}
+
+	return m
+}
+
+type searchPage struct {
+	Total   int            `json:"total"`
+	Results []searchResult `json:"results"`
+}
+
+func searchGroup(query []string, documents []searchDocument, page, pageSize int) searchPage {
+	type match struct {
+		document searchDocument
+		score    float64
+	}
+
+	var matches []match
+	for _, document := range documents {
+		if score := searchScore(query, document); score > 0 {
+			matches = append(matches, match{document: document, score: score})
+		}
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].score != matches[j].score {
+			return matches[i].score > matches[j].score
+		}
+
+		if matches[i].document.key != matches[j].document.key {
+			return matches[i].document.key < matches[j].document.key
+		}
+
+		return matches[i].document.result.ID < matches[j].document.result.ID
+	})
+
+	response := searchPage{Total: len(matches), Results: []searchResult{}}
+	for i := (page - 1) * pageSize; i < len(matches) && i < page*pageSize; i++ {
+		response.Results = append(response.Results, matches[i].document.result)
+	}
+
+	return response
+}
+
+// Search looks up subjects, careers, professors and professorships by name, ignoring case and accents and
+// tolerating a few typos. Every group is paginated on its own with the same page.
+func (s *Service) Search(req SearchRequest) ([]byte, error) {
+	query := searchWords(req.Query)
+	if len(query) == 0 {
+		return nil, fmt.Errorf("%w: query must have letters or numbers", ErrInvalidSearch)
+	}
+
+	if req.Page == 0 {
+		req.Page = 1
+	}
+
+	if req.PageSize == 0 {
+		req.PageSize = searchDefaultPageSize
+	}
+
+	if req.Page < 1 || req.PageSize < 1 || req.PageSize > searchMaxPageSize {
+		return nil, fmt.Errorf("%w: page must be positive and page size between 1 and %d", ErrInvalidSearch, searchMaxPageSize)
+	}
+
+	groups, err := s.searchGroups()
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := json.Marshal(struct {
+		Query          string     `json:"query"`
+		Page           int        `json:"page"`
+		PageSize       int        `json:"page_size"`
+		Subjects       searchPage `json:"subjects"`
+		Careers        searchPage `json:"careers"`
+		Professors     searchPage `json:"professors"`
+		Professorships searchPage `json:"professorships"`
+	}{
+		Query:          req.Query,
+		Page:           req.Page,
+		PageSize:       req.PageSize,
+		Subjects:       searchGroup(query, groups.subjects, req.Page, req.PageSize),
+		Careers:        searchGroup(query, groups.careers, req.Page, req.PageSize),
+		Professors:     searchGroup(query, groups.professors, req.Page, req.PageSize),
+		Professorships: searchGroup(query, groups.professorships, req.Page, req.PageSize),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal response: %v", err)
+	}
+
+	return b, nil
+}
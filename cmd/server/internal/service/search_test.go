@@ -0,0 +1,160 @@
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage/memory"
+)
+
+var searchCatalog = storage.SearchCatalog{
+	Subjects: []storage.SearchSubject{
+		{ID: 1, Name: "Análisis Matemático I"},
+		{ID: 2, Name: "Análisis Matemático II"},
+		{ID: 3, Name: "Álgebra"},
+		{ID: 4, Name: "Análisis Numérico"},
+	},
+	Careers:    []storage.Career{{ID: 1, FacultyID: 2, Name: "Licenciatura en Matemática"}},
+	Professors: []storage.Professor{{ID: 1, Name: "José Pérez"}, {ID: 2, Name: "María Núñez"}},
+	Professorships: []storage.SearchProfessorship{
+		{ID: 1, Name: "Cátedra Pérez", CareerID: 1, SubjectID: 1, SubjectName: "Análisis Matemático I"},
+	},
+}
+
+func TestService_Search(t *testing.T) {
+	tt := []struct {
+		name     string
+		req      SearchRequest
+		expected string
+	}{
+		{
+			name:     "accent insensitive",
+			req:      SearchRequest{Query: "analisis matematico"},
+			expected: `{"query":"analisis matematico","page":1,"page_size":10,"subjects":{"total":2,"results":[{"id":1,"name":"Análisis Matemático I"},{"id":2,"name":"Análisis Matemático II"}]},"careers":{"total":0,"results":[]},"professors":{"total":0,"results":[]},"professorships":{"total":0,"results":[]}}`,
+		},
+		{
+			name:     "typos and groups",
+			req:      SearchRequest{Query: "Perez"},
+			expected: `{"query":"Perez","page":1,"page_size":10,"subjects":{"total":0,"results":[]},"careers":{"total":0,"results":[]},"professors":{"total":1,"results":[{"id":1,"name":"José Pérez"}]},"professorships":{"total":1,"results":[{"id":1,"name":"Cátedra Pérez","career_id":1,"subject_id":1,"subject_name":"Análisis Matemático I"}]}}`,
+		},
+		{
+			name:     "fuzzy",
+			req:      SearchRequest{Query: "matematca"},
+			expected: `{"query":"matematca","page":1,"page_size":10,"subjects":{"total":2,"results":[{"id":1,"name":"Análisis Matemático I"},{"id":2,"name":"Análisis Matemático II"}]},"careers":{"total":1,"results":[{"id":1,"name":"Licenciatura en Matemática","faculty_id":2}]},"professors":{"total":0,"results":[]},"professorships":{"total":0,"results":[]}}`,
+		},
+		{
+			name:     "paginated",
+			req:      SearchRequest{Query: "análisis", Page: 2, PageSize: 2},
+			expected: `{"query":"análisis","page":2,"page_size":2,"subjects":{"total":3,"results":[{"id":4,"name":"Análisis Numérico"}]},"careers":{"total":0,"results":[]},"professors":{"total":0,"results":[]},"professorships":{"total":0,"results":[]}}`,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// Given
+			storage_ := storageMock{}
+			storage_.On("GetSearchCatalog").Return(searchCatalog, nil)
+
+			s := NewService(&storage_)
+
+			// When
+			response, err := s.Search(tc.req)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// Then
+			require.JSONEq(t, tc.expected, string(response))
+		})
+	}
+}
+
+func TestService_Search_InvalidSearchError(t *testing.T) {
+	tt := []struct {
+		name string
+		req  SearchRequest
+	}{
+		{name: "no words", req: SearchRequest{Query: " ¿? "}},
+		{name: "negative page", req: SearchRequest{Query: "algebra", Page: -1}},
+		{name: "page size too big", req: SearchRequest{Query: "algebra", PageSize: 51}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// Given
+			s := NewService(&storageMock{})
+
+			// When
+			_, err := s.Search(tc.req)
+
+			// Then
+			require.True(t, errors.Is(err, ErrInvalidSearch))
+		})
+	}
+}
+
+func TestService_Search_StorageError(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("GetSearchCatalog").Return(storage.SearchCatalog{}, errors.New("error"))
+
+	s := NewService(&storage_)
+
+	// When
+	_, err := s.Search(SearchRequest{Query: "algebra"})
+
+	// Then
+	require.EqualError(t, err, "could not get search catalog: error")
+}
+
+func TestService_Search_ReusesIndex(t *testing.T) {
+	// Given
+	now := time.Date(2021, 4, 1, 12, 0, 0, 0, time.UTC)
+	storage_ := storageMock{}
+	storage_.On("GetSearchCatalog").Return(searchCatalog, nil)
+
+	s := NewService(&storage_)
+	s.now = func() time.Time { return now }
+
+	// When
+	_, err := s.Search(SearchRequest{Query: "algebra"})
+	require.NoError(t, err)
+
+	_, err = s.Search(SearchRequest{Query: "perez"})
+	require.NoError(t, err)
+
+	now = now.Add(searchIndexMaxAge)
+	_, err = s.Search(SearchRequest{Query: "perez"})
+	require.NoError(t, err)
+
+	// Then
+	storage_.AssertNumberOfCalls(t, "GetSearchCatalog", 2)
+}
+
+func TestService_Search_RefreshesOnCatalogChanges(t *testing.T) {
+	// Given
+	storage_ := memory.NewStorage()
+	s := NewService(storage_)
+
+	_, err := s.CreateSubject(SubjectRequest{Name: "Álgebra"})
+	require.NoError(t, err)
+
+	response, err := s.Search(SearchRequest{Query: "algebra"})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"query":"algebra","page":1,"page_size":10,"subjects":{"total":1,"results":[{"id":1,"name":"Álgebra"}]},"careers":{"total":0,"results":[]},"professors":{"total":0,"results":[]},"professorships":{"total":0,"results":[]}}`, string(response))
+
+	// When
+	require.NoError(t, s.UpdateSubject("1", SubjectRequest{Name: "Álgebra Lineal"}))
+	_, err = s.CreateSubject(SubjectRequest{Name: "Geometría y Álgebra"})
+	require.NoError(t, err)
+
+	response, err = s.Search(SearchRequest{Query: "algebra"})
+	require.NoError(t, err)
+
+	// Then
+	require.JSONEq(t, `{"query":"algebra","page":1,"page_size":10,"subjects":{"total":2,"results":[{"id":1,"name":"Álgebra Lineal"},{"id":2,"name":"Geometría y Álgebra"}]},"careers":{"total":0,"results":[]},"professors":{"total":0,"results":[]},"professorships":{"total":0,"results":[]}}`, string(response))
+}
@@ -6,17 +6,18 @@ import (
 	"fmt"
 	"sort"
 	"strconv"
+	"time"
 
 	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
 )
 
-const maxCareersPerStudent = 2
-
 var (
 	ErrNotFound              = errors.New("service: resource not found")
 	ErrCareerAlreadyAssigned = errors.New("service: career already assigned")
 	ErrMaxCareerReached      = errors.New("service: student already has maximum careers assigned")
 	ErrStudentAlreadyExist   = errors.New("service: student already exist")
+	ErrSameCareerTransfer    = errors.New("service: career transfer to the same career")
+	ErrPasswordRequired      = errors.New("service: password is required")
 )
 
 var (
@@ -42,25 +43,108 @@ var (
 )
 
 type Storage interface {
-	CreateStudent(name, studentEmail string) error
+	CreateStudent(name, studentEmail, passwordHash string) error
+	GetStudentCredentials(studentEmail string) (storage.Credentials, error)
+	GetStudent(studentID string) (storage.Student, error)
+	UpdateStudent(req storage.UpdateStudentRequest) error
+	ChangeStudentEmail(req storage.ChangeStudentEmailRequest) error
+	GetStudentEmailHistory(studentID string) ([]storage.EmailChange, error)
+	DeleteStudent(studentID string) error
 	GetStudentSubjects(studentEmail, careerID string) ([]storage.StudentSubject, error)
 	GetSubjectDetails(subjectID, careerID string) (storage.SubjectDetails, error)
-	GetProfessorships(subjectID, careerID string) ([]storage.Professorship, error)
+	GetProfessorships(subjectID, careerID, termID string) ([]storage.Professorship, error)
 	GetStudentCareerIDs(studentEmail string) ([]int, error)
 	AssignStudentToCareer(studentEmail, careerID string) error
+	UnassignStudentFromCareer(studentEmail, careerID string) error
+	TransferStudentCareer(req storage.TransferStudentCareerRequest) ([]int, error)
+	AssignStudentToCareerWithCredits(studentEmail, careerID, creditedAt string) ([]storage.StudentEquivalence, error)
 	UpdateStudentSubject(req storage.UpdateStudentSubjectRequest) error
+	GetFaculties() ([]storage.Faculty, error)
+	GetFacultyCareers(facultyID string) ([]storage.Career, error)
+	GetCareer(careerID string) (storage.Career, error)
+	GetProfessorshipsProfessors(subjectID, careerID string) ([]storage.ProfessorshipProfessor, error)
+	GetProfessor(professorID string) (storage.Professor, error)
+	GetProfessorProfessorships(professorID string) ([]storage.ProfessorProfessorship, error)
+	GetMaterials(professorshipID string) ([]storage.Material, error)
+	CreateMaterial(req storage.CreateMaterialRequest) (int, error)
+	UpdateMaterial(req storage.UpdateMaterialRequest) error
+	DeleteMaterial(professorshipID, materialID string) error
+	GetCorrelatives(careerID string) ([]storage.Correlative, error)
+	CreateExam(req storage.CreateExamRequest) (int, error)
+	GetStudentExams(studentEmail, careerID string) ([]storage.Exam, error)
+	GetProfessorshipsSchedules(professorshipIDs []int) ([]storage.ProfessorshipSchedule, error)
+	EnrollStudentInProfessorship(studentEmail, professorshipID string) error
+	DeleteStudentProfessorship(studentEmail, professorshipID string) error
+	GetStudentSchedules(studentEmail, termID string) ([]storage.StudentSchedule, error)
+	SetStudentCalendarToken(studentEmail string, tokenHash *string) error
+	GetStudentEmailByCalendarToken(tokenHash string) (string, error)
+	GetTerm(year, cuatrimestre int) (storage.Term, error)
+	GetCurrentTerm(date string) (storage.Term, error)
+	CreateTerm(req storage.TermRequest) (int, error)
+	UpdateTerm(termID string, req storage.TermRequest) error
+	CreateFaculty(req storage.FacultyRequest) (int, error)
+	UpdateFaculty(facultyID string, req storage.FacultyRequest) error
+	DeleteFaculty(facultyID string) error
+	GetFacultyPolicy(facultyID string) (storage.FacultyPolicy, error)
+	UpdateFacultyPolicy(req storage.FacultyPolicy) error
+	CreateCareer(req storage.CareerRequest) (int, error)
+	UpdateCareer(careerID string, req storage.CareerRequest) error
+	DeleteCareer(careerID string) error
+	CreateSubject(req storage.SubjectRequest) (int, error)
+	UpdateSubject(subjectID string, req storage.SubjectRequest) error
+	DeleteSubject(subjectID string) error
+	CreateCareerSubject(req storage.CareerSubjectRequest) (int, error)
+	UpdateCareerSubject(req storage.CareerSubjectRequest) error
+	DeleteCareerSubject(careerID, subjectID string) error
+	CreateProfessorship(req storage.CreateProfessorshipRequest) (int, error)
+	UpdateProfessorship(req storage.UpdateProfessorshipRequest) error
+	DeleteProfessorship(professorshipID string) error
+	CreateSchedule(req storage.ScheduleRequest) (int, error)
+	UpdateSchedule(req storage.ScheduleRequest) error
+	DeleteSchedule(professorshipID, scheduleID string) error
+	GetCareerPlan(careerID string) (storage.CareerPlan, error)
+	ImportCareerPlan(req storage.ImportCareerPlanRequest) error
+	CreateEquivalence(req storage.EquivalenceRequest) (int, error)
+	DeleteEquivalence(equivalenceID string) error
+	GetEquivalences(careerID string) ([]storage.Equivalence, error)
+	CreateStudentEquivalence(req storage.StudentEquivalenceRequest) (int, error)
+	GetStudentEquivalences(studentEmail, careerID string) ([]storage.StudentEquivalence, error)
+	GetEquivalenceRequests(status string) ([]storage.StudentEquivalence, error)
+	ResolveEquivalenceRequest(req storage.ResolveEquivalenceRequest) error
+	GetSearchCatalog() (storage.SearchCatalog, error)
 }
 
 type Service struct {
-	storage Storage
+	storage  Storage
+	calendar CalendarConfig
+	auth     AuthConfig
+	now      func() time.Time
+	search   *searchIndex
 }
 
-func NewService(storage Storage) *Service {
-	return &Service{storage: storage}
+type Option func(s *Service)
+
+func NewService(storage Storage, opts ...Option) *Service {
+	s := &Service{
+		storage: storage,
+		now:     time.Now,
+		search:  &searchIndex{},
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
 }
 
-func (s *Service) CreateStudent(name, studentEmail string) error {
-	if err := s.storage.CreateStudent(name, studentEmail); err != nil {
+func (s *Service) CreateStudent(name, studentEmail, password string) error {
+	passwordHash, err := hashPassword(password)
+	if err != nil {
+		return err
+	}
+
+	if err := s.storage.CreateStudent(name, studentEmail, passwordHash); err != nil {
 		if errors.Is(err, storage.ErrResourceAlreadyExist) {
 			return ErrStudentAlreadyExist
 		}
@@ -71,47 +155,147 @@ func (s *Service) CreateStudent(name, studentEmail string) error {
 	return nil
 }
 
-func (s *Service) AssignStudentToCareer(studentEmail, careerID string) error {
+// AssignStudentToCareer assigns the career to the student, within the limit of careers of the faculty of the career,
+// and credits the subjects equivalent to the ones it approved in its other careers. It returns the report of the
+// credited subjects.
+func (s *Service) AssignStudentToCareer(studentEmail, careerID string) ([]byte, error) {
 	careersIDs, err := s.storage.GetStudentCareerIDs(studentEmail)
 	if err != nil && !errors.Is(err, storage.ErrNotFound) {
-		return fmt.Errorf("could not get student careers [student_email: %s]: %v", studentEmail, err)
+		return nil, fmt.Errorf("could not get student careers [student_email: %s]: %v", studentEmail, err)
 	}
 
-	if careersIDs != nil {
-		for _, id := range careersIDs {
-			if strconv.Itoa(id) == careerID {
-				return fmt.Errorf("student [student_email: %s] %w", studentEmail, ErrCareerAlreadyAssigned)
-			}
+	for _, id := range careersIDs {
+		if strconv.Itoa(id) == careerID {
+			return nil, fmt.Errorf("student [student_email: %s] %w", studentEmail, ErrCareerAlreadyAssigned)
 		}
+	}
+
+	policy, err := s.careerPolicy(careerID)
+	if err != nil {
+		return nil, fmt.Errorf("could not assign student [student_email: %s] to career: %w", studentEmail, err)
+	}
+
+	if len(careersIDs) >= policy.MaxCareersPerStudent {
+		return nil, fmt.Errorf("student [student_email: %s] %w", studentEmail, ErrMaxCareerReached)
+	}
+
+	// Nothing can be credited in the first career of the student. Otherwise the career is assigned along with the
+	// credits, so a failure leaves the student without the career rather than without its credits.
+	credits := []storage.StudentEquivalence{}
+	if len(careersIDs) == 0 {
+		err = s.storage.AssignStudentToCareer(studentEmail, careerID)
+	} else {
+		credits, err = s.storage.AssignStudentToCareerWithCredits(studentEmail, careerID, s.now().UTC().Format(dateTimeLayout))
+	}
 
-		if len(careersIDs) >= maxCareersPerStudent {
-			return fmt.Errorf("student [student_email: %s] %w", studentEmail, ErrMaxCareerReached)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, fmt.Errorf("could not assign student [student_email: %s] to career: %w", studentEmail, ErrNotFound)
 		}
+
+		return nil, fmt.Errorf("could not assign student [student_email: %s] to career: %v", studentEmail, err)
+	}
+
+	id, _ := strconv.Atoi(careerID)
+	response, err := json.Marshal(struct {
+		CareerID         int                  `json:"career_id"`
+		CreditedSubjects []studentEquivalence `json:"credited_subjects"`
+	}{CareerID: id, CreditedSubjects: newStudentEquivalences(credits)})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal response: %v", err)
 	}
 
-	if err := s.storage.AssignStudentToCareer(studentEmail, careerID); err != nil {
+	return response, nil
+}
+
+// UnassignStudentFromCareer removes the career from the student with its subject statuses, exams, enrollments and
+// equivalences.
+func (s *Service) UnassignStudentFromCareer(studentEmail, careerID string) error {
+	if err := s.storage.UnassignStudentFromCareer(studentEmail, careerID); err != nil {
 		if errors.Is(err, storage.ErrNotFound) {
-			return fmt.Errorf("could not assign student [student_email: %s] to career: %w", studentEmail, ErrNotFound)
+			return fmt.Errorf("could not unassign student [student_email: %s] from career: %w", studentEmail, ErrNotFound)
 		}
 
-		return fmt.Errorf("could not assign student [student_email: %s] to career: %v", studentEmail, err)
+		return fmt.Errorf("could not unassign student [student_email: %s] from career: %v", studentEmail, err)
 	}
 
 	return nil
 }
 
+// TransferStudentCareer switches the student to another career, approving there the approved subjects both careers
+// share. The policy of the new career must allow the careers the student ends up with and the approved subjects.
+func (s *Service) TransferStudentCareer(studentEmail, fromCareerID, toCareerID string) ([]byte, error) {
+	if fromCareerID == toCareerID {
+		return nil, fmt.Errorf("student [student_email: %s] %w", studentEmail, ErrSameCareerTransfer)
+	}
+
+	careersIDs, err := s.storage.GetStudentCareerIDs(studentEmail)
+	if err != nil && !errors.Is(err, storage.ErrNotFound) {
+		return nil, fmt.Errorf("could not get student careers [student_email: %s]: %v", studentEmail, err)
+	}
+
+	policy, err := s.careerPolicy(toCareerID)
+	if err != nil {
+		return nil, fmt.Errorf("could not transfer student [student_email: %s] to career: %w", studentEmail, err)
+	}
+
+	careers := 0
+	for _, id := range careersIDs {
+		if strconv.Itoa(id) != fromCareerID && strconv.Itoa(id) != toCareerID {
+			careers++
+		}
+	}
+
+	if careers+1 > policy.MaxCareersPerStudent {
+		return nil, fmt.Errorf("student [student_email: %s] %w", studentEmail, ErrMaxCareerReached)
+	}
+
+	if !allowsStatus(policy, statusAprobada) {
+		return nil, fmt.Errorf("could not transfer student [student_email: %s] to career: %s: %w", studentEmail, statusAprobada, ErrStatusNotAllowed)
+	}
+
+	subjectIDs, err := s.storage.TransferStudentCareer(storage.TransferStudentCareerRequest{
+		StudentEmail: studentEmail,
+		FromCareerID: fromCareerID,
+		ToCareerID:   toCareerID,
+	})
+
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, fmt.Errorf("could not transfer student [student_email: %s] to career: %w", studentEmail, ErrNotFound)
+		}
+
+		return nil, fmt.Errorf("could not transfer student [student_email: %s] to career: %v", studentEmail, err)
+	}
+
+	toID, _ := strconv.Atoi(toCareerID)
+	response, err := json.Marshal(struct {
+		CareerID              int   `json:"career_id"`
+		TransferredSubjectIDs []int `json:"transferred_subject_ids"`
+	}{CareerID: toID, TransferredSubjectIDs: subjectIDs})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal response: %v", err)
+	}
+
+	return response, nil
+}
+
 func (s *Service) GetStudentSubjects(studentEmail, careerID string) ([]byte, error) {
 	type (
 		studentSubject struct {
-			ID          int     `json:"id"`
-			Name        string  `json:"name"`
-			Type        string  `json:"type"`
-			Status      string  `json:"status"`
-			Description *string `json:"description"`
+			ID           int      `json:"id"`
+			Name         string   `json:"name"`
+			Type         string   `json:"type"`
+			Status       string   `json:"status"`
+			NextStatuses []string `json:"next_statuses"`
+			Description  *string  `json:"description"`
+			Term         *string  `json:"term"`
 		}
 
 		getStudentSubjectsResponse struct {
-			Correlatives map[string][]int          `json:"correlatives"`
+			Correlatives correlativeGraph          `json:"correlatives"`
 			Subjects     map[string]studentSubject `json:"subjects"`
 		}
 	)
@@ -125,31 +309,38 @@ func (s *Service) GetStudentSubjects(studentEmail, careerID string) ([]byte, err
 		return nil, fmt.Errorf("could not get subjects: %v", err)
 	}
 
-	subjects := make(map[string]studentSubject, len(studentSubjects))
-	subjectsCorrelatives := make(map[string][]int, len(studentSubjects))
-	for _, subject := range studentSubjects {
-		from := subject.CorrelativeID
-		to := strconv.Itoa(subject.ID)
+	correlatives, err := s.storage.GetCorrelatives(careerID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get correlatives: %v", err)
+	}
 
-		if subjectsCorrelatives[to] == nil {
-			subjectsCorrelatives[to] = []int{}
-		}
+	policy, err := s.careerPolicy(careerID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get subjects: %w", err)
+	}
 
-		if hasCorrelative(from) {
-			subjectsCorrelatives[to] = append(subjectsCorrelatives[to], subject.CorrelativeID)
+	subjects := make(map[string]studentSubject, len(studentSubjects))
+	subjectIDs := make([]int, 0, len(studentSubjects))
+	for _, subject := range studentSubjects {
+		subjectIDs = append(subjectIDs, subject.ID)
+		subjects[strconv.Itoa(subject.ID)] = studentSubject{
+			ID:           subject.ID,
+			Name:         subject.Name,
+			Type:         subject.Type,
+			Status:       subject.Status,
+			NextStatuses: nextStatuses(policy, subject.Status),
+			Description:  subject.Description,
+			Term:         subject.Term,
 		}
+	}
 
-		subjects[to] = studentSubject{
-			ID:          subject.ID,
-			Name:        subject.Name,
-			Type:        subject.Type,
-			Status:      subject.Status,
-			Description: subject.Description,
-		}
+	graph, err := newCorrelativeGraph(subjectIDs, correlatives)
+	if err != nil {
+		return nil, fmt.Errorf("could not build correlatives: %w", err)
 	}
 
 	response, err := json.Marshal(getStudentSubjectsResponse{
-		Correlatives: subjectsCorrelatives,
+		Correlatives: graph,
 		Subjects:     subjects,
 	})
 
@@ -166,9 +357,32 @@ type UpdateStudentSubjectRequest struct {
 	SubjectID    string
 	Status       string
 	Description  string
+	Term         string
+
+	OverrideCorrelatives bool
+	OverrideStatus       bool
 }
 
+// UpdateStudentSubject sets the status of the subject when the faculty of the career allows it. The correlatives
+// and, for admins, the status transitions can be overridden, but not the statuses the faculty allows.
 func (s *Service) UpdateStudentSubject(req UpdateStudentSubjectRequest) error {
+	policy, err := s.careerPolicy(req.CareerID)
+	if err != nil {
+		return fmt.Errorf("could not update subject: %w", err)
+	}
+
+	if !allowsStatus(policy, req.Status) {
+		return fmt.Errorf("could not update subject: %s: %w", req.Status, ErrStatusNotAllowed)
+	}
+
+	enforceCorrelatives := policy.EnforceCorrelatives && !req.OverrideCorrelatives
+	if !req.OverrideStatus || enforceCorrelatives {
+		err := s.validateStudentSubjectUpdate(policy, req.StudentEmail, req.CareerID, req.SubjectID, req.Status, !req.OverrideStatus, enforceCorrelatives)
+		if err != nil {
+			return fmt.Errorf("could not update subject: %w", err)
+		}
+	}
+
 	storageReq := storage.UpdateStudentSubjectRequest{
 		StudentEmail: req.StudentEmail,
 		CareerID:     req.CareerID,
@@ -180,6 +394,15 @@ func (s *Service) UpdateStudentSubject(req UpdateStudentSubjectRequest) error {
 		storageReq.Description = &req.Description
 	}
 
+	if req.Term != "" {
+		term, err := s.getTerm(req.Term)
+		if err != nil {
+			return fmt.Errorf("could not update subject: %w", err)
+		}
+
+		storageReq.TermID = &term.ID
+	}
+
 	if err := s.storage.UpdateStudentSubject(storageReq); err != nil {
 		if errors.Is(err, storage.ErrNotFound) {
 			return fmt.Errorf("could not update subject: %w: %v", ErrNotFound, err)
@@ -191,10 +414,6 @@ func (s *Service) UpdateStudentSubject(req UpdateStudentSubjectRequest) error {
 	return nil
 }
 
-func hasCorrelative(correlativeID int) bool {
-	return correlativeID != 0
-}
-
 func (s *Service) GetSubjectDetails(subjectID, careerID string) ([]byte, error) {
 	type subjectDetailsResponse struct {
 		ID     int     `json:"id"`
@@ -232,14 +451,31 @@ func (s *Service) GetSubjectDetails(subjectID, careerID string) ([]byte, error)
 	return response, nil
 }
 
-func (s *Service) GetProfessorships(subjectID, careerID string) ([]byte, error) {
-	type schedule struct {
-		Day   string `json:"day"`
-		Start string `json:"start"`
-		End   string `json:"end"`
+// GetProfessorships returns the schedules of the professorships of the subject grouped by name. When the
+// professors are included, professorships are keyed by id instead, so two of them with the same name are not merged,
+// and carry their professors too.
+func (s *Service) GetProfessorships(subjectID, careerID, term string, includeProfessors bool) ([]byte, error) {
+	type (
+		schedule struct {
+			Day   string `json:"day"`
+			Start string `json:"start"`
+			End   string `json:"end"`
+		}
+
+		professorshipInformation struct {
+			ID         int         `json:"id"`
+			Name       string      `json:"name"`
+			Schedules  []schedule  `json:"schedules"`
+			Professors []professor `json:"professors"`
+		}
+	)
+
+	termID, err := s.resolveTermID(term)
+	if err != nil {
+		return nil, err
 	}
 
-	professorships, err := s.storage.GetProfessorships(subjectID, careerID)
+	professorships, err := s.storage.GetProfessorships(subjectID, careerID, termID)
 	if err != nil {
 		if errors.Is(err, storage.ErrNotFound) {
 			return nil, fmt.Errorf("could not get professorships: %w", ErrNotFound)
@@ -248,41 +484,100 @@ func (s *Service) GetProfessorships(subjectID, careerID string) ([]byte, error)
 		return nil, fmt.Errorf("could not get professorships: %v", err)
 	}
 
-	professorshipInformation := make(map[string][]schedule, len(professorships))
+	professorshipsInformation := make(map[int]*professorshipInformation, len(professorships))
+	var professorshipIDs []int
 	for _, professorship := range professorships {
-		day, err := convertDayNumberToDay(professorship.Day)
-		if err != nil {
-			return nil, err
+		if _, exist := professorshipsInformation[professorship.ID]; !exist {
+			professorshipsInformation[professorship.ID] = &professorshipInformation{
+				ID:         professorship.ID,
+				Name:       professorship.Name,
+				Schedules:  []schedule{},
+				Professors: []professor{},
+			}
+
+			professorshipIDs = append(professorshipIDs, professorship.ID)
+		}
+
+		if professorship.Day == nil {
+			continue
 		}
 
-		start, err := trimSecondsFromTime(professorship.Start)
+		day, err := convertDayNumberToDay(*professorship.Day)
 		if err != nil {
 			return nil, err
 		}
 
-		end, err := trimSecondsFromTime(professorship.End)
+		start, err := trimSecondsFromTime(*professorship.Start)
 		if err != nil {
 			return nil, err
 		}
 
-		if _, exist := professorshipInformation[professorship.Name]; !exist {
-			professorshipInformation[professorship.Name] = []schedule{}
+		end, err := trimSecondsFromTime(*professorship.End)
+		if err != nil {
+			return nil, err
 		}
 
-		professorshipInformation[professorship.Name] = append(professorshipInformation[professorship.Name], schedule{
+		information := professorshipsInformation[professorship.ID]
+		information.Schedules = append(information.Schedules, schedule{
 			Day:   day,
 			Start: start,
 			End:   end,
 		})
 	}
 
-	for _, schedule := range professorshipInformation {
-		sort.Slice(schedule, func(i, j int) bool {
-			return isTargetLessThanCandidate(schedule[i].Day, schedule[j].Day)
+	if !includeProfessors {
+		schedulesByName := make(map[string][]schedule, len(professorshipsInformation))
+		for _, id := range professorshipIDs {
+			information := professorshipsInformation[id]
+			if _, exist := schedulesByName[information.Name]; !exist {
+				schedulesByName[information.Name] = []schedule{}
+			}
+
+			schedulesByName[information.Name] = append(schedulesByName[information.Name], information.Schedules...)
+		}
+
+		for _, schedules := range schedulesByName {
+			sort.Slice(schedules, func(i, j int) bool {
+				return isTargetLessThanCandidate(schedules[i].Day, schedules[j].Day)
+			})
+		}
+
+		response, err := json.Marshal(schedulesByName)
+		if err != nil {
+			return nil, fmt.Errorf("could not marshal response: %v", err)
+		}
+
+		return response, nil
+	}
+
+	professors, err := s.storage.GetProfessorshipsProfessors(subjectID, careerID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get professorships professors: %v", err)
+	}
+
+	for _, p := range professors {
+		information, exist := professorshipsInformation[p.ProfessorshipID]
+		if !exist {
+			continue
+		}
+
+		information.Professors = append(information.Professors, professor{
+			ID:   p.ID,
+			Name: p.Name,
+			Role: p.Role,
 		})
 	}
 
-	response, err := json.Marshal(professorshipInformation)
+	for _, information := range professorshipsInformation {
+		schedules := information.Schedules
+		sort.Slice(schedules, func(i, j int) bool {
+			return isTargetLessThanCandidate(schedules[i].Day, schedules[j].Day)
+		})
+
+		sortProfessorsByRole(information.Professors)
+	}
+
+	response, err := json.Marshal(professorshipsInformation)
 	if err != nil {
 		return nil, fmt.Errorf("could not marshal response: %v", err)
 	}
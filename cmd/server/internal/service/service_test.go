@@ -2,20 +2,59 @@ package service
 
 import (
 	"errors"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage/memory"
 )
 
 type storageMock struct {
 	mock.Mock
 }
 
-func (s *storageMock) CreateStudent(name, studentEmail string) error {
-	return s.Called(name, studentEmail).Error(0)
+func (s *storageMock) CreateStudent(name, studentEmail, passwordHash string) error {
+	return s.Called(name, studentEmail, passwordHash).Error(0)
+}
+
+func (s *storageMock) GetStudentCredentials(studentEmail string) (storage.Credentials, error) {
+	args := s.Called(studentEmail)
+	return args.Get(0).(storage.Credentials), args.Error(1)
+}
+
+func (s *storageMock) UnassignStudentFromCareer(studentEmail, careerID string) error {
+	return s.Called(studentEmail, careerID).Error(0)
+}
+
+func (s *storageMock) TransferStudentCareer(req storage.TransferStudentCareerRequest) ([]int, error) {
+	args := s.Called(req)
+	return args.Get(0).([]int), args.Error(1)
+}
+
+func (s *storageMock) GetStudent(studentID string) (storage.Student, error) {
+	args := s.Called(studentID)
+	return args.Get(0).(storage.Student), args.Error(1)
+}
+
+func (s *storageMock) UpdateStudent(req storage.UpdateStudentRequest) error {
+	return s.Called(req).Error(0)
+}
+
+func (s *storageMock) ChangeStudentEmail(req storage.ChangeStudentEmailRequest) error {
+	return s.Called(req).Error(0)
+}
+
+func (s *storageMock) GetStudentEmailHistory(studentID string) ([]storage.EmailChange, error) {
+	args := s.Called(studentID)
+	return args.Get(0).([]storage.EmailChange), args.Error(1)
+}
+
+func (s *storageMock) DeleteStudent(studentID string) error {
+	return s.Called(studentID).Error(0)
 }
 
 func (s *storageMock) GetStudentCareerIDs(studentEmail string) ([]int, error) {
@@ -43,20 +82,268 @@ func (s *storageMock) GetSubjectDetails(subjectID, careerID string) (storage.Sub
 	return args.Get(0).(storage.SubjectDetails), args.Error(1)
 }
 
-func (s *storageMock) GetProfessorships(subjectID, careerID string) ([]storage.Professorship, error) {
-	args := s.Called(subjectID, careerID)
+func (s *storageMock) GetProfessorships(subjectID, careerID, termID string) ([]storage.Professorship, error) {
+	args := s.Called(subjectID, careerID, termID)
 	return args.Get(0).([]storage.Professorship), args.Error(1)
 }
 
+func (s *storageMock) GetFaculties() ([]storage.Faculty, error) {
+	args := s.Called()
+	return args.Get(0).([]storage.Faculty), args.Error(1)
+}
+
+func (s *storageMock) GetFacultyCareers(facultyID string) ([]storage.Career, error) {
+	args := s.Called(facultyID)
+	return args.Get(0).([]storage.Career), args.Error(1)
+}
+
+func (s *storageMock) GetCareer(careerID string) (storage.Career, error) {
+	args := s.Called(careerID)
+	return args.Get(0).(storage.Career), args.Error(1)
+}
+
+func (s *storageMock) GetProfessorshipsProfessors(subjectID, careerID string) ([]storage.ProfessorshipProfessor, error) {
+	args := s.Called(subjectID, careerID)
+	return args.Get(0).([]storage.ProfessorshipProfessor), args.Error(1)
+}
+
+func (s *storageMock) GetProfessor(professorID string) (storage.Professor, error) {
+	args := s.Called(professorID)
+	return args.Get(0).(storage.Professor), args.Error(1)
+}
+
+func (s *storageMock) GetProfessorProfessorships(professorID string) ([]storage.ProfessorProfessorship, error) {
+	args := s.Called(professorID)
+	return args.Get(0).([]storage.ProfessorProfessorship), args.Error(1)
+}
+
+func (s *storageMock) GetMaterials(professorshipID string) ([]storage.Material, error) {
+	args := s.Called(professorshipID)
+	return args.Get(0).([]storage.Material), args.Error(1)
+}
+
+func (s *storageMock) CreateMaterial(req storage.CreateMaterialRequest) (int, error) {
+	args := s.Called(req)
+	return args.Int(0), args.Error(1)
+}
+
+func (s *storageMock) UpdateMaterial(req storage.UpdateMaterialRequest) error {
+	return s.Called(req).Error(0)
+}
+
+func (s *storageMock) DeleteMaterial(professorshipID, materialID string) error {
+	return s.Called(professorshipID, materialID).Error(0)
+}
+
+func (s *storageMock) GetCorrelatives(careerID string) ([]storage.Correlative, error) {
+	args := s.Called(careerID)
+	return args.Get(0).([]storage.Correlative), args.Error(1)
+}
+
+func (s *storageMock) CreateExam(req storage.CreateExamRequest) (int, error) {
+	args := s.Called(req)
+	return args.Int(0), args.Error(1)
+}
+
+func (s *storageMock) GetStudentExams(studentEmail, careerID string) ([]storage.Exam, error) {
+	args := s.Called(studentEmail, careerID)
+	return args.Get(0).([]storage.Exam), args.Error(1)
+}
+
+func (s *storageMock) GetProfessorshipsSchedules(professorshipIDs []int) ([]storage.ProfessorshipSchedule, error) {
+	args := s.Called(professorshipIDs)
+	return args.Get(0).([]storage.ProfessorshipSchedule), args.Error(1)
+}
+
+func (s *storageMock) EnrollStudentInProfessorship(studentEmail, professorshipID string) error {
+	return s.Called(studentEmail, professorshipID).Error(0)
+}
+
+func (s *storageMock) DeleteStudentProfessorship(studentEmail, professorshipID string) error {
+	return s.Called(studentEmail, professorshipID).Error(0)
+}
+
+func (s *storageMock) GetStudentSchedules(studentEmail, termID string) ([]storage.StudentSchedule, error) {
+	args := s.Called(studentEmail, termID)
+	return args.Get(0).([]storage.StudentSchedule), args.Error(1)
+}
+
+func (s *storageMock) SetStudentCalendarToken(studentEmail string, tokenHash *string) error {
+	return s.Called(studentEmail, tokenHash).Error(0)
+}
+
+func (s *storageMock) GetStudentEmailByCalendarToken(tokenHash string) (string, error) {
+	args := s.Called(tokenHash)
+	return args.String(0), args.Error(1)
+}
+
+func (s *storageMock) GetTerm(year, cuatrimestre int) (storage.Term, error) {
+	args := s.Called(year, cuatrimestre)
+	return args.Get(0).(storage.Term), args.Error(1)
+}
+
+func (s *storageMock) GetCurrentTerm(date string) (storage.Term, error) {
+	args := s.Called(date)
+	return args.Get(0).(storage.Term), args.Error(1)
+}
+
+func (s *storageMock) CreateTerm(req storage.TermRequest) (int, error) {
+	args := s.Called(req)
+	return args.Int(0), args.Error(1)
+}
+
+func (s *storageMock) UpdateTerm(termID string, req storage.TermRequest) error {
+	return s.Called(termID, req).Error(0)
+}
+
+func (s *storageMock) CreateFaculty(req storage.FacultyRequest) (int, error) {
+	args := s.Called(req)
+	return args.Int(0), args.Error(1)
+}
+
+func (s *storageMock) UpdateFaculty(facultyID string, req storage.FacultyRequest) error {
+	return s.Called(facultyID, req).Error(0)
+}
+
+func (s *storageMock) DeleteFaculty(facultyID string) error {
+	return s.Called(facultyID).Error(0)
+}
+
+func (s *storageMock) CreateCareer(req storage.CareerRequest) (int, error) {
+	args := s.Called(req)
+	return args.Int(0), args.Error(1)
+}
+
+func (s *storageMock) UpdateCareer(careerID string, req storage.CareerRequest) error {
+	return s.Called(careerID, req).Error(0)
+}
+
+func (s *storageMock) DeleteCareer(careerID string) error {
+	return s.Called(careerID).Error(0)
+}
+
+func (s *storageMock) CreateSubject(req storage.SubjectRequest) (int, error) {
+	args := s.Called(req)
+	return args.Int(0), args.Error(1)
+}
+
+func (s *storageMock) UpdateSubject(subjectID string, req storage.SubjectRequest) error {
+	return s.Called(subjectID, req).Error(0)
+}
+
+func (s *storageMock) DeleteSubject(subjectID string) error {
+	return s.Called(subjectID).Error(0)
+}
+
+func (s *storageMock) CreateCareerSubject(req storage.CareerSubjectRequest) (int, error) {
+	args := s.Called(req)
+	return args.Int(0), args.Error(1)
+}
+
+func (s *storageMock) UpdateCareerSubject(req storage.CareerSubjectRequest) error {
+	return s.Called(req).Error(0)
+}
+
+func (s *storageMock) DeleteCareerSubject(careerID, subjectID string) error {
+	return s.Called(careerID, subjectID).Error(0)
+}
+
+func (s *storageMock) CreateProfessorship(req storage.CreateProfessorshipRequest) (int, error) {
+	args := s.Called(req)
+	return args.Int(0), args.Error(1)
+}
+
+func (s *storageMock) UpdateProfessorship(req storage.UpdateProfessorshipRequest) error {
+	return s.Called(req).Error(0)
+}
+
+func (s *storageMock) DeleteProfessorship(professorshipID string) error {
+	return s.Called(professorshipID).Error(0)
+}
+
+func (s *storageMock) CreateSchedule(req storage.ScheduleRequest) (int, error) {
+	args := s.Called(req)
+	return args.Int(0), args.Error(1)
+}
+
+func (s *storageMock) UpdateSchedule(req storage.ScheduleRequest) error {
+	return s.Called(req).Error(0)
+}
+
+func (s *storageMock) DeleteSchedule(professorshipID, scheduleID string) error {
+	return s.Called(professorshipID, scheduleID).Error(0)
+}
+
+func (s *storageMock) GetCareerPlan(careerID string) (storage.CareerPlan, error) {
+	args := s.Called(careerID)
+	return args.Get(0).(storage.CareerPlan), args.Error(1)
+}
+
+func (s *storageMock) ImportCareerPlan(req storage.ImportCareerPlanRequest) error {
+	return s.Called(req).Error(0)
+}
+
+func (s *storageMock) GetFacultyPolicy(facultyID string) (storage.FacultyPolicy, error) {
+	args := s.Called(facultyID)
+	return args.Get(0).(storage.FacultyPolicy), args.Error(1)
+}
+
+func (s *storageMock) UpdateFacultyPolicy(req storage.FacultyPolicy) error {
+	return s.Called(req).Error(0)
+}
+
+func (s *storageMock) AssignStudentToCareerWithCredits(studentEmail, careerID, creditedAt string) ([]storage.StudentEquivalence, error) {
+	args := s.Called(studentEmail, careerID, creditedAt)
+	return args.Get(0).([]storage.StudentEquivalence), args.Error(1)
+}
+
+func (s *storageMock) CreateEquivalence(req storage.EquivalenceRequest) (int, error) {
+	args := s.Called(req)
+	return args.Int(0), args.Error(1)
+}
+
+func (s *storageMock) DeleteEquivalence(equivalenceID string) error {
+	return s.Called(equivalenceID).Error(0)
+}
+
+func (s *storageMock) GetEquivalences(careerID string) ([]storage.Equivalence, error) {
+	args := s.Called(careerID)
+	return args.Get(0).([]storage.Equivalence), args.Error(1)
+}
+
+func (s *storageMock) CreateStudentEquivalence(req storage.StudentEquivalenceRequest) (int, error) {
+	args := s.Called(req)
+	return args.Int(0), args.Error(1)
+}
+
+func (s *storageMock) GetStudentEquivalences(studentEmail, careerID string) ([]storage.StudentEquivalence, error) {
+	args := s.Called(studentEmail, careerID)
+	return args.Get(0).([]storage.StudentEquivalence), args.Error(1)
+}
+
+func (s *storageMock) GetEquivalenceRequests(status string) ([]storage.StudentEquivalence, error) {
+	args := s.Called(status)
+	return args.Get(0).([]storage.StudentEquivalence), args.Error(1)
+}
+
+func (s *storageMock) ResolveEquivalenceRequest(req storage.ResolveEquivalenceRequest) error {
+	return s.Called(req).Error(0)
+}
+
+func (s *storageMock) GetSearchCatalog() (storage.SearchCatalog, error) {
+	args := s.Called()
+	return args.Get(0).(storage.SearchCatalog), args.Error(1)
+}
+
 func TestService_CreateStudent(t *testing.T) {
 	// Given
 	storage_ := storageMock{}
-	storage_.On("CreateStudent", "example", "example@gmail.com").Return(nil)
+	storage_.On("CreateStudent", "example", "example@gmail.com", mock.AnythingOfType("string")).Return(nil)
 
 	s := NewService(&storage_)
 
 	// When
-	err := s.CreateStudent("example", "example@gmail.com")
+	err := s.CreateStudent("example", "example@gmail.com", "secret-password")
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -68,12 +355,12 @@ func TestService_CreateStudent(t *testing.T) {
 func TestService_CreateStudent_StorageError(t *testing.T) {
 	// Given
 	storage_ := storageMock{}
-	storage_.On("CreateStudent", "example", "example@gmail.com").Return(errors.New("error"))
+	storage_.On("CreateStudent", "example", "example@gmail.com", mock.AnythingOfType("string")).Return(errors.New("error"))
 
 	s := NewService(&storage_)
 
 	// When
-	err := s.CreateStudent("example", "example@gmail.com")
+	err := s.CreateStudent("example", "example@gmail.com", "secret-password")
 	if err == nil {
 		t.Fatal("test must fail")
 	}
@@ -85,12 +372,12 @@ func TestService_CreateStudent_StorageError(t *testing.T) {
 func TestService_CreateStudent_StudentAlreadyExistError(t *testing.T) {
 	// Given
 	storage_ := storageMock{}
-	storage_.On("CreateStudent", "example", "example@gmail.com").Return(storage.ErrResourceAlreadyExist)
+	storage_.On("CreateStudent", "example", "example@gmail.com", mock.AnythingOfType("string")).Return(storage.ErrResourceAlreadyExist)
 
 	s := NewService(&storage_)
 
 	// When
-	err := s.CreateStudent("example", "example@gmail.com")
+	err := s.CreateStudent("example", "example@gmail.com", "secret-password")
 	if err == nil {
 		t.Fatal("test must fail")
 	}
@@ -102,19 +389,20 @@ func TestService_CreateStudent_StudentAlreadyExistError(t *testing.T) {
 func TestService_AssignStudentToCareer(t *testing.T) {
 	// Given
 	storage_ := storageMock{}
+	mockCareerPolicy(&storage_, defaultPolicy)
 	storage_.On("GetStudentCareerIDs", "example@gmail.com").Return([]int{}, nil)
 	storage_.On("AssignStudentToCareer", "example@gmail.com", "1").Return(nil)
 
 	s := NewService(&storage_)
 
 	// When
-	err := s.AssignStudentToCareer("example@gmail.com", "1")
+	response, err := s.AssignStudentToCareer("example@gmail.com", "1")
 	if err != nil {
 		t.Fatal(err)
 	}
 
 	// Then
-	require.Nil(t, err)
+	require.JSONEq(t, `{"career_id":1,"credited_subjects":[]}`, string(response))
 }
 
 func TestService_AssignStudentToCareer_GetStudentCareerIDsError(t *testing.T) {
@@ -125,7 +413,7 @@ func TestService_AssignStudentToCareer_GetStudentCareerIDsError(t *testing.T) {
 	s := NewService(&storage_)
 
 	// When
-	err := s.AssignStudentToCareer("example@gmail.com", "1")
+	_, err := s.AssignStudentToCareer("example@gmail.com", "1")
 	if err == nil {
 		t.Fatal("test must fail")
 	}
@@ -137,19 +425,20 @@ func TestService_AssignStudentToCareer_GetStudentCareerIDsError(t *testing.T) {
 func TestService_AssignStudentToCareer_GetStudentCareerIDsNotFoundError(t *testing.T) {
 	// Given
 	storage_ := storageMock{}
+	mockCareerPolicy(&storage_, defaultPolicy)
 	storage_.On("GetStudentCareerIDs", "example@gmail.com").Return([]int{}, storage.ErrNotFound)
 	storage_.On("AssignStudentToCareer", "example@gmail.com", "1").Return(nil)
 
 	s := NewService(&storage_)
 
 	// When
-	err := s.AssignStudentToCareer("example@gmail.com", "1")
+	response, err := s.AssignStudentToCareer("example@gmail.com", "1")
 	if err != nil {
 		t.Fatal(err)
 	}
 
 	// Then
-	require.Nil(t, err)
+	require.JSONEq(t, `{"career_id":1,"credited_subjects":[]}`, string(response))
 }
 
 func TestService_AssignStudentToCareer_CareerAlreadyAssignedError(t *testing.T) {
@@ -160,7 +449,7 @@ func TestService_AssignStudentToCareer_CareerAlreadyAssignedError(t *testing.T)
 	s := NewService(&storage_)
 
 	// When
-	err := s.AssignStudentToCareer("example@gmail.com", "1")
+	_, err := s.AssignStudentToCareer("example@gmail.com", "1")
 	if err == nil {
 		t.Fatal("test must fail")
 	}
@@ -172,12 +461,13 @@ func TestService_AssignStudentToCareer_CareerAlreadyAssignedError(t *testing.T)
 func TestService_AssignStudentToCareer_MaxCareerReachedError(t *testing.T) {
 	// Given
 	storage_ := storageMock{}
+	mockCareerPolicy(&storage_, defaultPolicy)
 	storage_.On("GetStudentCareerIDs", "example@gmail.com").Return([]int{2, 3, 4}, nil)
 
 	s := NewService(&storage_)
 
 	// When
-	err := s.AssignStudentToCareer("example@gmail.com", "1")
+	_, err := s.AssignStudentToCareer("example@gmail.com", "1")
 	if err == nil {
 		t.Fatal("test must fail")
 	}
@@ -189,13 +479,14 @@ func TestService_AssignStudentToCareer_MaxCareerReachedError(t *testing.T) {
 func TestService_AssignStudentToCareer_StorageAssignStudentToCareerError(t *testing.T) {
 	// Given
 	storage_ := storageMock{}
+	mockCareerPolicy(&storage_, defaultPolicy)
 	storage_.On("GetStudentCareerIDs", "example@gmail.com").Return([]int{}, nil)
 	storage_.On("AssignStudentToCareer", "example@gmail.com", "1").Return(errors.New("error"))
 
 	s := NewService(&storage_)
 
 	// When
-	err := s.AssignStudentToCareer("example@gmail.com", "1")
+	_, err := s.AssignStudentToCareer("example@gmail.com", "1")
 	if err == nil {
 		t.Fatal("test must fail")
 	}
@@ -207,13 +498,14 @@ func TestService_AssignStudentToCareer_StorageAssignStudentToCareerError(t *test
 func TestService_AssignStudentToCareer_StorageAssignStudentToCareerNotFoundError(t *testing.T) {
 	// Given
 	storage_ := storageMock{}
+	mockCareerPolicy(&storage_, defaultPolicy)
 	storage_.On("GetStudentCareerIDs", "example@gmail.com").Return([]int{}, nil)
 	storage_.On("AssignStudentToCareer", "example@gmail.com", "1").Return(storage.ErrNotFound)
 
 	s := NewService(&storage_)
 
 	// When
-	err := s.AssignStudentToCareer("example@gmail.com", "1")
+	_, err := s.AssignStudentToCareer("example@gmail.com", "1")
 	if err == nil {
 		t.Fatal("test must fail")
 	}
@@ -222,6 +514,63 @@ func TestService_AssignStudentToCareer_StorageAssignStudentToCareerNotFoundError
 	require.EqualError(t, err, "could not assign student [student_email: example@gmail.com] to career: service: resource not found")
 }
 
+func TestService_UnassignStudentFromCareer_StorageNotFoundError(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("UnassignStudentFromCareer", "example@gmail.com", "1").Return(storage.ErrNotFound)
+
+	s := NewService(&storage_)
+
+	// When
+	err := s.UnassignStudentFromCareer("example@gmail.com", "1")
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.True(t, errors.Is(err, ErrNotFound))
+}
+
+func TestService_TransferStudentCareer(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("TransferStudentCareer", storage.TransferStudentCareerRequest{
+		StudentEmail: "example@gmail.com",
+		FromCareerID: "1",
+		ToCareerID:   "2",
+	}).Return([]int{3, 5}, nil)
+	storage_.On("GetStudentCareerIDs", "example@gmail.com").Return([]int{1}, nil)
+	mockCareerPolicy(&storage_, defaultPolicy)
+
+	s := NewService(&storage_)
+
+	// When
+	b, err := s.TransferStudentCareer("example@gmail.com", "1", "2")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.JSONEq(t, `{"career_id":2,"transferred_subject_ids":[3,5]}`, string(b))
+}
+
+func TestService_TransferStudentCareer_SameCareerError(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+
+	s := NewService(&storage_)
+
+	// When
+	_, err := s.TransferStudentCareer("example@gmail.com", "1", "1")
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.True(t, errors.Is(err, ErrSameCareerTransfer))
+	storage_.AssertNotCalled(t, "TransferStudentCareer")
+}
+
 func stringToPtr(s string) *string {
 	if s == "" {
 		return nil
@@ -230,9 +579,20 @@ func stringToPtr(s string) *string {
 	return &s
 }
 
+func intToPtr(i int) *int {
+	return &i
+}
+
+func professorship(id int, name string, day int, start, end string) storage.Professorship {
+	return storage.Professorship{ID: id, Day: &day, Name: name, Start: &start, End: &end}
+}
+
 func TestService_UpdateStudentSubject(t *testing.T) {
 	// Given
 	storage_ := storageMock{}
+	mockCareerPolicy(&storage_, defaultPolicy)
+	storage_.On("GetStudentSubjects", "test@gmail.com", "1").Return([]storage.StudentSubject{{ID: 2, Status: "PENDIENTE"}}, nil)
+	storage_.On("GetCorrelatives", "1").Return([]storage.Correlative{}, nil)
 	storage_.On("UpdateStudentSubject", storage.UpdateStudentSubjectRequest{
 		StudentEmail: "test@gmail.com",
 		CareerID:     "1",
@@ -262,6 +622,9 @@ func TestService_UpdateStudentSubject(t *testing.T) {
 func TestService_UpdateStudentSubject_StorageError(t *testing.T) {
 	// Given
 	storage_ := storageMock{}
+	mockCareerPolicy(&storage_, defaultPolicy)
+	storage_.On("GetStudentSubjects", "test@gmail.com", "1").Return([]storage.StudentSubject{{ID: 2, Status: "PENDIENTE"}}, nil)
+	storage_.On("GetCorrelatives", "1").Return([]storage.Correlative{}, nil)
 	storage_.On("UpdateStudentSubject", storage.UpdateStudentSubjectRequest{
 		StudentEmail: "test@gmail.com",
 		CareerID:     "1",
@@ -291,6 +654,9 @@ func TestService_UpdateStudentSubject_StorageError(t *testing.T) {
 func TestService_UpdateStudentSubject_StorageNotFoundError(t *testing.T) {
 	// Given
 	storage_ := storageMock{}
+	mockCareerPolicy(&storage_, defaultPolicy)
+	storage_.On("GetStudentSubjects", "test@gmail.com", "1").Return([]storage.StudentSubject{{ID: 2, Status: "PENDIENTE"}}, nil)
+	storage_.On("GetCorrelatives", "1").Return([]storage.Correlative{}, nil)
 	storage_.On("UpdateStudentSubject", storage.UpdateStudentSubjectRequest{
 		StudentEmail: "test@gmail.com",
 		CareerID:     "1",
@@ -320,6 +686,9 @@ func TestService_UpdateStudentSubject_StorageNotFoundError(t *testing.T) {
 func TestService_UpdateStudentSubject_NilDescription(t *testing.T) {
 	// Given
 	storage_ := storageMock{}
+	mockCareerPolicy(&storage_, defaultPolicy)
+	storage_.On("GetStudentSubjects", "test@gmail.com", "1").Return([]storage.StudentSubject{{ID: 2, Status: "PENDIENTE"}}, nil)
+	storage_.On("GetCorrelatives", "1").Return([]storage.Correlative{}, nil)
 	storage_.On("UpdateStudentSubject", storage.UpdateStudentSubjectRequest{
 		StudentEmail: "test@gmail.com",
 		CareerID:     "1",
@@ -345,27 +714,377 @@ func TestService_UpdateStudentSubject_NilDescription(t *testing.T) {
 	require.Nil(t, err)
 }
 
+func TestService_UpdateStudentSubject_CorrelativesNotMetError(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	mockCareerPolicy(&storage_, defaultPolicy)
+	storage_.On("GetStudentSubjects", "test@gmail.com", "1").Return([]storage.StudentSubject{
+		{ID: 1, Name: "Algebra", Status: "PENDIENTE"},
+		{ID: 2, Name: "Analisis", Status: "PENDIENTE"},
+		{ID: 3, Name: "Algoritmos", Status: "PENDIENTE"},
+	}, nil)
+	storage_.On("GetCorrelatives", "1").Return([]storage.Correlative{
+		{SubjectID: 3, CorrelativeID: 1, Requirement: "APROBADA"},
+		{SubjectID: 3, CorrelativeID: 2, Requirement: "REGULARIZADA"},
+	}, nil)
+
+	s := NewService(&storage_)
+
+	// When
+	err := s.UpdateStudentSubject(UpdateStudentSubjectRequest{
+		StudentEmail: "test@gmail.com",
+		CareerID:     "1",
+		SubjectID:    "3",
+		Status:       "APROBADA",
+	})
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	var cErr *CorrelativesNotMetError
+	require.True(t, errors.As(err, &cErr))
+	require.True(t, errors.Is(err, ErrCorrelativesNotMet))
+	require.Equal(t, []MissingCorrelative{
+		{ID: 1, Name: "Algebra", Requirement: "APROBADA", Status: "PENDIENTE"},
+		{ID: 2, Name: "Analisis", Requirement: "REGULARIZADA", Status: "PENDIENTE"},
+	}, cErr.Missing)
+	require.EqualError(t, err, "could not update subject: service: correlatives not met: Algebra [subject_id: 1] must be APROBADA but is PENDIENTE, Analisis [subject_id: 2] must be REGULARIZADA but is PENDIENTE")
+	storage_.AssertNotCalled(t, "UpdateStudentSubject", mock.Anything)
+}
+
+func TestService_UpdateStudentSubject_CorrelativesMet(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	mockCareerPolicy(&storage_, defaultPolicy)
+	storage_.On("GetStudentSubjects", "test@gmail.com", "1").Return([]storage.StudentSubject{
+		{ID: 1, Name: "Algebra", Status: "APROBADA"},
+		{ID: 2, Name: "Analisis", Status: "REGULARIZADA"},
+		{ID: 3, Name: "Algoritmos", Status: "PENDIENTE"},
+	}, nil)
+	storage_.On("GetCorrelatives", "1").Return([]storage.Correlative{
+		{SubjectID: 3, CorrelativeID: 1, Requirement: "APROBADA"},
+		{SubjectID: 3, CorrelativeID: 2, Requirement: "REGULARIZADA"},
+	}, nil)
+	storage_.On("UpdateStudentSubject", storage.UpdateStudentSubjectRequest{
+		StudentEmail: "test@gmail.com",
+		CareerID:     "1",
+		SubjectID:    "3",
+		Status:       "APROBADA",
+	}).Return(nil)
+
+	s := NewService(&storage_)
+
+	// When
+	err := s.UpdateStudentSubject(UpdateStudentSubjectRequest{
+		StudentEmail: "test@gmail.com",
+		CareerID:     "1",
+		SubjectID:    "3",
+		Status:       "APROBADA",
+	})
+
+	// Then
+	require.NoError(t, err)
+}
+
+func TestService_GetProfessorships_LegacyProfessorship_MemoryStorage(t *testing.T) {
+	// Given
+	storage_ := memory.NewStorage()
+	facultyID, _ := storage_.CreateFaculty(storage.FacultyRequest{Name: "Exactas"})
+	careerID, _ := storage_.CreateCareer(storage.CareerRequest{FacultyID: facultyID, Name: "Sistemas"})
+	career := strconv.Itoa(careerID)
+	subjectType := "OBLIGATORIA"
+	require.NoError(t, storage_.ImportCareerPlan(storage.ImportCareerPlanRequest{
+		CareerID: career,
+		Subjects: []storage.ImportSubject{{Name: "Algebra", Type: &subjectType}},
+	}))
+
+	// The professorship was created before terms existed, so it has none.
+	professorshipID, err := storage_.CreateProfessorship(storage.CreateProfessorshipRequest{CareerID: career, SubjectID: "1", Name: "Cátedra A"})
+	require.NoError(t, err)
+
+	_, err = storage_.CreateSchedule(storage.ScheduleRequest{ProfessorshipID: strconv.Itoa(professorshipID), Day: 1, Start: "08:00:00", End: "10:00:00"})
+	require.NoError(t, err)
+
+	_, err = storage_.CreateTerm(storage.TermRequest{Year: 2021, Cuatrimestre: 1, Start: "2021-03-15", End: "2021-07-10"})
+	require.NoError(t, err)
+
+	s := NewService(storage_)
+	s.now = func() time.Time {
+		return time.Date(2021, time.April, 10, 12, 0, 0, 0, time.UTC)
+	}
+
+	// When
+	current, err := s.GetProfessorships("1", career, "", false)
+	require.NoError(t, err)
+
+	requested, err := s.GetProfessorships("1", career, "2021-1", false)
+	require.NoError(t, err)
+
+	// Then
+	require.Contains(t, string(current), `"Cátedra A"`)
+	require.Equal(t, current, requested)
+}
+
+func TestService_UpdateStudentSubject_MemoryStorage(t *testing.T) {
+	// Given
+	storage_ := memory.NewStorage()
+	facultyID, _ := storage_.CreateFaculty(storage.FacultyRequest{Name: "Exactas"})
+	careerID, _ := storage_.CreateCareer(storage.CareerRequest{FacultyID: facultyID, Name: "Sistemas"})
+	career := strconv.Itoa(careerID)
+	subjectType := "OBLIGATORIA"
+	require.NoError(t, storage_.ImportCareerPlan(storage.ImportCareerPlanRequest{
+		CareerID: career,
+		Subjects: []storage.ImportSubject{
+			{Name: "Algebra", Type: &subjectType},
+			{Name: "Algoritmos", Type: &subjectType, Correlatives: []storage.ImportCorrelative{{SubjectName: "Algebra", Requirement: "APROBADA"}}},
+		},
+	}))
+
+	s := NewService(storage_)
+	require.NoError(t, s.CreateStudent("test", "test@gmail.com", "password"))
+	_, err := s.AssignStudentToCareer("test@gmail.com", career)
+	require.NoError(t, err)
+
+	req := UpdateStudentSubjectRequest{StudentEmail: "test@gmail.com", CareerID: career, SubjectID: "2", Status: "APROBADA"}
+
+	// When
+	correlativesErr := s.UpdateStudentSubject(req)
+	require.NoError(t, s.UpdateStudentSubject(UpdateStudentSubjectRequest{StudentEmail: "test@gmail.com", CareerID: career, SubjectID: "1", Status: "APROBADA"}))
+	err = s.UpdateStudentSubject(req)
+
+	// Then
+	require.True(t, errors.Is(correlativesErr, ErrCorrelativesNotMet))
+	require.NoError(t, err)
+
+	subjects, err := storage_.GetStudentSubjects("test@gmail.com", career)
+	require.NoError(t, err)
+	require.Equal(t, "APROBADA", subjects[0].Status)
+	require.Equal(t, "APROBADA", subjects[1].Status)
+}
+
+func TestService_UpdateStudentSubject_Override(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	mockCareerPolicy(&storage_, defaultPolicy)
+	storage_.On("UpdateStudentSubject", storage.UpdateStudentSubjectRequest{
+		StudentEmail: "test@gmail.com",
+		CareerID:     "1",
+		SubjectID:    "3",
+		Status:       "APROBADA",
+	}).Return(nil)
+
+	s := NewService(&storage_)
+
+	// When
+	err := s.UpdateStudentSubject(UpdateStudentSubjectRequest{
+		StudentEmail:         "test@gmail.com",
+		CareerID:             "1",
+		SubjectID:            "3",
+		Status:               "APROBADA",
+		OverrideCorrelatives: true,
+		OverrideStatus:       true,
+	})
+
+	// Then
+	require.NoError(t, err)
+	storage_.AssertNotCalled(t, "GetStudentSubjects", mock.Anything, mock.Anything)
+}
+
+func TestService_UpdateStudentSubject_OverrideCorrelatives(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	mockCareerPolicy(&storage_, defaultPolicy)
+	storage_.On("GetStudentSubjects", "test@gmail.com", "1").Return([]storage.StudentSubject{
+		{ID: 3, Name: "Algoritmos", Status: "PENDIENTE"},
+	}, nil)
+
+	storage_.On("UpdateStudentSubject", storage.UpdateStudentSubjectRequest{
+		StudentEmail: "test@gmail.com",
+		CareerID:     "1",
+		SubjectID:    "3",
+		Status:       "APROBADA",
+	}).Return(nil)
+
+	s := NewService(&storage_)
+
+	// When
+	err := s.UpdateStudentSubject(UpdateStudentSubjectRequest{
+		StudentEmail:         "test@gmail.com",
+		CareerID:             "1",
+		SubjectID:            "3",
+		Status:               "APROBADA",
+		OverrideCorrelatives: true,
+	})
+
+	// The status transitions are still enforced.
+	transitionErr := s.UpdateStudentSubject(UpdateStudentSubjectRequest{
+		StudentEmail:         "test@gmail.com",
+		CareerID:             "1",
+		SubjectID:            "3",
+		Status:               "REGULARIZADA",
+		OverrideCorrelatives: true,
+	})
+
+	// Then
+	require.NoError(t, err)
+	require.True(t, errors.Is(transitionErr, ErrInvalidStatusTransition))
+	storage_.AssertNotCalled(t, "GetCorrelatives", mock.Anything)
+	storage_.AssertNumberOfCalls(t, "UpdateStudentSubject", 1)
+}
+
+func TestService_UpdateStudentSubject_InvalidStatusTransitionError(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	mockCareerPolicy(&storage_, defaultPolicy)
+	storage_.On("GetStudentSubjects", "test@gmail.com", "1").Return([]storage.StudentSubject{
+		{ID: 3, Name: "Algoritmos", Status: "PENDIENTE"},
+	}, nil)
+
+	s := NewService(&storage_)
+
+	// When
+	err := s.UpdateStudentSubject(UpdateStudentSubjectRequest{
+		StudentEmail: "test@gmail.com",
+		CareerID:     "1",
+		SubjectID:    "3",
+		Status:       "REGULARIZADA",
+	})
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.True(t, errors.Is(err, ErrInvalidStatusTransition))
+	require.EqualError(t, err, "could not update subject: service: invalid status transition: from PENDIENTE to REGULARIZADA, allowed: [CURSANDO, APROBADA]")
+	storage_.AssertNotCalled(t, "GetCorrelatives", mock.Anything)
+	storage_.AssertNotCalled(t, "UpdateStudentSubject", mock.Anything)
+}
+
+func TestService_UpdateStudentSubject_BackToPendienteSkipsCorrelatives(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	mockCareerPolicy(&storage_, defaultPolicy)
+	storage_.On("GetStudentSubjects", "test@gmail.com", "1").Return([]storage.StudentSubject{
+		{ID: 3, Name: "Algoritmos", Status: "CURSANDO"},
+	}, nil)
+	storage_.On("UpdateStudentSubject", storage.UpdateStudentSubjectRequest{
+		StudentEmail: "test@gmail.com",
+		CareerID:     "1",
+		SubjectID:    "3",
+		Status:       "PENDIENTE",
+	}).Return(nil)
+
+	s := NewService(&storage_)
+
+	// When
+	err := s.UpdateStudentSubject(UpdateStudentSubjectRequest{
+		StudentEmail: "test@gmail.com",
+		CareerID:     "1",
+		SubjectID:    "3",
+		Status:       "PENDIENTE",
+	})
+
+	// Then
+	require.NoError(t, err)
+	storage_.AssertNotCalled(t, "GetCorrelatives", mock.Anything)
+}
+
+func TestService_UpdateStudentSubject_SubjectNotFoundError(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	mockCareerPolicy(&storage_, defaultPolicy)
+	storage_.On("GetStudentSubjects", "test@gmail.com", "1").Return([]storage.StudentSubject{
+		{ID: 3, Name: "Algoritmos", Status: "CURSANDO"},
+	}, nil)
+
+	s := NewService(&storage_)
+
+	// When
+	err := s.UpdateStudentSubject(UpdateStudentSubjectRequest{
+		StudentEmail: "test@gmail.com",
+		CareerID:     "1",
+		SubjectID:    "9",
+		Status:       "APROBADA",
+	})
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "could not update subject: could not find subject [subject_id: 9]: service: resource not found")
+}
+
+func TestService_UpdateStudentSubject_GetStudentSubjectsNotFoundError(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	mockCareerPolicy(&storage_, defaultPolicy)
+	storage_.On("GetStudentSubjects", "test@gmail.com", "1").Return([]storage.StudentSubject{}, storage.ErrNotFound)
+
+	s := NewService(&storage_)
+
+	// When
+	err := s.UpdateStudentSubject(UpdateStudentSubjectRequest{
+		StudentEmail: "test@gmail.com",
+		CareerID:     "1",
+		SubjectID:    "3",
+		Status:       "APROBADA",
+	})
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "could not update subject: could not get subjects: service: resource not found")
+}
+
 func TestService_GetStudentSubjects(t *testing.T) {
 	// Given
 	storage_ := storageMock{}
 	storage_.On("GetStudentSubjects", "example@gmail.com", "1").Return([]storage.StudentSubject{
 		{
-			ID:            1,
-			CorrelativeID: 0,
-			Status:        "PENDING",
-			Name:          "Subject 1",
-			Type:          "REQUIRED",
-			Description:   nil,
+			ID:          1,
+			Status:      "PENDIENTE",
+			Name:        "Subject 1",
+			Type:        "REQUIRED",
+			Description: nil,
+		},
+		{
+			ID:          2,
+			Status:      "APROBADA",
+			Name:        "Subject 2",
+			Type:        "REQUIRED",
+			Description: nil,
+			Term:        stringToPtr("2021-1"),
 		},
+	}, nil)
+	storage_.On("GetCorrelatives", "1").Return([]storage.Correlative{
 		{
-			ID:            2,
+			SubjectID:     2,
 			CorrelativeID: 1,
-			Status:        "PENDING",
-			Name:          "Subject 2",
-			Type:          "REQUIRED",
-			Description:   nil,
+			Requirement:   "REGULARIZADA",
 		},
 	}, nil)
+	mockCareerPolicy(&storage_, defaultPolicy)
+
+	s := NewService(&storage_)
+
+	// When
+	subjects, err := s.GetStudentSubjects("example@gmail.com", "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Equal(t, []byte(`{"correlatives":{"1":[],"2":[{"id":1,"requirement":"REGULARIZADA"}]},"subjects":{"1":{"id":1,"name":"Subject 1","type":"REQUIRED","status":"PENDIENTE","next_statuses":["CURSANDO","APROBADA"],"description":null,"term":null},"2":{"id":2,"name":"Subject 2","type":"REQUIRED","status":"APROBADA","next_statuses":[],"description":null,"term":"2021-1"}}}`), subjects)
+}
+
+func TestService_GetStudentSubjects_PolicyStatuses(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("GetStudentSubjects", "example@gmail.com", "1").Return([]storage.StudentSubject{{ID: 1, Status: "PENDIENTE", Name: "Subject 1"}}, nil)
+	storage_.On("GetCorrelatives", "1").Return([]storage.Correlative{}, nil)
+	mockCareerPolicy(&storage_, storage.FacultyPolicy{FacultyID: 1, MaxCareersPerStudent: 2, AllowedStatuses: []string{"PENDIENTE", "APROBADA"}})
 
 	s := NewService(&storage_)
 
@@ -376,7 +1095,48 @@ func TestService_GetStudentSubjects(t *testing.T) {
 	}
 
 	// Then
-	require.Equal(t, []byte(`{"correlatives":{"1":[],"2":[1]},"subjects":{"1":{"id":1,"name":"Subject 1","type":"REQUIRED","status":"PENDING","description":null},"2":{"id":2,"name":"Subject 2","type":"REQUIRED","status":"PENDING","description":null}}}`), subjects)
+	require.Contains(t, string(subjects), `"next_statuses":["APROBADA"]`)
+}
+
+func TestService_GetStudentSubjects_GetCorrelativesError(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("GetStudentSubjects", "example@gmail.com", "1").Return([]storage.StudentSubject{{ID: 1}}, nil)
+	storage_.On("GetCorrelatives", "1").Return([]storage.Correlative{}, errors.New("error"))
+
+	s := NewService(&storage_)
+
+	// When
+	_, err := s.GetStudentSubjects("example@gmail.com", "1")
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "could not get correlatives: error")
+}
+
+func TestService_GetStudentSubjects_CorrelativesCycleError(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("GetStudentSubjects", "example@gmail.com", "1").Return([]storage.StudentSubject{{ID: 1}, {ID: 2}}, nil)
+	storage_.On("GetCorrelatives", "1").Return([]storage.Correlative{
+		{SubjectID: 1, CorrelativeID: 2, Requirement: "APROBADA"},
+		{SubjectID: 2, CorrelativeID: 1, Requirement: "APROBADA"},
+	}, nil)
+	mockCareerPolicy(&storage_, defaultPolicy)
+
+	s := NewService(&storage_)
+
+	// When
+	_, err := s.GetStudentSubjects("example@gmail.com", "1")
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.True(t, errors.Is(err, ErrCorrelativesCycle))
+	require.EqualError(t, err, "could not build correlatives: service: correlatives have a cycle [subject_id: 1]")
 }
 
 func TestService_GetStudentSubjects_StorageError(t *testing.T) {
@@ -475,48 +1235,171 @@ func TestService_GetSubjectDetails_StorageNotFoundError(t *testing.T) {
 func TestService_GetProfessorships(t *testing.T) {
 	// Given
 	storage_ := storageMock{}
-	storage_.On("GetProfessorships", "1", "2").Return([]storage.Professorship{
+	storage_.On("GetCurrentTerm", mock.Anything).Return(storage.Term{}, storage.ErrNotFound)
+	storage_.On("GetProfessorships", "1", "2", "").Return([]storage.Professorship{
 		{
-			Day:   1,
+			ID:    1,
+			Day:   intToPtr(1),
 			Name:  "CATEDRA 1",
-			Start: "17:00:00",
-			End:   "21:00:00",
+			Start: stringToPtr("17:00:00"),
+			End:   stringToPtr("21:00:00"),
 		},
 		{
-			Day:   1,
+			ID:    2,
+			Day:   intToPtr(2),
 			Name:  "CATEDRA 2",
-			Start: "9:00:00",
-			End:   "12:00:00",
+			Start: stringToPtr("9:00:00"),
+			End:   stringToPtr("12:00:00"),
 		},
 		{
-			Day:   2,
+			ID:    2,
+			Day:   intToPtr(1),
 			Name:  "CATEDRA 2",
-			Start: "9:00:00",
-			End:   "12:00:00",
+			Start: stringToPtr("9:00:00"),
+			End:   stringToPtr("12:00:00"),
+		},
+		{
+			ID:   3,
+			Name: "CATEDRA 3",
 		},
 	}, nil)
 
 	s := NewService(&storage_)
 
 	// When
-	professorships, err := s.GetProfessorships("1", "2")
+	professorships, err := s.GetProfessorships("1", "2", "", false)
 	if err != nil {
 		t.Fatal(err)
 	}
 
 	// Then
-	require.Equal(t, []byte(`{"CATEDRA 1":[{"day":"Lunes","start":"17:00","end":"21:00"}],"CATEDRA 2":[{"day":"Lunes","start":"9:00","end":"12:00"},{"day":"Martes","start":"9:00","end":"12:00"}]}`), professorships)
+	require.Equal(t, []byte(`{"CATEDRA 1":[{"day":"Lunes","start":"17:00","end":"21:00"}],"CATEDRA 2":[{"day":"Lunes","start":"9:00","end":"12:00"},{"day":"Martes","start":"9:00","end":"12:00"}],"CATEDRA 3":[]}`), professorships)
+	storage_.AssertNotCalled(t, "GetProfessorshipsProfessors", mock.Anything, mock.Anything)
+}
+
+func TestService_GetProfessorships_IncludeProfessors_SameName(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("GetCurrentTerm", mock.Anything).Return(storage.Term{}, storage.ErrNotFound)
+	storage_.On("GetProfessorships", "1", "2", "").Return([]storage.Professorship{
+		professorship(1, "CATEDRA", 1, "17:00:00", "21:00:00"),
+		professorship(2, "CATEDRA", 3, "9:00:00", "12:00:00"),
+	}, nil)
+	storage_.On("GetProfessorshipsProfessors", "1", "2").Return([]storage.ProfessorshipProfessor{
+		{ProfessorshipID: 1, ID: 3, Name: "Titular", Role: "TITULAR"},
+		{ProfessorshipID: 2, ID: 4, Name: "Adjunto", Role: "ADJUNTO"},
+	}, nil)
+
+	s := NewService(&storage_)
+
+	// When
+	professorships, err := s.GetProfessorships("1", "2", "", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.JSONEq(t, `{
+		"1":{"id":1,"name":"CATEDRA","schedules":[{"day":"Lunes","start":"17:00","end":"21:00"}],"professors":[{"id":3,"name":"Titular","role":"TITULAR"}]},
+		"2":{"id":2,"name":"CATEDRA","schedules":[{"day":"Miércoles","start":"9:00","end":"12:00"}],"professors":[{"id":4,"name":"Adjunto","role":"ADJUNTO"}]}
+	}`, string(professorships))
+}
+
+func TestService_GetProfessorships_IncludeProfessors(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("GetCurrentTerm", mock.Anything).Return(storage.Term{}, storage.ErrNotFound)
+	storage_.On("GetProfessorships", "1", "2", "").Return([]storage.Professorship{
+		{
+			ID:    1,
+			Day:   intToPtr(1),
+			Name:  "CATEDRA 1",
+			Start: stringToPtr("17:00:00"),
+			End:   stringToPtr("21:00:00"),
+		},
+		{
+			ID:    2,
+			Day:   intToPtr(2),
+			Name:  "CATEDRA 2",
+			Start: stringToPtr("9:00:00"),
+			End:   stringToPtr("12:00:00"),
+		},
+		{
+			ID:    2,
+			Day:   intToPtr(1),
+			Name:  "CATEDRA 2",
+			Start: stringToPtr("9:00:00"),
+			End:   stringToPtr("12:00:00"),
+		},
+	}, nil)
+	storage_.On("GetProfessorshipsProfessors", "1", "2").Return([]storage.ProfessorshipProfessor{
+		{
+			ProfessorshipID: 2,
+			ID:              3,
+			Name:            "Ayudante",
+			Role:            "AYUDANTE",
+		},
+		{
+			ProfessorshipID: 2,
+			ID:              4,
+			Name:            "Titular",
+			Role:            "TITULAR",
+		},
+		{
+			ProfessorshipID: 2,
+			ID:              5,
+			Name:            "JTP",
+			Role:            "jtp",
+		},
+		{
+			ProfessorshipID: 9,
+			ID:              6,
+			Name:            "Another",
+			Role:            "TITULAR",
+		},
+	}, nil)
+
+	s := NewService(&storage_)
+
+	// When
+	professorships, err := s.GetProfessorships("1", "2", "", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Equal(t, []byte(`{"1":{"id":1,"name":"CATEDRA 1","schedules":[{"day":"Lunes","start":"17:00","end":"21:00"}],"professors":[]},"2":{"id":2,"name":"CATEDRA 2","schedules":[{"day":"Lunes","start":"9:00","end":"12:00"},{"day":"Martes","start":"9:00","end":"12:00"}],"professors":[{"id":4,"name":"Titular","role":"TITULAR"},{"id":5,"name":"JTP","role":"jtp"},{"id":3,"name":"Ayudante","role":"AYUDANTE"}]}}`), professorships)
+}
+
+func TestService_GetProfessorships_GetProfessorshipsProfessorsError(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("GetCurrentTerm", mock.Anything).Return(storage.Term{}, storage.ErrNotFound)
+	storage_.On("GetProfessorships", "1", "2", "").Return([]storage.Professorship{}, nil)
+	storage_.On("GetProfessorshipsProfessors", "1", "2").Return([]storage.ProfessorshipProfessor{}, errors.New("error"))
+
+	s := NewService(&storage_)
+
+	// When
+	_, err := s.GetProfessorships("1", "2", "", true)
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "could not get professorships professors: error")
 }
 
 func TestService_GetProfessorships_StorageError(t *testing.T) {
 	// Given
 	storage_ := storageMock{}
-	storage_.On("GetProfessorships", "1", "2").Return([]storage.Professorship{}, errors.New("error"))
+	storage_.On("GetCurrentTerm", mock.Anything).Return(storage.Term{}, storage.ErrNotFound)
+	storage_.On("GetProfessorships", "1", "2", "").Return([]storage.Professorship{}, errors.New("error"))
 
 	s := NewService(&storage_)
 
 	// When
-	_, err := s.GetProfessorships("1", "2")
+	_, err := s.GetProfessorships("1", "2", "", false)
 	if err == nil {
 		t.Fatal("test must fail")
 	}
@@ -528,12 +1411,13 @@ func TestService_GetProfessorships_StorageError(t *testing.T) {
 func TestService_GetProfessorships_StorageNotFoundError(t *testing.T) {
 	// Given
 	storage_ := storageMock{}
-	storage_.On("GetProfessorships", "1", "2").Return([]storage.Professorship{}, storage.ErrNotFound)
+	storage_.On("GetCurrentTerm", mock.Anything).Return(storage.Term{}, storage.ErrNotFound)
+	storage_.On("GetProfessorships", "1", "2", "").Return([]storage.Professorship{}, storage.ErrNotFound)
 
 	s := NewService(&storage_)
 
 	// When
-	_, err := s.GetProfessorships("1", "2")
+	_, err := s.GetProfessorships("1", "2", "", false)
 	if err == nil {
 		t.Fatal("test must fail")
 	}
@@ -545,19 +1429,21 @@ func TestService_GetProfessorships_StorageNotFoundError(t *testing.T) {
 func TestService_GetProfessorships_DayNotExist(t *testing.T) {
 	// Given
 	storage_ := storageMock{}
-	storage_.On("GetProfessorships", "1", "2").Return([]storage.Professorship{
+	storage_.On("GetCurrentTerm", mock.Anything).Return(storage.Term{}, storage.ErrNotFound)
+	storage_.On("GetProfessorships", "1", "2", "").Return([]storage.Professorship{
 		{
-			Day:   9,
+			Day:   intToPtr(9),
 			Name:  "CATEDRA 1",
-			Start: "17:00:00",
-			End:   "21:00:00",
+			Start: stringToPtr("17:00:00"),
+			End:   stringToPtr("21:00:00"),
 		},
 	}, nil)
+	storage_.On("GetProfessorshipsProfessors", "1", "2").Return([]storage.ProfessorshipProfessor{}, nil)
 
 	s := NewService(&storage_)
 
 	// When
-	_, err := s.GetProfessorships("1", "2")
+	_, err := s.GetProfessorships("1", "2", "", false)
 	if err == nil {
 		t.Fatal("test must fail")
 	}
@@ -588,19 +1474,21 @@ func TestService_GetProfessorships_InvalidTimeLength(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			// Given
 			storage_ := storageMock{}
-			storage_.On("GetProfessorships", "1", "2").Return([]storage.Professorship{
+			storage_.On("GetCurrentTerm", mock.Anything).Return(storage.Term{}, storage.ErrNotFound)
+			storage_.On("GetProfessorships", "1", "2", "").Return([]storage.Professorship{
 				{
-					Day:   1,
+					Day:   intToPtr(1),
 					Name:  "CATEDRA 1",
-					Start: tc.start,
-					End:   tc.end,
+					Start: stringToPtr(tc.start),
+					End:   stringToPtr(tc.end),
 				},
 			}, nil)
+			storage_.On("GetProfessorshipsProfessors", "1", "2").Return([]storage.ProfessorshipProfessor{}, nil)
 
 			s := NewService(&storage_)
 
 			// When
-			_, err := s.GetProfessorships("1", "2")
+			_, err := s.GetProfessorships("1", "2", "", false)
 			if err == nil {
 				t.Fatal("test must fail")
 			}
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
+)
+
+const (
+	statusPendiente    = "PENDIENTE"
+	statusCursando     = "CURSANDO"
+	statusRegularizada = "REGULARIZADA"
+	statusAprobada     = "APROBADA"
+	statusLibre        = "LIBRE"
+	statusRecursando   = "RECURSANDO"
+)
+
+var ErrInvalidStatusTransition = errors.New("service: invalid status transition")
+
+// statusTransitions lists, for every status, the statuses a subject can move to. A subject can be
+// approved straight from PENDIENTE or LIBRE because final exams can be taken without attending the course.
+var statusTransitions = map[string][]string{
+	statusPendiente:    {statusCursando, statusAprobada},
+	statusCursando:     {statusPendiente, statusRegularizada, statusAprobada, statusLibre},
+	statusRegularizada: {statusAprobada, statusLibre},
+	statusLibre:        {statusRecursando, statusAprobada},
+	statusRecursando:   {statusRegularizada, statusAprobada, statusLibre},
+	statusAprobada:     {},
+}
+
+type InvalidStatusTransitionError struct {
+	From    string
+	To      string
+	Allowed []string
+}
+
+func (e *InvalidStatusTransitionError) Error() string {
+	return fmt.Sprintf("%v: from %s to %s, allowed: [%s]", ErrInvalidStatusTransition, e.From, e.To, strings.Join(e.Allowed, ", "))
+}
+
+func (e *InvalidStatusTransitionError) Is(target error) bool {
+	return target == ErrInvalidStatusTransition
+}
+
+// nextStatuses lists the statuses a subject can move to that the faculty of its career allows.
+func nextStatuses(policy storage.FacultyPolicy, status string) []string {
+	next := []string{}
+	for _, s := range statusTransitions[status] {
+		if allowsStatus(policy, s) {
+			next = append(next, s)
+		}
+	}
+
+	return next
+}
+
+func checkStatusTransition(policy storage.FacultyPolicy, from, to string) error {
+	if from == to {
+		return nil
+	}
+
+	next := nextStatuses(policy, from)
+	for _, status := range next {
+		if status == to {
+			return nil
+		}
+	}
+
+	return &InvalidStatusTransitionError{
+		From:    from,
+		To:      to,
+		Allowed: next,
+	}
+}
@@ -0,0 +1,51 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
+)
+
+func TestCheckStatusTransition(t *testing.T) {
+	tt := []struct {
+		from  string
+		to    string
+		valid bool
+	}{
+		{from: "PENDIENTE", to: "PENDIENTE", valid: true},
+		{from: "PENDIENTE", to: "CURSANDO", valid: true},
+		{from: "PENDIENTE", to: "APROBADA", valid: true},
+		{from: "PENDIENTE", to: "REGULARIZADA", valid: false},
+		{from: "CURSANDO", to: "REGULARIZADA", valid: true},
+		{from: "CURSANDO", to: "LIBRE", valid: true},
+		{from: "REGULARIZADA", to: "APROBADA", valid: true},
+		{from: "REGULARIZADA", to: "CURSANDO", valid: false},
+		{from: "LIBRE", to: "RECURSANDO", valid: true},
+		{from: "RECURSANDO", to: "REGULARIZADA", valid: true},
+		{from: "APROBADA", to: "PENDIENTE", valid: false},
+		{from: "UNKNOWN", to: "APROBADA", valid: false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.from+" to "+tc.to, func(t *testing.T) {
+			// When
+			err := checkStatusTransition(defaultPolicy, tc.from, tc.to)
+
+			// Then
+			require.Equal(t, tc.valid, err == nil)
+		})
+	}
+}
+
+func TestCheckStatusTransition_PolicyError(t *testing.T) {
+	// Given
+	policy := storage.FacultyPolicy{FacultyID: 1, MaxCareersPerStudent: 2, AllowedStatuses: []string{"PENDIENTE", "CURSANDO", "APROBADA"}}
+
+	// When
+	err := checkStatusTransition(policy, "CURSANDO", "REGULARIZADA")
+
+	// Then
+	require.EqualError(t, err, "service: invalid status transition: from CURSANDO to REGULARIZADA, allowed: [PENDIENTE, APROBADA]")
+}
@@ -0,0 +1,410 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+var ErrResourceInUse = errors.New("storage: resource in use")
+
+type violation int
+
+const (
+	noViolation violation = iota
+	duplicateEntry
+	referencedRow
+	missingReference
+)
+
+// constraintError is a constraint violation of a driver without numbered errors such as MySQL's.
+type constraintError struct {
+	violation violation
+	err       error
+}
+
+func (e *constraintError) Error() string {
+	return e.err.Error()
+}
+
+func (e *constraintError) Unwrap() error {
+	return e.err
+}
+
+func constraintViolation(err error) violation {
+	var ce *constraintError
+	if errors.As(err, &ce) {
+		return ce.violation
+	}
+
+	var me *mysql.MySQLError
+	if !errors.As(err, &me) {
+		return noViolation
+	}
+
+	switch me.Number {
+	case 1062: // Duplicate entry
+		return duplicateEntry
+	case 1451: // Cannot delete or update a parent row
+		return referencedRow
+	case 1452: // Cannot add or update a child row
+		return missingReference
+	default:
+		return noViolation
+	}
+}
+
+// translateWriteError maps the constraint violations raised by catalog writes to storage errors.
+func translateWriteError(resource string, err error) error {
+	switch constraintViolation(err) {
+	case duplicateEntry:
+		return fmt.Errorf("%s already exist: %w", resource, ErrResourceAlreadyExist)
+	case referencedRow:
+		return fmt.Errorf("%s is referenced by other resources: %w", resource, ErrResourceInUse)
+	case missingReference:
+		return fmt.Errorf("could not find %s reference: %w", resource, ErrNotFound)
+	default:
+		return err
+	}
+}
+
+func (s *Storage) createResource(resource, query string, args ...interface{}) (int, error) {
+	result, err := s.db.Exec(query, args...)
+	if err != nil {
+		return 0, translateWriteError(resource, err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(id), nil
+}
+
+// updateResource checks the resource exists before updating it, since MySQL reports 0 affected rows when
+// the new values are equal to the stored ones.
+func (s *Storage) updateResource(resource, checkQuery string, checkArgs []interface{}, updateQuery string, args ...interface{}) error {
+	tx, err := s.db.Beginx()
+	if err != nil {
+		return fmt.Errorf("could not begin tx: %v", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var results int
+	if err = tx.Get(&results, checkQuery, checkArgs...); err != nil {
+		return err
+	}
+
+	if results == 0 {
+		err = fmt.Errorf("could not find %s: %w", resource, ErrNotFound)
+		return err
+	}
+
+	if _, err = tx.Exec(updateQuery, args...); err != nil {
+		return translateWriteError(resource, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("could not commit tx: %v", err)
+	}
+
+	return nil
+}
+
+func (s *Storage) deleteResource(resource, query string, args ...interface{}) error {
+	result, err := s.db.Exec(query, args...)
+	if err != nil {
+		return translateWriteError(resource, err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return fmt.Errorf("could not find %s: %w", resource, ErrNotFound)
+	}
+
+	return nil
+}
+
+type FacultyRequest struct {
+	Name string
+	URI  *string
+}
+
+const (
+	createFaculty     = `INSERT INTO faculty (name, uri) VALUES (?, ?);`
+	checkFacultyExist = `SELECT COUNT(1) FROM faculty WHERE id = ?;`
+	updateFaculty     = `UPDATE faculty SET name = ?, uri = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?;`
+	deleteFaculty     = `DELETE FROM faculty WHERE id = ?;`
+)
+
+func (s *Storage) CreateFaculty(req FacultyRequest) (int, error) {
+	return s.createResource("faculty", createFaculty, req.Name, req.URI)
+}
+
+func (s *Storage) UpdateFaculty(facultyID string, req FacultyRequest) error {
+	return s.updateResource("faculty", checkFacultyExist, []interface{}{facultyID}, updateFaculty, req.Name, req.URI, facultyID)
+}
+
+func (s *Storage) DeleteFaculty(facultyID string) error {
+	return s.deleteResource("faculty", deleteFaculty, facultyID)
+}
+
+type CareerRequest struct {
+	FacultyID int
+	Name      string
+	URI       *string
+}
+
+const (
+	createCareer     = `INSERT INTO career (faculty_id, name, uri) VALUES (?, ?, ?);`
+	checkCareerExist = `SELECT COUNT(1) FROM career WHERE id = ?;`
+	updateCareer     = `UPDATE career SET faculty_id = ?, name = ?, uri = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?;`
+	deleteCareer     = `DELETE FROM career WHERE id = ?;`
+)
+
+func (s *Storage) CreateCareer(req CareerRequest) (int, error) {
+	return s.createResource("career", createCareer, req.FacultyID, req.Name, req.URI)
+}
+
+func (s *Storage) UpdateCareer(careerID string, req CareerRequest) error {
+	return s.updateResource("career", checkCareerExist, []interface{}{careerID}, updateCareer, req.FacultyID, req.Name, req.URI, careerID)
+}
+
+func (s *Storage) DeleteCareer(careerID string) error {
+	return s.deleteResource("career", deleteCareer, careerID)
+}
+
+type SubjectRequest struct {
+	Name string
+	URI  *string
+	Meet *string
+}
+
+const (
+	createSubject     = `INSERT INTO subject (name, uri, meet) VALUES (?, ?, ?);`
+	checkSubjectExist = `SELECT COUNT(1) FROM subject WHERE id = ?;`
+	updateSubject     = `UPDATE subject SET name = ?, uri = ?, meet = ? WHERE id = ?;`
+	deleteSubject     = `DELETE FROM subject WHERE id = ?;`
+)
+
+func (s *Storage) CreateSubject(req SubjectRequest) (int, error) {
+	return s.createResource("subject", createSubject, req.Name, req.URI, req.Meet)
+}
+
+func (s *Storage) UpdateSubject(subjectID string, req SubjectRequest) error {
+	return s.updateResource("subject", checkSubjectExist, []interface{}{subjectID}, updateSubject, req.Name, req.URI, req.Meet, subjectID)
+}
+
+func (s *Storage) DeleteSubject(subjectID string) error {
+	return s.deleteResource("subject", deleteSubject, subjectID)
+}
+
+type CareerSubjectRequest struct {
+	CareerID  string
+	SubjectID string
+	Hours     *int
+	Type      *string
+	Points    *int
+}
+
+const (
+	checkCareerSubjectExist = `SELECT COUNT(1) FROM career_subject WHERE career_id = ? AND subject_id = ?;`
+	createCareerSubject     = `INSERT INTO career_subject (career_id, subject_id, hours, type, points) VALUES (?, ?, ?, ?, ?);`
+	updateCareerSubject     = `UPDATE career_subject SET hours = ?, type = ?, points = ? WHERE career_id = ? AND subject_id = ?;`
+	deleteCareerSubject     = `DELETE FROM career_subject WHERE career_id = ? AND subject_id = ?;`
+)
+
+func (s *Storage) CreateCareerSubject(req CareerSubjectRequest) (int, error) {
+	var results int
+	if err := s.db.Get(&results, checkCareerSubjectExist, req.CareerID, req.SubjectID); err != nil {
+		return 0, err
+	}
+
+	if results > 0 {
+		return 0, fmt.Errorf("career subject already exist: %w", ErrResourceAlreadyExist)
+	}
+
+	return s.createResource("career subject", createCareerSubject, req.CareerID, req.SubjectID, req.Hours, req.Type, req.Points)
+}
+
+func (s *Storage) UpdateCareerSubject(req CareerSubjectRequest) error {
+	return s.updateResource(
+		"career subject",
+		checkCareerSubjectExist, []interface{}{req.CareerID, req.SubjectID},
+		updateCareerSubject, req.Hours, req.Type, req.Points, req.CareerID, req.SubjectID,
+	)
+}
+
+func (s *Storage) DeleteCareerSubject(careerID, subjectID string) error {
+	return s.deleteResource("career subject", deleteCareerSubject, careerID, subjectID)
+}
+
+type CreateProfessorshipRequest struct {
+	CareerID  string
+	SubjectID string
+	Name      string
+	TermID    *int
+}
+
+const createProfessorship = `INSERT INTO professorship (career_subject_id, term_id, name) VALUES (?, ?, ?);`
+
+func (s *Storage) CreateProfessorship(req CreateProfessorshipRequest) (int, error) {
+	tx, err := s.db.Beginx()
+	if err != nil {
+		return 0, fmt.Errorf("could not begin tx: %v", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	careerSubjectID, err := s.getCareerSubjectByIDs(tx, req.CareerID, req.SubjectID)
+	if err != nil {
+		return 0, err
+	}
+
+	result, err := tx.Exec(createProfessorship, careerSubjectID, req.TermID, req.Name)
+	if err != nil {
+		return 0, translateWriteError("professorship", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, fmt.Errorf("could not commit tx: %v", err)
+	}
+
+	return int(id), nil
+}
+
+type UpdateProfessorshipRequest struct {
+	ProfessorshipID string
+	Name            string
+	TermID          *int
+}
+
+const (
+	updateProfessorship            = `UPDATE professorship SET name = ?, term_id = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?;`
+	updateProfessorshipEnrollments = `UPDATE student_professorship SET term_id = ? WHERE professorship_id = ?;`
+)
+
+// UpdateProfessorship moves the enrollments along with the term, so a student enrolled in another professorship of
+// the subject for the new term makes it fail.
+func (s *Storage) UpdateProfessorship(req UpdateProfessorshipRequest) error {
+	tx, err := s.db.Beginx()
+	if err != nil {
+		return fmt.Errorf("could not begin tx: %v", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	if err = s.checkProfessorshipExist(tx, req.ProfessorshipID); err != nil {
+		return err
+	}
+
+	if _, err = tx.Exec(updateProfessorship, req.Name, req.TermID, req.ProfessorshipID); err != nil {
+		err = translateWriteError("professorship", err)
+		return err
+	}
+
+	if _, err = tx.Exec(updateProfessorshipEnrollments, req.TermID, req.ProfessorshipID); err != nil {
+		err = translateWriteError("professorship", err)
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("could not commit tx: %v", err)
+	}
+
+	return nil
+}
+
+const (
+	deleteProfessorshipSchedules  = `DELETE FROM schedule WHERE professorship_id = ?;`
+	deleteProfessorshipMaterials  = `DELETE FROM material WHERE professorship_id = ?;`
+	deleteProfessorshipProfessors = `DELETE FROM professorship_professor WHERE professorship_id = ?;`
+	deleteProfessorship           = `DELETE FROM professorship WHERE id = ?;`
+)
+
+// DeleteProfessorship removes the professorship with its schedules, materials and professors. Professorships with
+// enrolled students can't be deleted.
+func (s *Storage) DeleteProfessorship(professorshipID string) error {
+	tx, err := s.db.Beginx()
+	if err != nil {
+		return fmt.Errorf("could not begin tx: %v", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	if err = s.checkProfessorshipExist(tx, professorshipID); err != nil {
+		return err
+	}
+
+	for _, query := range []string{deleteProfessorshipSchedules, deleteProfessorshipMaterials, deleteProfessorshipProfessors, deleteProfessorship} {
+		if _, err = tx.Exec(query, professorshipID); err != nil {
+			err = translateWriteError("professorship", err)
+			return err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("could not commit tx: %v", err)
+	}
+
+	return nil
+}
+
+type ScheduleRequest struct {
+	ProfessorshipID string
+	ScheduleID      string
+	Day             int
+	Start           string
+	End             string
+}
+
+const (
+	createSchedule     = `INSERT INTO schedule (professorship_id, day, start, end) VALUES (?, ?, ?, ?);`
+	checkScheduleExist = `SELECT COUNT(1) FROM schedule WHERE id = ? AND professorship_id = ?;`
+	updateSchedule     = `UPDATE schedule SET day = ?, start = ?, end = ? WHERE id = ?;`
+	deleteSchedule     = `DELETE FROM schedule WHERE id = ? AND professorship_id = ?;`
+)
+
+func (s *Storage) CreateSchedule(req ScheduleRequest) (int, error) {
+	return s.createResource("professorship", createSchedule, req.ProfessorshipID, req.Day, req.Start, req.End)
+}
+
+func (s *Storage) UpdateSchedule(req ScheduleRequest) error {
+	return s.updateResource(
+		"schedule",
+		checkScheduleExist, []interface{}{req.ScheduleID, req.ProfessorshipID},
+		updateSchedule, req.Day, req.Start, req.End, req.ScheduleID,
+	)
+}
+
+func (s *Storage) DeleteSchedule(professorshipID, scheduleID string) error {
+	return s.deleteResource("schedule", deleteSchedule, scheduleID, professorshipID)
+}
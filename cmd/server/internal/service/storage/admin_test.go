@@ -0,0 +1,296 @@
+package storage
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorage_CreateFaculty(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	mock.ExpectExec(`INSERT INTO faculty (name, uri) VALUES (?, ?);`).
+		WithArgs("Exactas", nil).
+		WillReturnResult(sqlmock.NewResult(3, 1))
+
+	// When
+	id, err := storage_.CreateFaculty(FacultyRequest{Name: "Exactas"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Equal(t, 3, id)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStorage_CreateCareer_FacultyNotFoundError(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	mock.ExpectExec(`INSERT INTO career (faculty_id, name, uri) VALUES (?, ?, ?);`).
+		WithArgs(1, "Sistemas", nil).
+		WillReturnError(&mysql.MySQLError{Number: 1452})
+
+	// When
+	_, err = storage_.CreateCareer(CareerRequest{FacultyID: 1, Name: "Sistemas"})
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.True(t, errors.Is(err, ErrNotFound))
+	require.EqualError(t, err, "could not find career reference: storage: resource not found")
+}
+
+func TestStorage_UpdateSubject(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	meet := "https://meet.google.com/abc"
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT COUNT(1) FROM subject WHERE id = ?;`).
+		WithArgs("1").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectExec(`UPDATE subject SET name = ?, uri = ?, meet = ? WHERE id = ?;`).
+		WithArgs("Análisis I", nil, meet, "1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	// When
+	err = storage_.UpdateSubject("1", SubjectRequest{Name: "Análisis I", Meet: &meet})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStorage_UpdateFaculty_NotFoundError(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT COUNT(1) FROM faculty WHERE id = ?;`).
+		WithArgs("1").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectRollback()
+
+	// When
+	err = storage_.UpdateFaculty("1", FacultyRequest{Name: "Exactas"})
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "could not find faculty: storage: resource not found")
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStorage_DeleteFaculty_Errors(t *testing.T) {
+	tt := []struct {
+		name          string
+		result        error
+		affected      int64
+		expectedErr   error
+		expectedError string
+	}{
+		{
+			name:          "faculty has careers",
+			result:        &mysql.MySQLError{Number: 1451},
+			expectedErr:   ErrResourceInUse,
+			expectedError: "faculty is referenced by other resources: storage: resource in use",
+		},
+		{
+			name:          "faculty not found",
+			expectedErr:   ErrNotFound,
+			expectedError: "could not find faculty: storage: resource not found",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// Given
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			if err != nil {
+				t.Fatalf("could not start sql mock: %v", err)
+			}
+
+			defer db.Close()
+
+			storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+			exec := mock.ExpectExec(`DELETE FROM faculty WHERE id = ?;`).WithArgs("1")
+			if tc.result != nil {
+				exec.WillReturnError(tc.result)
+			} else {
+				exec.WillReturnResult(sqlmock.NewResult(0, tc.affected))
+			}
+
+			// When
+			err = storage_.DeleteFaculty("1")
+			if err == nil {
+				t.Fatal("test must fail")
+			}
+
+			// Then
+			require.True(t, errors.Is(err, tc.expectedErr))
+			require.EqualError(t, err, tc.expectedError)
+		})
+	}
+}
+
+func TestStorage_CreateCareerSubject_AlreadyExistError(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	mock.ExpectQuery(`SELECT COUNT(1) FROM career_subject WHERE career_id = ? AND subject_id = ?;`).
+		WithArgs("1", "2").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+	// When
+	_, err = storage_.CreateCareerSubject(CareerSubjectRequest{CareerID: "1", SubjectID: "2"})
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "career subject already exist: storage: resource already exist")
+}
+
+func TestStorage_CreateProfessorship(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	termID := 4
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT id FROM career_subject WHERE career_id = ? AND subject_id = ? ORDER BY id LIMIT 1`).
+		WithArgs("1", "2").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+	mock.ExpectExec(`INSERT INTO professorship (career_subject_id, term_id, name) VALUES (?, ?, ?);`).
+		WithArgs(7, termID, "Cátedra A").
+		WillReturnResult(sqlmock.NewResult(9, 1))
+	mock.ExpectCommit()
+
+	// When
+	id, err := storage_.CreateProfessorship(CreateProfessorshipRequest{CareerID: "1", SubjectID: "2", Name: "Cátedra A", TermID: &termID})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Equal(t, 9, id)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStorage_DeleteProfessorship_EnrolledStudentsError(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT COUNT(1) FROM professorship WHERE id = ?;`).
+		WithArgs("9").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectExec(`DELETE FROM schedule WHERE professorship_id = ?;`).WithArgs("9").WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(`DELETE FROM material WHERE professorship_id = ?;`).WithArgs("9").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`DELETE FROM professorship_professor WHERE professorship_id = ?;`).WithArgs("9").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`DELETE FROM professorship WHERE id = ?;`).WithArgs("9").WillReturnError(&mysql.MySQLError{Number: 1451})
+	mock.ExpectRollback()
+
+	// When
+	err = storage_.DeleteProfessorship("9")
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.True(t, errors.Is(err, ErrResourceInUse))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStorage_UpdateSchedule(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT COUNT(1) FROM schedule WHERE id = ? AND professorship_id = ?;`).
+		WithArgs("3", "9").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectExec(`UPDATE schedule SET day = ?, start = ?, end = ? WHERE id = ?;`).
+		WithArgs(1, "08:00", "10:00", "3").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	// When
+	err = storage_.UpdateSchedule(ScheduleRequest{ProfessorshipID: "9", ScheduleID: "3", Day: 1, Start: "08:00", End: "10:00"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.NoError(t, mock.ExpectationsWereMet())
+}
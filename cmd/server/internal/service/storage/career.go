@@ -0,0 +1,188 @@
+package storage
+
+import (
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// unassignStudentFromCareerQueries remove the assignment after every row of the student that references the career.
+// The subjects approved in other careers through equivalences credited from it go back to PENDIENTE.
+var unassignStudentFromCareerQueries = []string{
+	`DELETE FROM exam WHERE student_id = ? AND career_subject_id IN (SELECT id FROM career_subject WHERE career_id = ?);`,
+	`DELETE FROM student_professorship WHERE student_id = ? AND professorship_id IN (SELECT p.id FROM professorship p INNER JOIN career_subject cs ON cs.id = p.career_subject_id WHERE cs.career_id = ?);`,
+	`DELETE FROM student_career_subject WHERE student_id = ? AND career_subject_id IN (SELECT id FROM career_subject WHERE career_id = ?);`,
+	`DELETE FROM student_equivalence WHERE student_id = ? AND career_subject_id IN (SELECT id FROM career_subject WHERE career_id = ?);`,
+	`UPDATE student_career_subject
+SET status  = 'PENDIENTE',
+    term_id = NULL
+WHERE student_id = ?
+  AND status = 'APROBADA'
+  AND EXISTS(SELECT 1
+             FROM student_equivalence se
+                      INNER JOIN career_subject cs ON cs.id = se.source_career_subject_id
+             WHERE se.student_id = student_career_subject.student_id
+               AND se.career_subject_id = student_career_subject.career_subject_id
+               AND se.status = 'APROBADA'
+               AND cs.career_id = ?);`,
+	`DELETE FROM student_equivalence WHERE student_id = ? AND source_career_subject_id IN (SELECT id FROM career_subject WHERE career_id = ?);`,
+	`DELETE FROM student_career WHERE student_id = ? AND career_id = ?;`,
+}
+
+// UnassignStudentFromCareer removes the career from the student with its subject statuses, exams, enrollments and
+// equivalences, both the ones credited in the career and the ones credited from it, revoking the subjects the latter
+// approved.
+func (s *Storage) UnassignStudentFromCareer(studentEmail, careerID string) (err error) {
+	tx, err := s.db.Beginx()
+	if err != nil {
+		return fmt.Errorf("could not begin tx: %v", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	studentID, err := s.getStudentByEmail(tx, studentEmail)
+	if err != nil {
+		return err
+	}
+
+	if err = s.checkStudentAssignedToCareer(tx, studentID, careerID); err != nil {
+		return err
+	}
+
+	if err = s.unassignStudentFromCareer(tx, studentID, careerID); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("could not commit tx: %v", err)
+	}
+
+	return nil
+}
+
+func (s *Storage) unassignStudentFromCareer(tx *sqlx.Tx, studentID int, careerID string) error {
+	for _, query := range unassignStudentFromCareerQueries {
+		if _, err := tx.Exec(query, studentID, careerID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type TransferStudentCareerRequest struct {
+	StudentEmail string
+	FromCareerID string
+	ToCareerID   string
+}
+
+const (
+	// getTransferableSubjects matches the approved subjects of the student in a career with the career subjects of
+	// another one by subject, keeping the lowest career subject id as getCareerSubjectByIDs does.
+	getTransferableSubjects = `SELECT source.subject_id, scs.career_subject_id source_id, MIN(target.id) target_id, scs.description, scs.term_id
+FROM student_career_subject scs
+         INNER JOIN career_subject source ON source.id = scs.career_subject_id
+         INNER JOIN career_subject target ON target.subject_id = source.subject_id AND target.career_id = ?
+WHERE scs.student_id = ?
+  AND source.career_id = ?
+  AND scs.status = 'APROBADA'
+GROUP BY source.subject_id, scs.career_subject_id, scs.description, scs.term_id
+ORDER BY source.subject_id, scs.career_subject_id;`
+	moveStudentExams             = `UPDATE exam SET career_subject_id = ? WHERE student_id = ? AND career_subject_id = ?;`
+	moveStudentEquivalenceSource = `UPDATE student_equivalence SET source_career_subject_id = ? WHERE student_id = ? AND source_career_subject_id = ?;`
+)
+
+// TransferStudentCareer switches the student from a career to another one, assigning it when needed. The approved
+// subjects that both careers share are approved in the new career with their exams and the equivalences credited
+// from them, and the rest of the old career is removed as in UnassignStudentFromCareer. It returns the ids of the
+// transferred subjects.
+func (s *Storage) TransferStudentCareer(req TransferStudentCareerRequest) (subjectIDs []int, err error) {
+	tx, err := s.db.Beginx()
+	if err != nil {
+		return nil, fmt.Errorf("could not begin tx: %v", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	studentID, err := s.getStudentByEmail(tx, req.StudentEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.checkStudentAssignedToCareer(tx, studentID, req.FromCareerID); err != nil {
+		return nil, err
+	}
+
+	var careerCount int
+	if err = tx.Get(&careerCount, findCareerWithID, req.ToCareerID); err != nil {
+		return nil, err
+	}
+
+	if careerCount == 0 {
+		err = fmt.Errorf("could not find career: %w", ErrNotFound)
+		return nil, err
+	}
+
+	var assigned int
+	if err = tx.Get(&assigned, checkStudentAssignedToCareer, studentID, req.ToCareerID); err != nil {
+		return nil, err
+	}
+
+	if assigned == 0 {
+		if _, err = tx.Exec(createStudentWithCareer, studentID, req.ToCareerID); err != nil {
+			return nil, err
+		}
+	}
+
+	var subjects []struct {
+		SubjectID   int     `db:"subject_id"`
+		SourceID    int     `db:"source_id"`
+		TargetID    int     `db:"target_id"`
+		Description *string `db:"description"`
+		TermID      *int    `db:"term_id"`
+	}
+
+	if err = tx.Select(&subjects, getTransferableSubjects, req.ToCareerID, studentID, req.FromCareerID); err != nil {
+		return nil, err
+	}
+
+	subjectIDs = []int{}
+	for _, subject := range subjects {
+		if _, err = tx.Exec(moveStudentExams, subject.TargetID, studentID, subject.SourceID); err != nil {
+			return nil, err
+		}
+
+		if _, err = tx.Exec(moveStudentEquivalenceSource, subject.TargetID, studentID, subject.SourceID); err != nil {
+			return nil, err
+		}
+
+		// Legacy plans may repeat a subject in the old career, it's approved once in the new one.
+		if len(subjectIDs) > 0 && subjectIDs[len(subjectIDs)-1] == subject.SubjectID {
+			continue
+		}
+
+		if err = s.updateStudentSubject(tx, studentID, subject.TargetID, "APROBADA", subject.Description, subject.TermID); err != nil {
+			return nil, err
+		}
+
+		subjectIDs = append(subjectIDs, subject.SubjectID)
+	}
+
+	if err = s.unassignStudentFromCareer(tx, studentID, req.FromCareerID); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("could not commit tx: %v", err)
+	}
+
+	return subjectIDs, nil
+}
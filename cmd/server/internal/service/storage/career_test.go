@@ -0,0 +1,134 @@
+package storage
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorage_UnassignStudentFromCareer(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT id FROM student WHERE email = ?;`).
+		WithArgs("example@gmail.com").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectQuery(`SELECT COUNT(1) FROM student_career WHERE student_id = ? AND career_id = ?`).
+		WithArgs(1, "2").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	for _, query := range unassignStudentFromCareerQueries {
+		mock.ExpectExec(query).
+			WithArgs(1, "2").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+	mock.ExpectCommit()
+
+	// When
+	err = storage_.UnassignStudentFromCareer("example@gmail.com", "2")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStorage_UnassignStudentFromCareer_NotAssignedError(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT id FROM student WHERE email = ?;`).
+		WithArgs("example@gmail.com").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectQuery(`SELECT COUNT(1) FROM student_career WHERE student_id = ? AND career_id = ?`).
+		WithArgs(1, "2").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectRollback()
+
+	// When
+	err = storage_.UnassignStudentFromCareer("example@gmail.com", "2")
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.True(t, errors.Is(err, ErrNotFound))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStorage_TransferStudentCareer(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT id FROM student WHERE email = ?;`).
+		WithArgs("example@gmail.com").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectQuery(`SELECT COUNT(1) FROM student_career WHERE student_id = ? AND career_id = ?`).
+		WithArgs(1, "2").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery(`SELECT COUNT(1) FROM career WHERE id = ?;`).
+		WithArgs("3").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery(`SELECT COUNT(1) FROM student_career WHERE student_id = ? AND career_id = ?`).
+		WithArgs(1, "3").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectExec(`INSERT INTO student_career (student_id, career_id) VALUES (?, ?);`).
+		WithArgs(1, "3").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(getTransferableSubjects).
+		WithArgs("3", 1, "2").
+		WillReturnRows(sqlmock.NewRows([]string{"subject_id", "source_id", "target_id", "description", "term_id"}).
+			AddRow(4, 10, 20, nil, 5))
+	mock.ExpectExec(`UPDATE exam SET career_subject_id = ? WHERE student_id = ? AND career_subject_id = ?;`).
+		WithArgs(20, 1, 10).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE student_equivalence SET source_career_subject_id = ? WHERE student_id = ? AND source_career_subject_id = ?;`).
+		WithArgs(20, 1, 10).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(updateStudentSubject).
+		WithArgs(1, 20, "APROBADA", nil, 5, "APROBADA", nil, 5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	for _, query := range unassignStudentFromCareerQueries {
+		mock.ExpectExec(query).
+			WithArgs(1, "2").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+	mock.ExpectCommit()
+
+	// When
+	subjectIDs, err := storage_.TransferStudentCareer(TransferStudentCareerRequest{StudentEmail: "example@gmail.com", FromCareerID: "2", ToCareerID: "3"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Equal(t, []int{4}, subjectIDs)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,108 @@
+package storage
+
+import (
+	"database/sql"
+	"errors"
+)
+
+type Faculty struct {
+	ID   int
+	Name string
+	URI  *string
+}
+
+const getFaculties = `SELECT id, name, uri FROM faculty ORDER BY id;`
+
+func (s *Storage) GetFaculties() ([]Faculty, error) {
+	var faculties []struct {
+		ID   int     `db:"id"`
+		Name string  `db:"name"`
+		URI  *string `db:"uri"`
+	}
+
+	if err := s.db.Select(&faculties, getFaculties); err != nil {
+		return nil, err
+	}
+
+	if faculties == nil {
+		return nil, ErrNotFound
+	}
+
+	response := make([]Faculty, 0, len(faculties))
+	for _, faculty := range faculties {
+		response = append(response, Faculty{
+			ID:   faculty.ID,
+			Name: faculty.Name,
+			URI:  faculty.URI,
+		})
+	}
+
+	return response, nil
+}
+
+type Career struct {
+	ID        int
+	FacultyID int
+	Name      string
+	URI       *string
+}
+
+type career struct {
+	ID        int     `db:"id"`
+	FacultyID int     `db:"faculty_id"`
+	Name      string  `db:"name"`
+	URI       *string `db:"uri"`
+}
+
+const getFacultyCareers = `SELECT id, faculty_id, name, uri FROM career WHERE faculty_id = :facultyID ORDER BY id;`
+
+func (s *Storage) GetFacultyCareers(facultyID string) ([]Career, error) {
+	stmt, err := s.db.PrepareNamed(getFacultyCareers)
+	if err != nil {
+		return nil, err
+	}
+
+	defer stmt.Close()
+
+	params := map[string]interface{}{"facultyID": facultyID}
+
+	var careers []career
+	if err := stmt.Select(&careers, params); err != nil {
+		return nil, err
+	}
+
+	if careers == nil {
+		return nil, ErrNotFound
+	}
+
+	response := make([]Career, 0, len(careers))
+	for _, c := range careers {
+		response = append(response, Career(c))
+	}
+
+	return response, nil
+}
+
+const getCareer = `SELECT id, faculty_id, name, uri FROM career WHERE id = :careerID;`
+
+func (s *Storage) GetCareer(careerID string) (Career, error) {
+	stmt, err := s.db.PrepareNamed(getCareer)
+	if err != nil {
+		return Career{}, err
+	}
+
+	defer stmt.Close()
+
+	params := map[string]interface{}{"careerID": careerID}
+
+	var c career
+	if err := stmt.Get(&c, params); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Career{}, ErrNotFound
+		}
+
+		return Career{}, err
+	}
+
+	return Career(c), nil
+}
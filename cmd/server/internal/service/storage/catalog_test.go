@@ -0,0 +1,263 @@
+package storage
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorage_GetFaculties(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	q := `SELECT id, name, uri FROM faculty ORDER BY id;`
+	mock.ExpectQuery(q).
+		WillReturnError(nil).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"id", "name", "uri"}).
+				AddRow(1, "Ciencias Exactas y Naturales", "https://exactas.uba.ar"))
+
+	// When
+	faculties, err := storage_.GetFaculties()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Len(t, faculties, 1)
+	require.Equal(t, 1, faculties[0].ID)
+	require.Equal(t, "Ciencias Exactas y Naturales", faculties[0].Name)
+	require.Equal(t, "https://exactas.uba.ar", *faculties[0].URI)
+}
+
+func TestStorage_GetFaculties_ExecuteStmtError(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	q := `SELECT id, name, uri FROM faculty ORDER BY id;`
+	mock.ExpectQuery(q).WillReturnError(errors.New("error"))
+
+	// When
+	_, err = storage_.GetFaculties()
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "error")
+}
+
+func TestStorage_GetFaculties_NotFoundError(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	q := `SELECT id, name, uri FROM faculty ORDER BY id;`
+	mock.ExpectQuery(q).
+		WillReturnError(nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "uri"}))
+
+	// When
+	_, err = storage_.GetFaculties()
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "storage: resource not found")
+}
+
+func TestStorage_GetFacultyCareers(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	q := `SELECT id, faculty_id, name, uri FROM career WHERE faculty_id = ? ORDER BY id;`
+	mock.ExpectPrepare(q).WillReturnError(nil)
+	mock.ExpectQuery(q).
+		WithArgs("1").
+		WillReturnError(nil).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"id", "faculty_id", "name", "uri"}).
+				AddRow(2, 1, "Ciencias de la Computación", nil))
+
+	// When
+	careers, err := storage_.GetFacultyCareers("1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Len(t, careers, 1)
+	require.Equal(t, 2, careers[0].ID)
+	require.Equal(t, 1, careers[0].FacultyID)
+	require.Equal(t, "Ciencias de la Computación", careers[0].Name)
+	require.Nil(t, careers[0].URI)
+}
+
+func TestStorage_GetFacultyCareers_PrepareStmtError(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	q := `SELECT id, faculty_id, name, uri FROM career WHERE faculty_id = ? ORDER BY id;`
+	mock.ExpectPrepare(q).WillReturnError(errors.New("error"))
+
+	// When
+	_, err = storage_.GetFacultyCareers("1")
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "error")
+}
+
+func TestStorage_GetFacultyCareers_NotFoundError(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	q := `SELECT id, faculty_id, name, uri FROM career WHERE faculty_id = ? ORDER BY id;`
+	mock.ExpectPrepare(q).WillReturnError(nil)
+	mock.ExpectQuery(q).
+		WithArgs("1").
+		WillReturnError(nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "faculty_id", "name", "uri"}))
+
+	// When
+	_, err = storage_.GetFacultyCareers("1")
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "storage: resource not found")
+}
+
+func TestStorage_GetCareer(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	q := `SELECT id, faculty_id, name, uri FROM career WHERE id = ?;`
+	mock.ExpectPrepare(q).WillReturnError(nil)
+	mock.ExpectQuery(q).
+		WithArgs("2").
+		WillReturnError(nil).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"id", "faculty_id", "name", "uri"}).
+				AddRow(2, 1, "Ciencias de la Computación", "https://dc.uba.ar"))
+
+	// When
+	career, err := storage_.GetCareer("2")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Equal(t, 2, career.ID)
+	require.Equal(t, 1, career.FacultyID)
+	require.Equal(t, "Ciencias de la Computación", career.Name)
+	require.Equal(t, "https://dc.uba.ar", *career.URI)
+}
+
+func TestStorage_GetCareer_NotFoundError(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	q := `SELECT id, faculty_id, name, uri FROM career WHERE id = ?;`
+	mock.ExpectPrepare(q).WillReturnError(nil)
+	mock.ExpectQuery(q).
+		WithArgs("2").
+		WillReturnError(nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "faculty_id", "name", "uri"}))
+
+	// When
+	_, err = storage_.GetCareer("2")
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "storage: resource not found")
+}
+
+func TestStorage_GetCareer_ExecuteStmtError(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	q := `SELECT id, faculty_id, name, uri FROM career WHERE id = ?;`
+	mock.ExpectPrepare(q).WillReturnError(nil)
+	mock.ExpectQuery(q).WillReturnError(errors.New("error"))
+
+	// When
+	_, err = storage_.GetCareer("2")
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "error")
+}
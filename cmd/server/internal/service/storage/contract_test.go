@@ -0,0 +1,77 @@
+package storage_test
+
+import (
+	"math"
+	"os"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service"
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage/storagetest"
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/migrations"
+)
+
+type sqlFixtures struct {
+	db *sqlx.DB
+}
+
+func (f sqlFixtures) CreateProfessor(name string) (int, error) {
+	return f.insert(`INSERT INTO professor (name) VALUES (?);`, name)
+}
+
+func (f sqlFixtures) AssignProfessor(professorshipID, professorID int, role string) error {
+	_, err := f.db.Exec(`INSERT INTO professorship_professor (professorship_id, professor_id, role) VALUES (?, ?, ?);`, professorshipID, professorID, role)
+	return err
+}
+
+func (f sqlFixtures) insert(query string, args ...interface{}) (int, error) {
+	result, err := f.db.Exec(query, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := result.LastInsertId()
+	return int(id), err
+}
+
+func TestStorage_Contract_SQLite(t *testing.T) {
+	storagetest.Run(t, func(t *testing.T) (service.Storage, storagetest.Fixtures) {
+		db := newSQLiteDB(t)
+		return storage.NewStorage(db), sqlFixtures{db: db}
+	})
+}
+
+// TestStorage_Contract_MySQL runs against the database of TEST_MYSQL_DATABASE_CONFIG, which is dropped and
+// migrated again before each test.
+func TestStorage_Contract_MySQL(t *testing.T) {
+	source := os.Getenv("TEST_MYSQL_DATABASE_CONFIG")
+	if source == "" {
+		t.Skip("TEST_MYSQL_DATABASE_CONFIG is not set")
+	}
+
+	db, err := storage.Open(storage.DriverMySQL, source)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	migrator, err := migrations.NewMigrator(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	storagetest.Run(t, func(t *testing.T) (service.Storage, storagetest.Fixtures) {
+		if _, err := migrator.Down(math.MaxInt32); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := migrator.Up(); err != nil {
+			t.Fatal(err)
+		}
+
+		return storage.NewStorage(db), sqlFixtures{db: db}
+	})
+}
@@ -0,0 +1,122 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/jmoiron/sqlx"
+)
+
+var ErrCorrelativesCycle = errors.New("storage: correlatives have a cycle")
+
+type Correlative struct {
+	SubjectID     int
+	CorrelativeID int
+	Requirement   string
+}
+
+const getCorrelatives = `SELECT cs.subject_id, ccs.subject_id correlative_id, csc.requirement
+FROM career_subject_correlative csc
+         INNER JOIN career_subject cs ON cs.id = csc.career_subject_id
+         INNER JOIN career_subject ccs ON ccs.id = csc.correlative_career_subject_id
+WHERE cs.career_id = :careerID
+ORDER BY cs.subject_id, ccs.subject_id;`
+
+func (s *Storage) GetCorrelatives(careerID string) ([]Correlative, error) {
+	stmt, err := s.db.PrepareNamed(getCorrelatives)
+	if err != nil {
+		return nil, err
+	}
+
+	defer stmt.Close()
+
+	return selectCorrelatives(stmt, careerID)
+}
+
+func getCareerCorrelatives(tx *sqlx.Tx, careerID string) ([]Correlative, error) {
+	stmt, err := tx.PrepareNamed(getCorrelatives)
+	if err != nil {
+		return nil, err
+	}
+
+	defer stmt.Close()
+
+	return selectCorrelatives(stmt, careerID)
+}
+
+func selectCorrelatives(stmt *sqlx.NamedStmt, careerID string) ([]Correlative, error) {
+	params := map[string]interface{}{"careerID": careerID}
+
+	var correlatives []struct {
+		SubjectID     int    `db:"subject_id"`
+		CorrelativeID int    `db:"correlative_id"`
+		Requirement   string `db:"requirement"`
+	}
+
+	if err := stmt.Select(&correlatives, params); err != nil {
+		return nil, err
+	}
+
+	response := make([]Correlative, 0, len(correlatives))
+	for _, correlative := range correlatives {
+		response = append(response, Correlative{
+			SubjectID:     correlative.SubjectID,
+			CorrelativeID: correlative.CorrelativeID,
+			Requirement:   correlative.Requirement,
+		})
+	}
+
+	return response, nil
+}
+
+// CheckCorrelatives checks the correlatives of a career have no cycle, so a subject never requires itself. Storages
+// run it on every write of correlatives, before making it visible.
+func CheckCorrelatives(correlatives []Correlative) error {
+	requires := make(map[int][]int, len(correlatives))
+	for _, c := range correlatives {
+		requires[c.SubjectID] = append(requires[c.SubjectID], c.CorrelativeID)
+	}
+
+	subjectIDs := make([]int, 0, len(requires))
+	for id := range requires {
+		subjectIDs = append(subjectIDs, id)
+	}
+
+	sort.Ints(subjectIDs)
+
+	const (
+		visiting = iota + 1
+		visited
+	)
+
+	state := make(map[int]int, len(requires))
+
+	var visit func(id int) error
+	visit = func(id int) error {
+		switch state[id] {
+		case visiting:
+			return fmt.Errorf("%w [subject_id: %d]", ErrCorrelativesCycle, id)
+		case visited:
+			return nil
+		}
+
+		state[id] = visiting
+		for _, correlativeID := range requires[id] {
+			if err := visit(correlativeID); err != nil {
+				return err
+			}
+		}
+
+		state[id] = visited
+		return nil
+	}
+
+	for _, id := range subjectIDs {
+		if err := visit(id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
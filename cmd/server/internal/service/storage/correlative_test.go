@@ -0,0 +1,111 @@
+package storage
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorage_GetCorrelatives(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	q := `SELECT cs.subject_id, ccs.subject_id correlative_id, csc.requirement
+FROM career_subject_correlative csc
+         INNER JOIN career_subject cs ON cs.id = csc.career_subject_id
+         INNER JOIN career_subject ccs ON ccs.id = csc.correlative_career_subject_id
+WHERE cs.career_id = ?
+ORDER BY cs.subject_id, ccs.subject_id;`
+	mock.ExpectPrepare(q).WillReturnError(nil)
+	mock.ExpectQuery(q).
+		WithArgs("1").
+		WillReturnError(nil).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"subject_id", "correlative_id", "requirement"}).
+				AddRow(2, 1, "REGULARIZADA").
+				AddRow(3, 1, "APROBADA"))
+
+	// When
+	correlatives, err := storage_.GetCorrelatives("1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Equal(t, []Correlative{
+		{SubjectID: 2, CorrelativeID: 1, Requirement: "REGULARIZADA"},
+		{SubjectID: 3, CorrelativeID: 1, Requirement: "APROBADA"},
+	}, correlatives)
+}
+
+func TestStorage_GetCorrelatives_Empty(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	q := `SELECT cs.subject_id, ccs.subject_id correlative_id, csc.requirement
+FROM career_subject_correlative csc
+         INNER JOIN career_subject cs ON cs.id = csc.career_subject_id
+         INNER JOIN career_subject ccs ON ccs.id = csc.correlative_career_subject_id
+WHERE cs.career_id = ?
+ORDER BY cs.subject_id, ccs.subject_id;`
+	mock.ExpectPrepare(q).WillReturnError(nil)
+	mock.ExpectQuery(q).
+		WithArgs("1").
+		WillReturnError(nil).
+		WillReturnRows(sqlmock.NewRows([]string{"subject_id", "correlative_id", "requirement"}))
+
+	// When
+	correlatives, err := storage_.GetCorrelatives("1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Empty(t, correlatives)
+}
+
+func TestStorage_GetCorrelatives_PrepareStmtError(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	q := `SELECT cs.subject_id, ccs.subject_id correlative_id, csc.requirement
+FROM career_subject_correlative csc
+         INNER JOIN career_subject cs ON cs.id = csc.career_subject_id
+         INNER JOIN career_subject ccs ON ccs.id = csc.correlative_career_subject_id
+WHERE cs.career_id = ?
+ORDER BY cs.subject_id, ccs.subject_id;`
+	mock.ExpectPrepare(q).WillReturnError(errors.New("error"))
+
+	// When
+	_, err = storage_.GetCorrelatives("1")
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "error")
+}
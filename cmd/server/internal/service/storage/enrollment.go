@@ -0,0 +1,191 @@
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+)
+
+const (
+	getProfessorshipCareerSubject = `SELECT p.career_subject_id, cs.career_id, p.term_id
+FROM professorship p
+         INNER JOIN career_subject cs ON cs.id = p.career_subject_id
+WHERE p.id = ?;`
+	checkStudentEnrolledInCareerSubject = `SELECT COUNT(1)
+FROM student_professorship sp
+         INNER JOIN professorship p ON p.id = sp.professorship_id
+WHERE sp.student_id = ?
+  AND p.career_subject_id = ?
+  AND (p.term_id IS NULL OR ? IS NULL OR p.term_id = ?);`
+	createStudentProfessorship = `INSERT INTO student_professorship (student_id, professorship_id, career_subject_id, term_id)
+VALUES (?, ?, ?, ?);`
+)
+
+func (s *Storage) EnrollStudentInProfessorship(studentEmail, professorshipID string) error {
+	tx, err := s.db.Beginx()
+	if err != nil {
+		return fmt.Errorf("could not begin tx: %v", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	studentID, err := s.getStudentByEmail(tx, studentEmail)
+	if err != nil {
+		return err
+	}
+
+	professorship, err := s.getProfessorshipCareerSubject(tx, professorshipID)
+	if err != nil {
+		return err
+	}
+
+	if err = s.checkStudentAssignedToCareer(tx, studentID, professorship.CareerID); err != nil {
+		return err
+	}
+
+	// Students retake subjects in later terms, so only an enrollment in the same term is a duplicate. Professorships
+	// without a term overlap every term, which the unique key of the enrollments can't tell.
+	var enrollments int
+	err = tx.Get(&enrollments, checkStudentEnrolledInCareerSubject, studentID, professorship.CareerSubjectID, professorship.TermID, professorship.TermID)
+	if err != nil {
+		return err
+	}
+
+	if enrollments > 0 {
+		err = fmt.Errorf("student already enrolled in subject: %w", ErrResourceAlreadyExist)
+		return err
+	}
+
+	_, err = tx.Exec(createStudentProfessorship, studentID, professorshipID, professorship.CareerSubjectID, professorship.TermID)
+	if err != nil {
+		err = translateWriteError("enrollment", err)
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("could not commit tx: %v", err)
+	}
+
+	return nil
+}
+
+type professorshipCareerSubject struct {
+	CareerSubjectID int    `db:"career_subject_id"`
+	CareerID        string `db:"career_id"`
+	TermID          *int   `db:"term_id"`
+}
+
+func (s *Storage) getProfessorshipCareerSubject(tx *sqlx.Tx, professorshipID string) (professorshipCareerSubject, error) {
+	var professorship professorshipCareerSubject
+	if err := tx.Get(&professorship, getProfessorshipCareerSubject, professorshipID); err != nil {
+		if err == sql.ErrNoRows {
+			return professorshipCareerSubject{}, fmt.Errorf("could not find professorship: %w", ErrNotFound)
+		}
+
+		return professorshipCareerSubject{}, err
+	}
+
+	return professorship, nil
+}
+
+const deleteStudentProfessorship = `DELETE
+FROM student_professorship
+WHERE student_id = (SELECT id FROM student WHERE email = ?) AND professorship_id = ?;`
+
+func (s *Storage) DeleteStudentProfessorship(studentEmail, professorshipID string) error {
+	result, err := s.db.Exec(deleteStudentProfessorship, studentEmail, professorshipID)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return fmt.Errorf("could not find student enrollment: %w", ErrNotFound)
+	}
+
+	return nil
+}
+
+type StudentSchedule struct {
+	ProfessorshipID   int
+	ProfessorshipName string
+	SubjectName       string
+	Meet              *string
+	Day               int
+	Start             string
+	End               string
+}
+
+const getStudentSchedules = `SELECT p.id professorship_id, p.name professorship_name, sub.name subject_name, sub.meet, s.day, s.start, s.end
+FROM student st
+         INNER JOIN student_professorship sp ON sp.student_id = st.id
+         INNER JOIN professorship p ON p.id = sp.professorship_id
+         INNER JOIN schedule s ON s.professorship_id = p.id
+         INNER JOIN career_subject cs ON cs.id = p.career_subject_id
+         INNER JOIN subject sub ON sub.id = cs.subject_id
+WHERE st.email = :email
+  AND (:termID = '' OR p.term_id = :termID OR p.term_id IS NULL)
+ORDER BY s.day, s.start, p.id;`
+
+// GetStudentSchedules returns the schedules of the professorships the student is enrolled in for the term, and of
+// the ones without a term. Every enrollment is returned when the term is empty, and a missing student is only told
+// apart from one without enrollments when there are no schedules.
+func (s *Storage) GetStudentSchedules(studentEmail, termID string) ([]StudentSchedule, error) {
+	stmt, err := s.db.PrepareNamed(getStudentSchedules)
+	if err != nil {
+		return nil, err
+	}
+
+	defer stmt.Close()
+
+	params := map[string]interface{}{"email": studentEmail, "termID": termID}
+
+	var schedules []struct {
+		ProfessorshipID   int     `db:"professorship_id"`
+		ProfessorshipName string  `db:"professorship_name"`
+		SubjectName       string  `db:"subject_name"`
+		Meet              *string `db:"meet"`
+		Day               int     `db:"day"`
+		Start             string  `db:"start"`
+		End               string  `db:"end"`
+	}
+
+	if err := stmt.Select(&schedules, params); err != nil {
+		return nil, err
+	}
+
+	if len(schedules) == 0 {
+		var students int
+		if err := s.db.Get(&students, checkStudentEmailExist, studentEmail); err != nil {
+			return nil, err
+		}
+
+		if students == 0 {
+			return nil, fmt.Errorf("could not find student: %w", ErrNotFound)
+		}
+	}
+
+	response := make([]StudentSchedule, 0, len(schedules))
+	for _, schedule := range schedules {
+		response = append(response, StudentSchedule{
+			ProfessorshipID:   schedule.ProfessorshipID,
+			ProfessorshipName: schedule.ProfessorshipName,
+			SubjectName:       schedule.SubjectName,
+			Meet:              schedule.Meet,
+			Day:               schedule.Day,
+			Start:             schedule.Start,
+			End:               schedule.End,
+		})
+	}
+
+	return response, nil
+}
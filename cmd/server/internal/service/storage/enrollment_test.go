@@ -0,0 +1,354 @@
+package storage
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorage_EnrollStudentInProfessorship(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT id FROM student WHERE email = ?;`).
+		WithArgs("test@gmail.com").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectQuery(`SELECT p.career_subject_id, cs.career_id, p.term_id
+FROM professorship p
+         INNER JOIN career_subject cs ON cs.id = p.career_subject_id
+WHERE p.id = ?;`).
+		WithArgs("7").
+		WillReturnRows(sqlmock.NewRows([]string{"career_subject_id", "career_id", "term_id"}).AddRow(4, "2", 3))
+	mock.ExpectQuery(`SELECT COUNT(1) FROM student_career WHERE student_id = ? AND career_id = ?`).
+		WithArgs(1, "2").
+		WillReturnRows(sqlmock.NewRows([]string{"COUNT(1)"}).AddRow(1))
+	mock.ExpectQuery(`SELECT COUNT(1)
+FROM student_professorship sp
+         INNER JOIN professorship p ON p.id = sp.professorship_id
+WHERE sp.student_id = ?
+  AND p.career_subject_id = ?
+  AND (p.term_id IS NULL OR ? IS NULL OR p.term_id = ?);`).
+		WithArgs(1, 4, 3, 3).
+		WillReturnRows(sqlmock.NewRows([]string{"COUNT(1)"}).AddRow(0))
+	mock.ExpectExec(`INSERT INTO student_professorship (student_id, professorship_id, career_subject_id, term_id)
+VALUES (?, ?, ?, ?);`).
+		WithArgs(1, "7", 4, 3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	// When
+	err = storage_.EnrollStudentInProfessorship("test@gmail.com", "7")
+
+	// Then
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStorage_EnrollStudentInProfessorship_DuplicateEntryError(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT id FROM student WHERE email = ?;`).
+		WithArgs("test@gmail.com").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectQuery(`SELECT p.career_subject_id, cs.career_id, p.term_id
+FROM professorship p
+         INNER JOIN career_subject cs ON cs.id = p.career_subject_id
+WHERE p.id = ?;`).
+		WithArgs("7").
+		WillReturnRows(sqlmock.NewRows([]string{"career_subject_id", "career_id", "term_id"}).AddRow(4, "2", 3))
+	mock.ExpectQuery(`SELECT COUNT(1) FROM student_career WHERE student_id = ? AND career_id = ?`).
+		WithArgs(1, "2").
+		WillReturnRows(sqlmock.NewRows([]string{"COUNT(1)"}).AddRow(1))
+	mock.ExpectQuery(`SELECT COUNT(1)
+FROM student_professorship sp
+         INNER JOIN professorship p ON p.id = sp.professorship_id
+WHERE sp.student_id = ?
+  AND p.career_subject_id = ?
+  AND (p.term_id IS NULL OR ? IS NULL OR p.term_id = ?);`).
+		WithArgs(1, 4, 3, 3).
+		WillReturnRows(sqlmock.NewRows([]string{"COUNT(1)"}).AddRow(0))
+	mock.ExpectExec(`INSERT INTO student_professorship (student_id, professorship_id, career_subject_id, term_id)
+VALUES (?, ?, ?, ?);`).
+		WithArgs(1, "7", 4, 3).
+		WillReturnError(&mysql.MySQLError{Number: 1062})
+	mock.ExpectRollback()
+
+	// When
+	err = storage_.EnrollStudentInProfessorship("test@gmail.com", "7")
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.True(t, errors.Is(err, ErrResourceAlreadyExist))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStorage_EnrollStudentInProfessorship_ProfessorshipNotFoundError(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT id FROM student WHERE email = ?;`).
+		WithArgs("test@gmail.com").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectQuery(`SELECT p.career_subject_id, cs.career_id, p.term_id
+FROM professorship p
+         INNER JOIN career_subject cs ON cs.id = p.career_subject_id
+WHERE p.id = ?;`).
+		WithArgs("7").
+		WillReturnRows(sqlmock.NewRows([]string{"career_subject_id", "career_id", "term_id"}))
+	mock.ExpectRollback()
+
+	// When
+	err = storage_.EnrollStudentInProfessorship("test@gmail.com", "7")
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "could not find professorship: storage: resource not found")
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStorage_EnrollStudentInProfessorship_StudentNotAssignedToCareerError(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT id FROM student WHERE email = ?;`).
+		WithArgs("test@gmail.com").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectQuery(`SELECT p.career_subject_id, cs.career_id, p.term_id
+FROM professorship p
+         INNER JOIN career_subject cs ON cs.id = p.career_subject_id
+WHERE p.id = ?;`).
+		WithArgs("7").
+		WillReturnRows(sqlmock.NewRows([]string{"career_subject_id", "career_id", "term_id"}).AddRow(4, "2", 3))
+	mock.ExpectQuery(`SELECT COUNT(1) FROM student_career WHERE student_id = ? AND career_id = ?`).
+		WithArgs(1, "2").
+		WillReturnRows(sqlmock.NewRows([]string{"COUNT(1)"}).AddRow(0))
+	mock.ExpectRollback()
+
+	// When
+	err = storage_.EnrollStudentInProfessorship("test@gmail.com", "7")
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "could not find student assigned to career: storage: resource not found")
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStorage_EnrollStudentInProfessorship_AlreadyEnrolledError(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT id FROM student WHERE email = ?;`).
+		WithArgs("test@gmail.com").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectQuery(`SELECT p.career_subject_id, cs.career_id, p.term_id
+FROM professorship p
+         INNER JOIN career_subject cs ON cs.id = p.career_subject_id
+WHERE p.id = ?;`).
+		WithArgs("7").
+		WillReturnRows(sqlmock.NewRows([]string{"career_subject_id", "career_id", "term_id"}).AddRow(4, "2", 3))
+	mock.ExpectQuery(`SELECT COUNT(1) FROM student_career WHERE student_id = ? AND career_id = ?`).
+		WithArgs(1, "2").
+		WillReturnRows(sqlmock.NewRows([]string{"COUNT(1)"}).AddRow(1))
+	mock.ExpectQuery(`SELECT COUNT(1)
+FROM student_professorship sp
+         INNER JOIN professorship p ON p.id = sp.professorship_id
+WHERE sp.student_id = ?
+  AND p.career_subject_id = ?
+  AND (p.term_id IS NULL OR ? IS NULL OR p.term_id = ?);`).
+		WithArgs(1, 4, 3, 3).
+		WillReturnRows(sqlmock.NewRows([]string{"COUNT(1)"}).AddRow(1))
+	mock.ExpectRollback()
+
+	// When
+	err = storage_.EnrollStudentInProfessorship("test@gmail.com", "7")
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.True(t, errors.Is(err, ErrResourceAlreadyExist))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStorage_DeleteStudentProfessorship(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	mock.ExpectExec(`DELETE
+FROM student_professorship
+WHERE student_id = (SELECT id FROM student WHERE email = ?) AND professorship_id = ?;`).
+		WithArgs("test@gmail.com", "7").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	// When
+	err = storage_.DeleteStudentProfessorship("test@gmail.com", "7")
+
+	// Then
+	require.NoError(t, err)
+}
+
+func TestStorage_DeleteStudentProfessorship_NotFoundError(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	mock.ExpectExec(`DELETE
+FROM student_professorship
+WHERE student_id = (SELECT id FROM student WHERE email = ?) AND professorship_id = ?;`).
+		WithArgs("test@gmail.com", "7").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	// When
+	err = storage_.DeleteStudentProfessorship("test@gmail.com", "7")
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "could not find student enrollment: storage: resource not found")
+}
+
+func TestStorage_GetStudentSchedules(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	q := `SELECT p.id professorship_id, p.name professorship_name, sub.name subject_name, sub.meet, s.day, s.start, s.end
+FROM student st
+         INNER JOIN student_professorship sp ON sp.student_id = st.id
+         INNER JOIN professorship p ON p.id = sp.professorship_id
+         INNER JOIN schedule s ON s.professorship_id = p.id
+         INNER JOIN career_subject cs ON cs.id = p.career_subject_id
+         INNER JOIN subject sub ON sub.id = cs.subject_id
+WHERE st.email = ?
+  AND (? = '' OR p.term_id = ? OR p.term_id IS NULL)
+ORDER BY s.day, s.start, p.id;`
+	mock.ExpectPrepare(q).WillReturnError(nil)
+	mock.ExpectQuery(q).
+		WithArgs("test@gmail.com", "3", "3").
+		WillReturnError(nil).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"professorship_id", "professorship_name", "subject_name", "meet", "day", "start", "end"}).
+				AddRow(7, "K1021", "Física I", "https://meet.google.com/abc", 1, "19:00:00", "22:00:00").
+				AddRow(8, "K1031", "Química", nil, 3, "08:00:00", "10:00:00"))
+
+	// When
+	schedules, err := storage_.GetStudentSchedules("test@gmail.com", "3")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	meet := "https://meet.google.com/abc"
+	require.Equal(t, []StudentSchedule{
+		{ProfessorshipID: 7, ProfessorshipName: "K1021", SubjectName: "Física I", Meet: &meet, Day: 1, Start: "19:00:00", End: "22:00:00"},
+		{ProfessorshipID: 8, ProfessorshipName: "K1031", SubjectName: "Química", Day: 3, Start: "08:00:00", End: "10:00:00"},
+	}, schedules)
+}
+
+func TestStorage_GetStudentSchedules_StudentNotFoundError(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	q := `SELECT p.id professorship_id, p.name professorship_name, sub.name subject_name, sub.meet, s.day, s.start, s.end
+FROM student st
+         INNER JOIN student_professorship sp ON sp.student_id = st.id
+         INNER JOIN professorship p ON p.id = sp.professorship_id
+         INNER JOIN schedule s ON s.professorship_id = p.id
+         INNER JOIN career_subject cs ON cs.id = p.career_subject_id
+         INNER JOIN subject sub ON sub.id = cs.subject_id
+WHERE st.email = ?
+  AND (? = '' OR p.term_id = ? OR p.term_id IS NULL)
+ORDER BY s.day, s.start, p.id;`
+	mock.ExpectPrepare(q).WillReturnError(nil)
+	mock.ExpectQuery(q).
+		WithArgs("test@gmail.com", "", "").
+		WillReturnRows(sqlmock.NewRows([]string{"professorship_id", "professorship_name", "subject_name", "meet", "day", "start", "end"}))
+	mock.ExpectQuery(`SELECT COUNT(1) FROM student WHERE email = ?;`).
+		WithArgs("test@gmail.com").
+		WillReturnRows(sqlmock.NewRows([]string{"COUNT(1)"}).AddRow(0))
+
+	// When
+	_, err = storage_.GetStudentSchedules("test@gmail.com", "")
+
+	// Then
+	require.EqualError(t, err, "could not find student: storage: resource not found")
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,532 @@
+package storage
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type EquivalenceRequest struct {
+	CareerID            string
+	SubjectID           string
+	EquivalentCareerID  string
+	EquivalentSubjectID string
+}
+
+const (
+	checkEquivalenceExist = `SELECT COUNT(1)
+FROM career_subject_equivalence
+WHERE (career_subject_id = ? AND equivalent_career_subject_id = ?)
+   OR (career_subject_id = ? AND equivalent_career_subject_id = ?);`
+	createEquivalence = `INSERT INTO career_subject_equivalence (career_subject_id, equivalent_career_subject_id) VALUES (?, ?);`
+	deleteEquivalence = `DELETE FROM career_subject_equivalence WHERE id = ?;`
+)
+
+// CreateEquivalence makes two career subjects equivalent. Equivalences work both ways, so the reversed one is
+// reported as already existing.
+func (s *Storage) CreateEquivalence(req EquivalenceRequest) (int, error) {
+	tx, err := s.db.Beginx()
+	if err != nil {
+		return 0, fmt.Errorf("could not begin tx: %v", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	careerSubjectID, err := s.getCareerSubjectByIDs(tx, req.CareerID, req.SubjectID)
+	if err != nil {
+		return 0, err
+	}
+
+	equivalentID, err := s.getCareerSubjectByIDs(tx, req.EquivalentCareerID, req.EquivalentSubjectID)
+	if err != nil {
+		return 0, err
+	}
+
+	var results int
+	if err = tx.Get(&results, checkEquivalenceExist, careerSubjectID, equivalentID, equivalentID, careerSubjectID); err != nil {
+		return 0, err
+	}
+
+	if results > 0 {
+		err = fmt.Errorf("equivalence already exist: %w", ErrResourceAlreadyExist)
+		return 0, err
+	}
+
+	result, err := tx.Exec(createEquivalence, careerSubjectID, equivalentID)
+	if err != nil {
+		err = translateWriteError("equivalence", err)
+		return 0, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, fmt.Errorf("could not commit tx: %v", err)
+	}
+
+	return int(id), nil
+}
+
+func (s *Storage) DeleteEquivalence(equivalenceID string) error {
+	return s.deleteResource("equivalence", deleteEquivalence, equivalenceID)
+}
+
+type Equivalence struct {
+	ID                    int
+	CareerID              int
+	SubjectID             int
+	SubjectName           string
+	EquivalentCareerID    int
+	EquivalentSubjectID   int
+	EquivalentSubjectName string
+}
+
+const getEquivalences = `SELECT e.id,
+       cs.career_id,
+       cs.subject_id,
+       s.name   subject_name,
+       ecs.career_id  equivalent_career_id,
+       ecs.subject_id equivalent_subject_id,
+       es.name  equivalent_subject_name
+FROM career_subject_equivalence e
+         INNER JOIN career_subject cs ON cs.id = e.career_subject_id
+         INNER JOIN subject s ON s.id = cs.subject_id
+         INNER JOIN career_subject ecs ON ecs.id = e.equivalent_career_subject_id
+         INNER JOIN subject es ON es.id = ecs.subject_id
+WHERE cs.career_id = ?
+   OR ecs.career_id = ?
+ORDER BY e.id;`
+
+// GetEquivalences returns the equivalences of the subjects of the career, with the subject of the career first.
+func (s *Storage) GetEquivalences(careerID string) ([]Equivalence, error) {
+	var equivalences []struct {
+		ID                    int    `db:"id"`
+		CareerID              int    `db:"career_id"`
+		SubjectID             int    `db:"subject_id"`
+		SubjectName           string `db:"subject_name"`
+		EquivalentCareerID    int    `db:"equivalent_career_id"`
+		EquivalentSubjectID   int    `db:"equivalent_subject_id"`
+		EquivalentSubjectName string `db:"equivalent_subject_name"`
+	}
+
+	if err := s.db.Select(&equivalences, getEquivalences, careerID, careerID); err != nil {
+		return nil, err
+	}
+
+	response := make([]Equivalence, 0, len(equivalences))
+	for _, e := range equivalences {
+		equivalence := Equivalence(e)
+		if strconv.Itoa(equivalence.CareerID) != careerID {
+			equivalence = Equivalence{
+				ID:                    e.ID,
+				CareerID:              e.EquivalentCareerID,
+				SubjectID:             e.EquivalentSubjectID,
+				SubjectName:           e.EquivalentSubjectName,
+				EquivalentCareerID:    e.CareerID,
+				EquivalentSubjectID:   e.SubjectID,
+				EquivalentSubjectName: e.SubjectName,
+			}
+		}
+
+		response = append(response, equivalence)
+	}
+
+	return response, nil
+}
+
+type StudentEquivalence struct {
+	ID                int
+	StudentEmail      string
+	CareerID          int
+	SubjectID         int
+	SubjectName       string
+	SourceCareerID    int
+	SourceSubjectID   int
+	SourceSubjectName string
+	Status            string
+	Automatic         bool
+	CreatedAt         string
+	ResolvedAt        *string
+}
+
+type careerSubjectRow struct {
+	ID          int    `db:"id"`
+	CareerID    int    `db:"career_id"`
+	SubjectID   int    `db:"subject_id"`
+	SubjectName string `db:"subject_name"`
+	TermID      *int   `db:"term_id"`
+}
+
+const (
+	// getCreditableSubjects returns the career subjects not approved by the student yet.
+	getCreditableSubjects = `SELECT cs.id, cs.career_id, cs.subject_id, s.name subject_name, NULL term_id
+FROM career_subject cs
+         INNER JOIN subject s ON s.id = cs.subject_id
+WHERE cs.career_id = ?
+  AND NOT EXISTS(SELECT 1
+                 FROM student_career_subject scs
+                 WHERE scs.student_id = ?
+                   AND scs.career_subject_id = cs.id
+                   AND scs.status = 'APROBADA')
+ORDER BY cs.subject_id, cs.id;`
+	getApprovedSubjectsOutsideCareer = `SELECT cs.id, cs.career_id, cs.subject_id, s.name subject_name, scs.term_id
+FROM student_career_subject scs
+         INNER JOIN career_subject cs ON cs.id = scs.career_subject_id
+         INNER JOIN subject s ON s.id = cs.subject_id
+WHERE scs.student_id = ?
+  AND cs.career_id <> ?
+  AND scs.status = 'APROBADA'
+ORDER BY cs.career_id, cs.subject_id, cs.id;`
+	getCareerEquivalences = `SELECT e.career_subject_id, e.equivalent_career_subject_id
+FROM career_subject_equivalence e
+         INNER JOIN career_subject cs ON cs.id = e.career_subject_id
+         INNER JOIN career_subject ecs ON ecs.id = e.equivalent_career_subject_id
+WHERE cs.career_id = ?
+   OR ecs.career_id = ?;`
+	createStudentEquivalence = `INSERT INTO student_equivalence
+    (student_id, career_subject_id, source_career_subject_id, status, automatic, created_at, resolved_at)
+VALUES (?, ?, ?, ?, ?, ?, ?);`
+)
+
+// AssignStudentToCareerWithCredits assigns the career to the student and, in the same transaction, approves the
+// subjects of the career that are equivalent to subjects the student approved in other careers. Subjects shared by
+// both careers are equivalent without an entry in the equivalence table. It returns the credited subjects, which
+// are kept as automatic student equivalences.
+func (s *Storage) AssignStudentToCareerWithCredits(studentEmail, careerID, creditedAt string) (credits []StudentEquivalence, err error) {
+	tx, err := s.db.Beginx()
+	if err != nil {
+		return nil, fmt.Errorf("could not begin tx: %v", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	studentID, err := s.getStudentByEmail(tx, studentEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	var careerCount int
+	if err = tx.Get(&careerCount, findCareerWithID, careerID); err != nil {
+		return nil, err
+	}
+
+	if careerCount == 0 {
+		err = fmt.Errorf("could not find career: %w", ErrNotFound)
+		return nil, err
+	}
+
+	if _, err = tx.Exec(createStudentWithCareer, studentID, careerID); err != nil {
+		return nil, err
+	}
+
+	var targets, approved []careerSubjectRow
+	if err = tx.Select(&targets, getCreditableSubjects, careerID, studentID); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Select(&approved, getApprovedSubjectsOutsideCareer, studentID, careerID); err != nil {
+		return nil, err
+	}
+
+	var pairs []struct {
+		CareerSubjectID           int `db:"career_subject_id"`
+		EquivalentCareerSubjectID int `db:"equivalent_career_subject_id"`
+	}
+
+	if err = tx.Select(&pairs, getCareerEquivalences, careerID, careerID); err != nil {
+		return nil, err
+	}
+
+	equivalent := make(map[[2]int]bool, 2*len(pairs))
+	for _, p := range pairs {
+		equivalent[[2]int{p.CareerSubjectID, p.EquivalentCareerSubjectID}] = true
+		equivalent[[2]int{p.EquivalentCareerSubjectID, p.CareerSubjectID}] = true
+	}
+
+	credits = []StudentEquivalence{}
+	for i, target := range targets {
+		// Legacy plans may repeat a subject in the career, only the first one is credited.
+		if i > 0 && targets[i-1].SubjectID == target.SubjectID {
+			continue
+		}
+
+		for _, source := range approved {
+			if source.SubjectID != target.SubjectID && !equivalent[[2]int{target.ID, source.ID}] {
+				continue
+			}
+
+			if err = s.approveStudentSubject(tx, studentID, target.ID, source.TermID); err != nil {
+				return nil, err
+			}
+
+			var result sql.Result
+			result, err = tx.Exec(createStudentEquivalence, studentID, target.ID, source.ID, "APROBADA", true, creditedAt, creditedAt)
+			if err != nil {
+				return nil, err
+			}
+
+			var id int64
+			if id, err = result.LastInsertId(); err != nil {
+				return nil, err
+			}
+
+			resolvedAt := creditedAt
+			credits = append(credits, StudentEquivalence{
+				ID:                int(id),
+				StudentEmail:      studentEmail,
+				CareerID:          target.CareerID,
+				SubjectID:         target.SubjectID,
+				SubjectName:       target.SubjectName,
+				SourceCareerID:    source.CareerID,
+				SourceSubjectID:   source.SubjectID,
+				SourceSubjectName: source.SubjectName,
+				Status:            "APROBADA",
+				Automatic:         true,
+				CreatedAt:         creditedAt,
+				ResolvedAt:        &resolvedAt,
+			})
+
+			break
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("could not commit tx: %v", err)
+	}
+
+	return credits, nil
+}
+
+type StudentEquivalenceRequest struct {
+	StudentEmail    string
+	CareerID        string
+	SubjectID       string
+	SourceCareerID  string
+	SourceSubjectID string
+	CreatedAt       string
+}
+
+const (
+	checkStudentSubjectApproved = `SELECT COUNT(1) FROM student_career_subject WHERE student_id = ? AND career_subject_id = ? AND status = 'APROBADA';`
+	checkPendingEquivalence     = `SELECT COUNT(1) FROM student_equivalence WHERE student_id = ? AND career_subject_id = ? AND status = 'PENDIENTE';`
+)
+
+// CreateStudentEquivalence requests to credit a subject of a career of the student with a subject it approved. The
+// request stays pending until an admin resolves it.
+func (s *Storage) CreateStudentEquivalence(req StudentEquivalenceRequest) (int, error) {
+	tx, err := s.db.Beginx()
+	if err != nil {
+		return 0, fmt.Errorf("could not begin tx: %v", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	studentID, err := s.getStudentByEmail(tx, req.StudentEmail)
+	if err != nil {
+		return 0, err
+	}
+
+	if err = s.checkStudentAssignedToCareer(tx, studentID, req.CareerID); err != nil {
+		return 0, err
+	}
+
+	careerSubjectID, err := s.getCareerSubjectByIDs(tx, req.CareerID, req.SubjectID)
+	if err != nil {
+		return 0, err
+	}
+
+	sourceID, err := s.getCareerSubjectByIDs(tx, req.SourceCareerID, req.SourceSubjectID)
+	if err != nil {
+		return 0, err
+	}
+
+	var results int
+	if err = tx.Get(&results, checkStudentSubjectApproved, studentID, sourceID); err != nil {
+		return 0, err
+	}
+
+	if results == 0 {
+		err = fmt.Errorf("could not find approved source subject: %w", ErrNotFound)
+		return 0, err
+	}
+
+	if err = tx.Get(&results, checkStudentSubjectApproved, studentID, careerSubjectID); err != nil {
+		return 0, err
+	}
+
+	if results > 0 {
+		err = fmt.Errorf("student subject already approved: %w", ErrResourceAlreadyExist)
+		return 0, err
+	}
+
+	if err = tx.Get(&results, checkPendingEquivalence, studentID, careerSubjectID); err != nil {
+		return 0, err
+	}
+
+	if results > 0 {
+		err = fmt.Errorf("equivalence request already exist: %w", ErrResourceAlreadyExist)
+		return 0, err
+	}
+
+	result, err := tx.Exec(createStudentEquivalence, studentID, careerSubjectID, sourceID, "PENDIENTE", false, req.CreatedAt, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, fmt.Errorf("could not commit tx: %v", err)
+	}
+
+	return int(id), nil
+}
+
+const getStudentEquivalences = `SELECT se.id,
+       st.email       student_email,
+       cs.career_id,
+       cs.subject_id,
+       s.name         subject_name,
+       src.career_id  source_career_id,
+       src.subject_id source_subject_id,
+       ss.name        source_subject_name,
+       se.status,
+       se.automatic,
+       DATE_FORMAT(se.created_at, '%Y-%m-%d %H:%i:%s')  created_at,
+       DATE_FORMAT(se.resolved_at, '%Y-%m-%d %H:%i:%s') resolved_at
+FROM student_equivalence se
+         INNER JOIN student st ON st.id = se.student_id
+         INNER JOIN career_subject cs ON cs.id = se.career_subject_id
+         INNER JOIN subject s ON s.id = cs.subject_id
+         INNER JOIN career_subject src ON src.id = se.source_career_subject_id
+         INNER JOIN subject ss ON ss.id = src.subject_id
+`
+
+func (s *Storage) selectStudentEquivalences(query string, args ...interface{}) ([]StudentEquivalence, error) {
+	var equivalences []struct {
+		ID                int     `db:"id"`
+		StudentEmail      string  `db:"student_email"`
+		CareerID          int     `db:"career_id"`
+		SubjectID         int     `db:"subject_id"`
+		SubjectName       string  `db:"subject_name"`
+		SourceCareerID    int     `db:"source_career_id"`
+		SourceSubjectID   int     `db:"source_subject_id"`
+		SourceSubjectName string  `db:"source_subject_name"`
+		Status            string  `db:"status"`
+		Automatic         bool    `db:"automatic"`
+		CreatedAt         string  `db:"created_at"`
+		ResolvedAt        *string `db:"resolved_at"`
+	}
+
+	if err := s.db.Select(&equivalences, query, args...); err != nil {
+		return nil, err
+	}
+
+	response := make([]StudentEquivalence, 0, len(equivalences))
+	for _, e := range equivalences {
+		response = append(response, StudentEquivalence(e))
+	}
+
+	return response, nil
+}
+
+// GetStudentEquivalences returns the credited and requested subjects of the student in the career.
+func (s *Storage) GetStudentEquivalences(studentEmail, careerID string) ([]StudentEquivalence, error) {
+	return s.selectStudentEquivalences(getStudentEquivalences+`WHERE st.email = ? AND cs.career_id = ? ORDER BY se.id;`, studentEmail, careerID)
+}
+
+// GetEquivalenceRequests returns the requests of every student with the status.
+func (s *Storage) GetEquivalenceRequests(status string) ([]StudentEquivalence, error) {
+	return s.selectStudentEquivalences(getStudentEquivalences+`WHERE se.status = ? AND se.automatic = FALSE ORDER BY se.id;`, status)
+}
+
+type ResolveEquivalenceRequest struct {
+	RequestID  string
+	Status     string
+	ResolvedAt string
+}
+
+const (
+	getPendingEquivalence = `SELECT student_id, career_subject_id, source_career_subject_id
+FROM student_equivalence
+WHERE id = ?
+  AND status = 'PENDIENTE';`
+	resolveStudentEquivalence = `UPDATE student_equivalence SET status = ?, resolved_at = ? WHERE id = ?;`
+	getStudentSubjectTerm     = `SELECT term_id FROM student_career_subject WHERE student_id = ? AND career_subject_id = ?;`
+)
+
+// ResolveEquivalenceRequest sets the status of a pending request. Approved requests approve the subject in the
+// career of the student, with the term of the source subject.
+func (s *Storage) ResolveEquivalenceRequest(req ResolveEquivalenceRequest) (err error) {
+	tx, err := s.db.Beginx()
+	if err != nil {
+		return fmt.Errorf("could not begin tx: %v", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var pending struct {
+		StudentID             int `db:"student_id"`
+		CareerSubjectID       int `db:"career_subject_id"`
+		SourceCareerSubjectID int `db:"source_career_subject_id"`
+	}
+
+	if err = tx.Get(&pending, getPendingEquivalence, req.RequestID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = fmt.Errorf("could not find pending equivalence request: %w", ErrNotFound)
+		}
+
+		return err
+	}
+
+	if _, err = tx.Exec(resolveStudentEquivalence, req.Status, req.ResolvedAt, req.RequestID); err != nil {
+		return err
+	}
+
+	if req.Status == "APROBADA" {
+		if err = s.approveEquivalentSubject(tx, pending.StudentID, pending.CareerSubjectID, pending.SourceCareerSubjectID); err != nil {
+			return err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("could not commit tx: %v", err)
+	}
+
+	return nil
+}
+
+func (s *Storage) approveEquivalentSubject(tx *sqlx.Tx, studentID, careerSubjectID, sourceCareerSubjectID int) error {
+	// The source subject may be gone if the student left its career.
+	var termID *int
+	if err := tx.Get(&termID, getStudentSubjectTerm, studentID, sourceCareerSubjectID); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	return s.approveStudentSubject(tx, studentID, careerSubjectID, termID)
+}
@@ -0,0 +1,221 @@
+package storage
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorage_CreateEquivalence_AlreadyExistError(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(getCareerSubjectByIDs).
+		WithArgs("1", "2").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(10))
+	mock.ExpectQuery(getCareerSubjectByIDs).
+		WithArgs("3", "4").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(20))
+	mock.ExpectQuery(checkEquivalenceExist).
+		WithArgs(10, 20, 20, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectRollback()
+
+	// When
+	_, err = storage_.CreateEquivalence(EquivalenceRequest{CareerID: "1", SubjectID: "2", EquivalentCareerID: "3", EquivalentSubjectID: "4"})
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.True(t, errors.Is(err, ErrResourceAlreadyExist))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStorage_GetEquivalences(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	columns := []string{"id", "career_id", "subject_id", "subject_name", "equivalent_career_id", "equivalent_subject_id", "equivalent_subject_name"}
+	mock.ExpectQuery(getEquivalences).
+		WithArgs("1", "1").
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(1, 1, 2, "Álgebra", 3, 4, "Álgebra I").
+			AddRow(2, 5, 6, "Física", 1, 7, "Física I"))
+
+	// When
+	equivalences, err := storage_.GetEquivalences("1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Equal(t, []Equivalence{
+		{ID: 1, CareerID: 1, SubjectID: 2, SubjectName: "Álgebra", EquivalentCareerID: 3, EquivalentSubjectID: 4, EquivalentSubjectName: "Álgebra I"},
+		{ID: 2, CareerID: 1, SubjectID: 7, SubjectName: "Física I", EquivalentCareerID: 5, EquivalentSubjectID: 6, EquivalentSubjectName: "Física"},
+	}, equivalences)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStorage_AssignStudentToCareerWithCredits(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	termID := 5
+	columns := []string{"id", "career_id", "subject_id", "subject_name", "term_id"}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT id FROM student WHERE email = ?;`).
+		WithArgs("example@gmail.com").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectQuery(findCareerWithID).
+		WithArgs("2").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectExec(createStudentWithCareer).
+		WithArgs(1, "2").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(getCreditableSubjects).
+		WithArgs("2", 1).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(20, 2, 3, "Álgebra", nil).
+			AddRow(21, 2, 4, "Física I", nil).
+			AddRow(22, 2, 8, "Química", nil))
+	mock.ExpectQuery(getApprovedSubjectsOutsideCareer).
+		WithArgs(1, "2").
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(10, 1, 3, "Álgebra", termID).
+			AddRow(11, 1, 5, "Física", nil))
+	mock.ExpectQuery(getCareerEquivalences).
+		WithArgs("2", "2").
+		WillReturnRows(sqlmock.NewRows([]string{"career_subject_id", "equivalent_career_subject_id"}).AddRow(11, 21))
+	mock.ExpectExec(approveStudentSubject).
+		WithArgs(1, 20, termID, termID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(createStudentEquivalence).
+		WithArgs(1, 20, 10, "APROBADA", true, "2021-04-01 12:00:00", "2021-04-01 12:00:00").
+		WillReturnResult(sqlmock.NewResult(7, 1))
+	mock.ExpectExec(approveStudentSubject).
+		WithArgs(1, 21, nil, nil).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(createStudentEquivalence).
+		WithArgs(1, 21, 11, "APROBADA", true, "2021-04-01 12:00:00", "2021-04-01 12:00:00").
+		WillReturnResult(sqlmock.NewResult(8, 1))
+	mock.ExpectCommit()
+
+	// When
+	credits, err := storage_.AssignStudentToCareerWithCredits("example@gmail.com", "2", "2021-04-01 12:00:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Len(t, credits, 2)
+	require.Equal(t, "Álgebra", credits[0].SourceSubjectName)
+	require.Equal(t, 4, credits[1].SubjectID)
+	require.Equal(t, 5, credits[1].SourceSubjectID)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStorage_AssignStudentToCareerWithCredits_RollbackError(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	columns := []string{"id", "career_id", "subject_id", "subject_name", "term_id"}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT id FROM student WHERE email = ?;`).
+		WithArgs("example@gmail.com").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectQuery(findCareerWithID).
+		WithArgs("2").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectExec(createStudentWithCareer).
+		WithArgs(1, "2").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(getCreditableSubjects).
+		WithArgs("2", 1).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(20, 2, 3, "Álgebra", nil))
+	mock.ExpectQuery(getApprovedSubjectsOutsideCareer).
+		WithArgs(1, "2").
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(10, 1, 3, "Álgebra", nil))
+	mock.ExpectQuery(getCareerEquivalences).
+		WithArgs("2", "2").
+		WillReturnRows(sqlmock.NewRows([]string{"career_subject_id", "equivalent_career_subject_id"}))
+	mock.ExpectExec(approveStudentSubject).
+		WithArgs(1, 20, nil, nil).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(createStudentEquivalence).
+		WithArgs(1, 20, 10, "APROBADA", true, "2021-04-01 12:00:00", "2021-04-01 12:00:00").
+		WillReturnError(errors.New("error"))
+	mock.ExpectRollback()
+
+	// When
+	_, err = storage_.AssignStudentToCareerWithCredits("example@gmail.com", "2", "2021-04-01 12:00:00")
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "error")
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStorage_ResolveEquivalenceRequest_NotFoundError(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(getPendingEquivalence).
+		WithArgs("1").
+		WillReturnRows(sqlmock.NewRows([]string{"student_id", "career_subject_id", "source_career_subject_id"}))
+	mock.ExpectRollback()
+
+	// When
+	err = storage_.ResolveEquivalenceRequest(ResolveEquivalenceRequest{RequestID: "1", Status: "APROBADA", ResolvedAt: "2021-04-01 12:00:00"})
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.True(t, errors.Is(err, ErrNotFound))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,103 @@
+package storage
+
+import (
+	"fmt"
+)
+
+type CreateExamRequest struct {
+	StudentEmail string
+	CareerID     string
+	SubjectID    string
+	Grade        int
+	Date         string
+}
+
+const createExam = `INSERT INTO exam (student_id, career_subject_id, grade, date) VALUES (?, ?, ?, ?);`
+
+func (s *Storage) CreateExam(req CreateExamRequest) (int, error) {
+	tx, err := s.db.Beginx()
+	if err != nil {
+		return 0, fmt.Errorf("could not begin tx: %v", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	studentID, err := s.getStudentByEmail(tx, req.StudentEmail)
+	if err != nil {
+		return 0, err
+	}
+
+	if err = s.checkStudentAssignedToCareer(tx, studentID, req.CareerID); err != nil {
+		return 0, err
+	}
+
+	careerSubjectID, err := s.getCareerSubjectByIDs(tx, req.CareerID, req.SubjectID)
+	if err != nil {
+		return 0, err
+	}
+
+	result, err := tx.Exec(createExam, studentID, careerSubjectID, req.Grade, req.Date)
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, fmt.Errorf("could not commit tx: %v", err)
+	}
+
+	return int(id), nil
+}
+
+type Exam struct {
+	SubjectID int
+	Grade     int
+	Date      string
+}
+
+const getStudentExams = `SELECT cs.subject_id, e.grade, DATE_FORMAT(e.date, '%Y-%m-%d') date
+FROM exam e
+         INNER JOIN student st ON st.id = e.student_id
+         INNER JOIN career_subject cs ON cs.id = e.career_subject_id
+WHERE st.email = :email AND cs.career_id = :careerID
+ORDER BY e.date, e.id;`
+
+func (s *Storage) GetStudentExams(studentEmail, careerID string) ([]Exam, error) {
+	stmt, err := s.db.PrepareNamed(getStudentExams)
+	if err != nil {
+		return nil, err
+	}
+
+	defer stmt.Close()
+
+	params := map[string]interface{}{"email": studentEmail, "careerID": careerID}
+
+	var exams []struct {
+		SubjectID int    `db:"subject_id"`
+		Grade     int    `db:"grade"`
+		Date      string `db:"date"`
+	}
+
+	if err := stmt.Select(&exams, params); err != nil {
+		return nil, err
+	}
+
+	response := make([]Exam, 0, len(exams))
+	for _, exam := range exams {
+		response = append(response, Exam{
+			SubjectID: exam.SubjectID,
+			Grade:     exam.Grade,
+			Date:      exam.Date,
+		})
+	}
+
+	return response, nil
+}
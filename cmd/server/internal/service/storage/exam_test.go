@@ -0,0 +1,182 @@
+package storage
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorage_CreateExam(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT id FROM student WHERE email = ?;`).
+		WithArgs("test@gmail.com").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectQuery(`SELECT COUNT(1) FROM student_career WHERE student_id = ? AND career_id = ?`).
+		WithArgs(1, "2").
+		WillReturnRows(sqlmock.NewRows([]string{"COUNT(1)"}).AddRow(1))
+	mock.ExpectQuery(`SELECT id FROM career_subject WHERE career_id = ? AND subject_id = ? ORDER BY id LIMIT 1`).
+		WithArgs("2", "3").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(4))
+	mock.ExpectExec(`INSERT INTO exam (student_id, career_subject_id, grade, date) VALUES (?, ?, ?, ?);`).
+		WithArgs(1, 4, 8, "2021-07-20").
+		WillReturnResult(sqlmock.NewResult(5, 1))
+	mock.ExpectCommit()
+
+	// When
+	id, err := storage_.CreateExam(CreateExamRequest{
+		StudentEmail: "test@gmail.com",
+		CareerID:     "2",
+		SubjectID:    "3",
+		Grade:        8,
+		Date:         "2021-07-20",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Equal(t, 5, id)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStorage_CreateExam_StudentNotAssignedToCareerError(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT id FROM student WHERE email = ?;`).
+		WithArgs("test@gmail.com").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectQuery(`SELECT COUNT(1) FROM student_career WHERE student_id = ? AND career_id = ?`).
+		WithArgs(1, "2").
+		WillReturnRows(sqlmock.NewRows([]string{"COUNT(1)"}).AddRow(0))
+	mock.ExpectRollback()
+
+	// When
+	_, err = storage_.CreateExam(CreateExamRequest{
+		StudentEmail: "test@gmail.com",
+		CareerID:     "2",
+		SubjectID:    "3",
+		Grade:        8,
+		Date:         "2021-07-20",
+	})
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "could not find student assigned to career: storage: resource not found")
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStorage_CreateExam_BeginTxError(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	mock.ExpectBegin().WillReturnError(errors.New("error"))
+
+	// When
+	_, err = storage_.CreateExam(CreateExamRequest{})
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "could not begin tx: error")
+}
+
+func TestStorage_GetStudentExams(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	q := `SELECT cs.subject_id, e.grade, DATE_FORMAT(e.date, '%Y-%m-%d') date
+FROM exam e
+         INNER JOIN student st ON st.id = e.student_id
+         INNER JOIN career_subject cs ON cs.id = e.career_subject_id
+WHERE st.email = ? AND cs.career_id = ?
+ORDER BY e.date, e.id;`
+	mock.ExpectPrepare(q).WillReturnError(nil)
+	mock.ExpectQuery(q).
+		WithArgs("test@gmail.com", "2").
+		WillReturnError(nil).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"subject_id", "grade", "date"}).
+				AddRow(3, 2, "2021-02-10").
+				AddRow(3, 8, "2021-07-20"))
+
+	// When
+	exams, err := storage_.GetStudentExams("test@gmail.com", "2")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Equal(t, []Exam{
+		{SubjectID: 3, Grade: 2, Date: "2021-02-10"},
+		{SubjectID: 3, Grade: 8, Date: "2021-07-20"},
+	}, exams)
+}
+
+func TestStorage_GetStudentExams_ExecuteStmtError(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	q := `SELECT cs.subject_id, e.grade, DATE_FORMAT(e.date, '%Y-%m-%d') date
+FROM exam e
+         INNER JOIN student st ON st.id = e.student_id
+         INNER JOIN career_subject cs ON cs.id = e.career_subject_id
+WHERE st.email = ? AND cs.career_id = ?
+ORDER BY e.date, e.id;`
+	mock.ExpectPrepare(q).WillReturnError(nil)
+	mock.ExpectQuery(q).WillReturnError(errors.New("error"))
+
+	// When
+	_, err = storage_.GetStudentExams("test@gmail.com", "2")
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "error")
+}
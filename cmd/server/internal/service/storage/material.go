@@ -0,0 +1,172 @@
+package storage
+
+import (
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type Material struct {
+	ID          int
+	URI         string
+	Description string
+}
+
+const getMaterials = `SELECT id, uri, description FROM material WHERE professorship_id = :professorshipID ORDER BY id;`
+
+func (s *Storage) GetMaterials(professorshipID string) ([]Material, error) {
+	stmt, err := s.db.PrepareNamed(getMaterials)
+	if err != nil {
+		return nil, err
+	}
+
+	defer stmt.Close()
+
+	params := map[string]interface{}{"professorshipID": professorshipID}
+
+	var materials []struct {
+		ID          int    `db:"id"`
+		URI         string `db:"uri"`
+		Description string `db:"description"`
+	}
+
+	if err := stmt.Select(&materials, params); err != nil {
+		return nil, err
+	}
+
+	if materials == nil {
+		return nil, ErrNotFound
+	}
+
+	response := make([]Material, 0, len(materials))
+	for _, material := range materials {
+		response = append(response, Material{
+			ID:          material.ID,
+			URI:         material.URI,
+			Description: material.Description,
+		})
+	}
+
+	return response, nil
+}
+
+type CreateMaterialRequest struct {
+	ProfessorshipID string
+	URI             string
+	Description     string
+}
+
+const createMaterial = `INSERT INTO material (professorship_id, uri, description) VALUES (?, ?, ?);`
+
+func (s *Storage) CreateMaterial(req CreateMaterialRequest) (int, error) {
+	tx, err := s.db.Beginx()
+	if err != nil {
+		return 0, fmt.Errorf("could not begin tx: %v", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	if err = s.checkProfessorshipExist(tx, req.ProfessorshipID); err != nil {
+		return 0, err
+	}
+
+	result, err := tx.Exec(createMaterial, req.ProfessorshipID, req.URI, req.Description)
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, fmt.Errorf("could not commit tx: %v", err)
+	}
+
+	return int(id), nil
+}
+
+type UpdateMaterialRequest struct {
+	ProfessorshipID string
+	MaterialID      string
+	URI             string
+	Description     string
+}
+
+const (
+	checkMaterialExist = `SELECT COUNT(1) FROM material WHERE id = ? AND professorship_id = ?;`
+	updateMaterial     = `UPDATE material SET uri = ?, description = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?;`
+)
+
+func (s *Storage) UpdateMaterial(req UpdateMaterialRequest) error {
+	tx, err := s.db.Beginx()
+	if err != nil {
+		return fmt.Errorf("could not begin tx: %v", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var results int
+	if err = tx.Get(&results, checkMaterialExist, req.MaterialID, req.ProfessorshipID); err != nil {
+		return err
+	}
+
+	if results == 0 {
+		err = fmt.Errorf("could not find material: %w", ErrNotFound)
+		return err
+	}
+
+	if _, err = tx.Exec(updateMaterial, req.URI, req.Description, req.MaterialID); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("could not commit tx: %v", err)
+	}
+
+	return nil
+}
+
+const deleteMaterial = `DELETE FROM material WHERE id = ? AND professorship_id = ?;`
+
+func (s *Storage) DeleteMaterial(professorshipID, materialID string) error {
+	result, err := s.db.Exec(deleteMaterial, materialID, professorshipID)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return fmt.Errorf("could not find material: %w", ErrNotFound)
+	}
+
+	return nil
+}
+
+const checkProfessorshipExist = `SELECT COUNT(1) FROM professorship WHERE id = ?;`
+
+func (s *Storage) checkProfessorshipExist(tx *sqlx.Tx, professorshipID string) error {
+	var results int
+	if err := tx.Get(&results, checkProfessorshipExist, professorshipID); err != nil {
+		return err
+	}
+
+	if results == 0 {
+		return fmt.Errorf("could not find professorship: %w", ErrNotFound)
+	}
+
+	return nil
+}
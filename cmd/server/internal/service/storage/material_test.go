@@ -0,0 +1,293 @@
+package storage
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorage_GetMaterials(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	q := `SELECT id, uri, description FROM material WHERE professorship_id = ? ORDER BY id;`
+	mock.ExpectPrepare(q).WillReturnError(nil)
+	mock.ExpectQuery(q).
+		WithArgs("1").
+		WillReturnError(nil).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"id", "uri", "description"}).
+				AddRow(1, "https://drive.google.com/slides", "Diapositivas"))
+
+	// When
+	materials, err := storage_.GetMaterials("1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Equal(t, []Material{{ID: 1, URI: "https://drive.google.com/slides", Description: "Diapositivas"}}, materials)
+}
+
+func TestStorage_GetMaterials_NotFoundError(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	q := `SELECT id, uri, description FROM material WHERE professorship_id = ? ORDER BY id;`
+	mock.ExpectPrepare(q).WillReturnError(nil)
+	mock.ExpectQuery(q).
+		WithArgs("1").
+		WillReturnError(nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "uri", "description"}))
+
+	// When
+	_, err = storage_.GetMaterials("1")
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "storage: resource not found")
+}
+
+func TestStorage_CreateMaterial(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT COUNT(1) FROM professorship WHERE id = ?;`).
+		WithArgs("1").
+		WillReturnRows(sqlmock.NewRows([]string{"COUNT(1)"}).AddRow(1))
+	mock.ExpectExec(`INSERT INTO material (professorship_id, uri, description) VALUES (?, ?, ?);`).
+		WithArgs("1", "https://drive.google.com/slides", "Diapositivas").
+		WillReturnResult(sqlmock.NewResult(7, 1))
+	mock.ExpectCommit()
+
+	// When
+	id, err := storage_.CreateMaterial(CreateMaterialRequest{
+		ProfessorshipID: "1",
+		URI:             "https://drive.google.com/slides",
+		Description:     "Diapositivas",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Equal(t, 7, id)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStorage_CreateMaterial_ProfessorshipNotFoundError(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT COUNT(1) FROM professorship WHERE id = ?;`).
+		WithArgs("1").
+		WillReturnRows(sqlmock.NewRows([]string{"COUNT(1)"}).AddRow(0))
+	mock.ExpectRollback()
+
+	// When
+	_, err = storage_.CreateMaterial(CreateMaterialRequest{
+		ProfessorshipID: "1",
+		URI:             "https://drive.google.com/slides",
+		Description:     "Diapositivas",
+	})
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "could not find professorship: storage: resource not found")
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStorage_CreateMaterial_BeginTxError(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	mock.ExpectBegin().WillReturnError(errors.New("error"))
+
+	// When
+	_, err = storage_.CreateMaterial(CreateMaterialRequest{ProfessorshipID: "1"})
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "could not begin tx: error")
+}
+
+func TestStorage_UpdateMaterial(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT COUNT(1) FROM material WHERE id = ? AND professorship_id = ?;`).
+		WithArgs("7", "1").
+		WillReturnRows(sqlmock.NewRows([]string{"COUNT(1)"}).AddRow(1))
+	mock.ExpectExec(`UPDATE material SET uri = ?, description = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?;`).
+		WithArgs("https://drive.google.com/slides", "Diapositivas", "7").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	// When
+	err = storage_.UpdateMaterial(UpdateMaterialRequest{
+		ProfessorshipID: "1",
+		MaterialID:      "7",
+		URI:             "https://drive.google.com/slides",
+		Description:     "Diapositivas",
+	})
+
+	// Then
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStorage_UpdateMaterial_NotFoundError(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT COUNT(1) FROM material WHERE id = ? AND professorship_id = ?;`).
+		WithArgs("7", "1").
+		WillReturnRows(sqlmock.NewRows([]string{"COUNT(1)"}).AddRow(0))
+	mock.ExpectRollback()
+
+	// When
+	err = storage_.UpdateMaterial(UpdateMaterialRequest{
+		ProfessorshipID: "1",
+		MaterialID:      "7",
+	})
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "could not find material: storage: resource not found")
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStorage_DeleteMaterial(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	mock.ExpectExec(`DELETE FROM material WHERE id = ? AND professorship_id = ?;`).
+		WithArgs("7", "1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	// When
+	err = storage_.DeleteMaterial("1", "7")
+
+	// Then
+	require.NoError(t, err)
+}
+
+func TestStorage_DeleteMaterial_NotFoundError(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	mock.ExpectExec(`DELETE FROM material WHERE id = ? AND professorship_id = ?;`).
+		WithArgs("7", "1").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	// When
+	err = storage_.DeleteMaterial("1", "7")
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "could not find material: storage: resource not found")
+}
+
+func TestStorage_DeleteMaterial_ExecuteStmtError(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	mock.ExpectExec(`DELETE FROM material WHERE id = ? AND professorship_id = ?;`).
+		WillReturnError(errors.New("error"))
+
+	// When
+	err = storage_.DeleteMaterial("1", "7")
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "error")
+}
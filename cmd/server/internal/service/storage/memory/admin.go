@@ -0,0 +1,445 @@
+package memory
+
+import (
+	"fmt"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
+)
+
+func notFound(resource string) error {
+	return fmt.Errorf("could not find %s: %w", resource, storage.ErrNotFound)
+}
+
+func missingReference(resource string) error {
+	return fmt.Errorf("could not find %s reference: %w", resource, storage.ErrNotFound)
+}
+
+func inUse(resource string) error {
+	return fmt.Errorf("%s is referenced by other resources: %w", resource, storage.ErrResourceInUse)
+}
+
+func (s *Storage) CreateFaculty(req storage.FacultyRequest) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f := storage.Faculty{ID: s.nextID("faculty"), Name: req.Name, URI: copyString(req.URI)}
+	s.faculties = append(s.faculties, f)
+	return f.ID, nil
+}
+
+func (s *Storage) UpdateFaculty(facultyID string, req storage.FacultyRequest) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f := s.faculty(id(facultyID))
+	if f == nil {
+		return notFound("faculty")
+	}
+
+	f.Name, f.URI = req.Name, copyString(req.URI)
+	return nil
+}
+
+func (s *Storage) DeleteFaculty(facultyID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, f := range s.faculties {
+		if f.ID != id(facultyID) {
+			continue
+		}
+
+		for _, c := range s.careers {
+			if c.FacultyID == f.ID {
+				return inUse("faculty")
+			}
+		}
+
+		s.faculties = append(s.faculties[:i], s.faculties[i+1:]...)
+		delete(s.policies, f.ID)
+		return nil
+	}
+
+	return notFound("faculty")
+}
+
+func (s *Storage) CreateCareer(req storage.CareerRequest) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.faculty(req.FacultyID) == nil {
+		return 0, missingReference("career")
+	}
+
+	c := storage.Career{ID: s.nextID("career"), FacultyID: req.FacultyID, Name: req.Name, URI: copyString(req.URI)}
+	s.careers = append(s.careers, c)
+	return c.ID, nil
+}
+
+func (s *Storage) UpdateCareer(careerID string, req storage.CareerRequest) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c := s.career(id(careerID))
+	if c == nil {
+		return notFound("career")
+	}
+
+	if s.faculty(req.FacultyID) == nil {
+		return missingReference("career")
+	}
+
+	c.FacultyID, c.Name, c.URI = req.FacultyID, req.Name, copyString(req.URI)
+	return nil
+}
+
+func (s *Storage) DeleteCareer(careerID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, c := range s.careers {
+		if c.ID != id(careerID) {
+			continue
+		}
+
+		for _, cs := range s.careerSubjects {
+			if cs.careerID == c.ID {
+				return inUse("career")
+			}
+		}
+
+		for _, sc := range s.studentCareers {
+			if sc.careerID == c.ID {
+				return inUse("career")
+			}
+		}
+
+		s.careers = append(s.careers[:i], s.careers[i+1:]...)
+		return nil
+	}
+
+	return notFound("career")
+}
+
+func (s *Storage) CreateSubject(req storage.SubjectRequest) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.createSubject(req.Name, copyString(req.URI), copyString(req.Meet)), nil
+}
+
+func (s *Storage) createSubject(name string, uri, meet *string) int {
+	sub := subject{id: s.nextID("subject"), name: name, uri: uri, meet: meet}
+	s.subjects = append(s.subjects, sub)
+	return sub.id
+}
+
+func (s *Storage) UpdateSubject(subjectID string, req storage.SubjectRequest) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sub := s.subject(id(subjectID))
+	if sub == nil {
+		return notFound("subject")
+	}
+
+	sub.name, sub.uri, sub.meet = req.Name, copyString(req.URI), copyString(req.Meet)
+	return nil
+}
+
+func (s *Storage) DeleteSubject(subjectID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, sub := range s.subjects {
+		if sub.id != id(subjectID) {
+			continue
+		}
+
+		for _, cs := range s.careerSubjects {
+			if cs.subjectID == sub.id {
+				return inUse("subject")
+			}
+		}
+
+		s.subjects = append(s.subjects[:i], s.subjects[i+1:]...)
+		return nil
+	}
+
+	return notFound("subject")
+}
+
+func (s *Storage) CreateCareerSubject(req storage.CareerSubjectRequest) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.careerSubject(id(req.CareerID), id(req.SubjectID)) != nil {
+		return 0, fmt.Errorf("career subject already exist: %w", storage.ErrResourceAlreadyExist)
+	}
+
+	if s.career(id(req.CareerID)) == nil || s.subject(id(req.SubjectID)) == nil {
+		return 0, missingReference("career subject")
+	}
+
+	return s.createCareerSubject(id(req.CareerID), id(req.SubjectID), req.Hours, req.Type, req.Points), nil
+}
+
+func (s *Storage) createCareerSubject(careerID, subjectID int, hours *int, kind *string, points *int) int {
+	cs := careerSubject{
+		id:        s.nextID("career_subject"),
+		careerID:  careerID,
+		subjectID: subjectID,
+		hours:     copyInt(hours),
+		kind:      copyString(kind),
+		points:    copyInt(points),
+	}
+
+	s.careerSubjects = append(s.careerSubjects, cs)
+	return cs.id
+}
+
+func (s *Storage) UpdateCareerSubject(req storage.CareerSubjectRequest) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.careerSubject(id(req.CareerID), id(req.SubjectID)) == nil {
+		return notFound("career subject")
+	}
+
+	for i := range s.careerSubjects {
+		cs := &s.careerSubjects[i]
+		if cs.careerID == id(req.CareerID) && cs.subjectID == id(req.SubjectID) {
+			cs.hours, cs.kind, cs.points = copyInt(req.Hours), copyString(req.Type), copyInt(req.Points)
+		}
+	}
+
+	return nil
+}
+
+func (s *Storage) DeleteCareerSubject(careerID, subjectID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var careerSubjects []careerSubject
+	deleted := map[int]bool{}
+	for _, cs := range s.careerSubjects {
+		if cs.careerID == id(careerID) && cs.subjectID == id(subjectID) {
+			deleted[cs.id] = true
+			continue
+		}
+
+		careerSubjects = append(careerSubjects, cs)
+	}
+
+	if len(deleted) == 0 {
+		return notFound("career subject")
+	}
+
+	if s.careerSubjectReferenced(deleted) {
+		return inUse("career subject")
+	}
+
+	s.careerSubjects = careerSubjects
+	return nil
+}
+
+func (s *Storage) careerSubjectReferenced(careerSubjectIDs map[int]bool) bool {
+	for _, c := range s.correlatives {
+		if careerSubjectIDs[c.careerSubjectID] || careerSubjectIDs[c.correlativeCareerSubjectID] {
+			return true
+		}
+	}
+
+	for _, p := range s.professorships {
+		if careerSubjectIDs[p.careerSubjectID] {
+			return true
+		}
+	}
+
+	for _, ss := range s.studentSubjects {
+		if careerSubjectIDs[ss.careerSubjectID] {
+			return true
+		}
+	}
+
+	for _, e := range s.exams {
+		if careerSubjectIDs[e.careerSubjectID] {
+			return true
+		}
+	}
+
+	for _, e := range s.equivalences {
+		if careerSubjectIDs[e.careerSubjectID] || careerSubjectIDs[e.equivalentCareerSubjectID] {
+			return true
+		}
+	}
+
+	for _, e := range s.studentEquivalences {
+		if careerSubjectIDs[e.careerSubjectID] || careerSubjectIDs[e.sourceCareerSubjectID] {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *Storage) CreateProfessorship(req storage.CreateProfessorshipRequest) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cs := s.careerSubject(id(req.CareerID), id(req.SubjectID))
+	if cs == nil {
+		return 0, fmt.Errorf("could not find career and subject: %w", storage.ErrNotFound)
+	}
+
+	if req.TermID != nil && s.term(*req.TermID) == nil {
+		return 0, missingReference("professorship")
+	}
+
+	return s.createProfessorship(cs.id, req.TermID, req.Name), nil
+}
+
+func (s *Storage) createProfessorship(careerSubjectID int, termID *int, name string) int {
+	p := professorship{id: s.nextID("professorship"), careerSubjectID: careerSubjectID, termID: copyInt(termID), name: name}
+	s.professorships = append(s.professorships, p)
+	return p.id
+}
+
+func (s *Storage) UpdateProfessorship(req storage.UpdateProfessorshipRequest) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p := s.professorship(id(req.ProfessorshipID))
+	if p == nil {
+		return notFound("professorship")
+	}
+
+	if req.TermID != nil && s.term(*req.TermID) == nil {
+		return missingReference("professorship")
+	}
+
+	// The enrollments move along with the term, as the unique key of the SQL storage requires.
+	for _, e := range s.enrollments {
+		if e.professorshipID != p.id {
+			continue
+		}
+
+		for _, other := range s.enrollments {
+			enrolled := s.professorship(other.professorshipID)
+			if other.studentID == e.studentID && enrolled.id != p.id && enrolled.careerSubjectID == p.careerSubjectID && sameTerm(enrolled.termID, req.TermID) {
+				return fmt.Errorf("professorship already exist: %w", storage.ErrResourceAlreadyExist)
+			}
+		}
+	}
+
+	p.name, p.termID = req.Name, copyInt(req.TermID)
+	return nil
+}
+
+// DeleteProfessorship removes the professorship with its schedules, materials and professors. Professorships with
+// enrolled students can't be deleted.
+func (s *Storage) DeleteProfessorship(professorshipID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p := s.professorship(id(professorshipID))
+	if p == nil {
+		return notFound("professorship")
+	}
+
+	for _, e := range s.enrollments {
+		if e.professorshipID == p.id {
+			return inUse("professorship")
+		}
+	}
+
+	s.deleteProfessorshipSchedules(p.id)
+
+	var materials []material
+	for _, m := range s.materials {
+		if m.professorshipID != p.id {
+			materials = append(materials, m)
+		}
+	}
+
+	var professors []professorshipProfessor
+	for _, pp := range s.professorshipProfessors {
+		if pp.professorshipID != p.id {
+			professors = append(professors, pp)
+		}
+	}
+
+	var professorships []professorship
+	for _, other := range s.professorships {
+		if other.id != p.id {
+			professorships = append(professorships, other)
+		}
+	}
+
+	s.materials, s.professorshipProfessors, s.professorships = materials, professors, professorships
+	return nil
+}
+
+func (s *Storage) deleteProfessorshipSchedules(professorshipID int) {
+	var schedules []schedule
+	for _, sch := range s.schedules {
+		if sch.professorshipID != professorshipID {
+			schedules = append(schedules, sch)
+		}
+	}
+
+	s.schedules = schedules
+}
+
+func (s *Storage) CreateSchedule(req storage.ScheduleRequest) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.professorship(id(req.ProfessorshipID)) == nil {
+		return 0, missingReference("professorship")
+	}
+
+	return s.createSchedule(id(req.ProfessorshipID), req.Day, req.Start, req.End), nil
+}
+
+func (s *Storage) createSchedule(professorshipID, day int, start, end string) int {
+	sch := schedule{id: s.nextID("schedule"), professorshipID: professorshipID, day: day, start: start, end: end}
+	s.schedules = append(s.schedules, sch)
+	return sch.id
+}
+
+func (s *Storage) schedule(professorshipID, scheduleID string) int {
+	for i, sch := range s.schedules {
+		if sch.id == id(scheduleID) && sch.professorshipID == id(professorshipID) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func (s *Storage) UpdateSchedule(req storage.ScheduleRequest) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := s.schedule(req.ProfessorshipID, req.ScheduleID)
+	if i < 0 {
+		return notFound("schedule")
+	}
+
+	s.schedules[i].day, s.schedules[i].start, s.schedules[i].end = req.Day, req.Start, req.End
+	return nil
+}
+
+func (s *Storage) DeleteSchedule(professorshipID, scheduleID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := s.schedule(professorshipID, scheduleID)
+	if i < 0 {
+		return notFound("schedule")
+	}
+
+	s.schedules = append(s.schedules[:i], s.schedules[i+1:]...)
+	return nil
+}
@@ -0,0 +1,439 @@
+package memory
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
+)
+
+func (s *Storage) GetFaculties() ([]storage.Faculty, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.faculties) == 0 {
+		return nil, storage.ErrNotFound
+	}
+
+	response := make([]storage.Faculty, 0, len(s.faculties))
+	for _, f := range s.faculties {
+		response = append(response, storage.Faculty{ID: f.ID, Name: f.Name, URI: copyString(f.URI)})
+	}
+
+	return response, nil
+}
+
+func (s *Storage) GetFacultyCareers(facultyID string) ([]storage.Career, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var response []storage.Career
+	for _, c := range s.careers {
+		if c.FacultyID == id(facultyID) {
+			response = append(response, storage.Career{ID: c.ID, FacultyID: c.FacultyID, Name: c.Name, URI: copyString(c.URI)})
+		}
+	}
+
+	if response == nil {
+		return nil, storage.ErrNotFound
+	}
+
+	return response, nil
+}
+
+func (s *Storage) GetCareer(careerID string) (storage.Career, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c := s.career(id(careerID))
+	if c == nil {
+		return storage.Career{}, storage.ErrNotFound
+	}
+
+	return storage.Career{ID: c.ID, FacultyID: c.FacultyID, Name: c.Name, URI: copyString(c.URI)}, nil
+}
+
+func (s *Storage) GetSubjectDetails(subjectID, careerID string) (storage.SubjectDetails, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cs := s.careerSubject(id(careerID), id(subjectID))
+	if cs == nil {
+		return storage.SubjectDetails{}, storage.ErrNotFound
+	}
+
+	sub := s.subject(cs.subjectID)
+	return storage.SubjectDetails{
+		ID:     sub.id,
+		Name:   sub.name,
+		Type:   stringValue(cs.kind),
+		URI:    copyString(sub.uri),
+		Meet:   copyString(sub.meet),
+		Hours:  copyInt(cs.hours),
+		Points: copyInt(cs.points),
+	}, nil
+}
+
+func (s *Storage) GetCorrelatives(careerID string) ([]storage.Correlative, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.careerCorrelatives(id(careerID)), nil
+}
+
+func (s *Storage) careerCorrelatives(careerID int) []storage.Correlative {
+	response := []storage.Correlative{}
+	for _, c := range s.correlatives {
+		cs := s.careerSubjectByID(c.careerSubjectID)
+		if cs.careerID != careerID {
+			continue
+		}
+
+		response = append(response, storage.Correlative{
+			SubjectID:     cs.subjectID,
+			CorrelativeID: s.careerSubjectByID(c.correlativeCareerSubjectID).subjectID,
+			Requirement:   c.requirement,
+		})
+	}
+
+	sort.SliceStable(response, func(i, j int) bool {
+		if response[i].SubjectID != response[j].SubjectID {
+			return response[i].SubjectID < response[j].SubjectID
+		}
+
+		return response[i].CorrelativeID < response[j].CorrelativeID
+	})
+
+	return response
+}
+
+func (s *Storage) professorshipSchedules(professorshipID int) []schedule {
+	var schedules []schedule
+	for _, sch := range s.schedules {
+		if sch.professorshipID == professorshipID {
+			schedules = append(schedules, sch)
+		}
+	}
+
+	return schedules
+}
+
+func (s *Storage) GetProfessorships(subjectID, careerID, termID string) ([]storage.Professorship, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var response []storage.Professorship
+	for _, p := range s.professorships {
+		cs := s.careerSubjectByID(p.careerSubjectID)
+		if cs.subjectID != id(subjectID) || cs.careerID != id(careerID) {
+			continue
+		}
+
+		if termID != "" && p.termID != nil && *p.termID != id(termID) {
+			continue
+		}
+
+		schedules := s.professorshipSchedules(p.id)
+		if len(schedules) == 0 {
+			response = append(response, storage.Professorship{ID: p.id, Name: p.name})
+		}
+
+		for _, sch := range schedules {
+			response = append(response, storage.Professorship{ID: p.id, Day: copyInt(&sch.day), Name: p.name, Start: copyString(&sch.start), End: copyString(&sch.end)})
+		}
+	}
+
+	if response == nil {
+		return nil, storage.ErrNotFound
+	}
+
+	sort.SliceStable(response, func(i, j int) bool {
+		// Professorships without schedules go first, as NULLs do in the database.
+		a, b := response[i], response[j]
+		if a.Day == nil || b.Day == nil {
+			return a.Day == nil && b.Day != nil
+		}
+
+		return *a.Day < *b.Day
+	})
+
+	return response, nil
+}
+
+func (s *Storage) GetProfessorshipsSchedules(professorshipIDs []int) ([]storage.ProfessorshipSchedule, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var response []storage.ProfessorshipSchedule
+	for _, professorshipID := range professorshipIDs {
+		p := s.professorship(professorshipID)
+		if p == nil {
+			continue
+		}
+
+		sub := s.subject(s.careerSubjectByID(p.careerSubjectID).subjectID)
+		schedules := s.professorshipSchedules(p.id)
+		if len(schedules) == 0 {
+			response = append(response, storage.ProfessorshipSchedule{
+				ProfessorshipID: p.id,
+				Name:            p.name,
+				SubjectID:       sub.id,
+				SubjectName:     sub.name,
+			})
+		}
+
+		for _, sch := range schedules {
+			response = append(response, storage.ProfessorshipSchedule{
+				ProfessorshipID: p.id,
+				Name:            p.name,
+				SubjectID:       sub.id,
+				SubjectName:     sub.name,
+				Day:             copyInt(&sch.day),
+				Start:           copyString(&sch.start),
+				End:             copyString(&sch.end),
+			})
+		}
+	}
+
+	if response == nil {
+		return nil, storage.ErrNotFound
+	}
+
+	sort.SliceStable(response, func(i, j int) bool {
+		// Professorships without schedules go first, as NULLs do in the database.
+		a, b := response[i], response[j]
+		if a.Day == nil || b.Day == nil {
+			if (a.Day == nil) != (b.Day == nil) {
+				return a.Day == nil
+			}
+
+			return a.ProfessorshipID < b.ProfessorshipID
+		}
+
+		if *a.Day != *b.Day {
+			return *a.Day < *b.Day
+		}
+
+		if *a.Start != *b.Start {
+			return *a.Start < *b.Start
+		}
+
+		return a.ProfessorshipID < b.ProfessorshipID
+	})
+
+	return response, nil
+}
+
+func (s *Storage) GetProfessorshipsProfessors(subjectID, careerID string) ([]storage.ProfessorshipProfessor, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	response := []storage.ProfessorshipProfessor{}
+	for _, pp := range s.professorshipProfessors {
+		cs := s.careerSubjectByID(s.professorship(pp.professorshipID).careerSubjectID)
+		if cs.subjectID != id(subjectID) || cs.careerID != id(careerID) {
+			continue
+		}
+
+		response = append(response, storage.ProfessorshipProfessor{
+			ProfessorshipID: pp.professorshipID,
+			ID:              pp.professorID,
+			Name:            s.professor(pp.professorID).Name,
+			Role:            pp.role,
+		})
+	}
+
+	return response, nil
+}
+
+func (s *Storage) GetProfessor(professorID string) (storage.Professor, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p := s.professor(id(professorID))
+	if p == nil {
+		return storage.Professor{}, storage.ErrNotFound
+	}
+
+	return *p, nil
+}
+
+func (s *Storage) GetProfessorProfessorships(professorID string) ([]storage.ProfessorProfessorship, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	response := []storage.ProfessorProfessorship{}
+	for _, pp := range s.professorshipProfessors {
+		if pp.professorID != id(professorID) {
+			continue
+		}
+
+		p := s.professorship(pp.professorshipID)
+		cs := s.careerSubjectByID(p.careerSubjectID)
+		response = append(response, storage.ProfessorProfessorship{
+			ID:          p.id,
+			Name:        p.name,
+			Role:        pp.role,
+			SubjectID:   cs.subjectID,
+			SubjectName: s.subject(cs.subjectID).name,
+			CareerID:    cs.careerID,
+			CareerName:  s.career(cs.careerID).Name,
+		})
+	}
+
+	sort.SliceStable(response, func(i, j int) bool {
+		a, b := response[i], response[j]
+		if a.CareerID != b.CareerID {
+			return a.CareerID < b.CareerID
+		}
+
+		if a.SubjectID != b.SubjectID {
+			return a.SubjectID < b.SubjectID
+		}
+
+		return a.ID < b.ID
+	})
+
+	return response, nil
+}
+
+func (s *Storage) GetMaterials(professorshipID string) ([]storage.Material, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var response []storage.Material
+	for _, m := range s.materials {
+		if m.professorshipID == id(professorshipID) {
+			response = append(response, storage.Material{ID: m.id, URI: m.uri, Description: m.description})
+		}
+	}
+
+	if response == nil {
+		return nil, storage.ErrNotFound
+	}
+
+	return response, nil
+}
+
+func (s *Storage) CreateMaterial(req storage.CreateMaterialRequest) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.professorship(id(req.ProfessorshipID)) == nil {
+		return 0, fmt.Errorf("could not find professorship: %w", storage.ErrNotFound)
+	}
+
+	m := material{id: s.nextID("material"), professorshipID: id(req.ProfessorshipID), uri: req.URI, description: req.Description}
+	s.materials = append(s.materials, m)
+	return m.id, nil
+}
+
+func (s *Storage) material(professorshipID, materialID string) int {
+	for i, m := range s.materials {
+		if m.id == id(materialID) && m.professorshipID == id(professorshipID) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func (s *Storage) UpdateMaterial(req storage.UpdateMaterialRequest) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := s.material(req.ProfessorshipID, req.MaterialID)
+	if i < 0 {
+		return fmt.Errorf("could not find material: %w", storage.ErrNotFound)
+	}
+
+	s.materials[i].uri = req.URI
+	s.materials[i].description = req.Description
+	return nil
+}
+
+func (s *Storage) DeleteMaterial(professorshipID, materialID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := s.material(professorshipID, materialID)
+	if i < 0 {
+		return fmt.Errorf("could not find material: %w", storage.ErrNotFound)
+	}
+
+	s.materials = append(s.materials[:i], s.materials[i+1:]...)
+	return nil
+}
+
+func (s *Storage) CreateTerm(req storage.TermRequest) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.termExist(0, req.Year, req.Cuatrimestre) {
+		return 0, fmt.Errorf("term already exist: %w", storage.ErrResourceAlreadyExist)
+	}
+
+	t := storage.Term{ID: s.nextID("term"), Year: req.Year, Cuatrimestre: req.Cuatrimestre, Start: req.Start, End: req.End}
+	s.terms = append(s.terms, t)
+	return t.ID, nil
+}
+
+func (s *Storage) UpdateTerm(termID string, req storage.TermRequest) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t := s.term(id(termID))
+	if t == nil {
+		return notFound("term")
+	}
+
+	if s.termExist(t.ID, req.Year, req.Cuatrimestre) {
+		return fmt.Errorf("term already exist: %w", storage.ErrResourceAlreadyExist)
+	}
+
+	t.Year, t.Cuatrimestre, t.Start, t.End = req.Year, req.Cuatrimestre, req.Start, req.End
+	return nil
+}
+
+// termExist reports whether a term other than exceptID has the given year and cuatrimestre.
+func (s *Storage) termExist(exceptID, year, cuatrimestre int) bool {
+	for _, t := range s.terms {
+		if t.ID != exceptID && t.Year == year && t.Cuatrimestre == cuatrimestre {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *Storage) GetTerm(year, cuatrimestre int) (storage.Term, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, t := range s.terms {
+		if t.Year == year && t.Cuatrimestre == cuatrimestre {
+			return t, nil
+		}
+	}
+
+	return storage.Term{}, storage.ErrNotFound
+}
+
+// GetCurrentTerm compares the dates as strings, since they are formatted as YYYY-MM-DD.
+func (s *Storage) GetCurrentTerm(date string) (storage.Term, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var current *storage.Term
+	for i, t := range s.terms {
+		if t.Start <= date && t.End >= date && (current == nil || t.Start > current.Start) {
+			current = &s.terms[i]
+		}
+	}
+
+	if current == nil {
+		return storage.Term{}, storage.ErrNotFound
+	}
+
+	return *current, nil
+}
@@ -0,0 +1,289 @@
+package memory
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
+)
+
+func (s *Storage) CreateEquivalence(req storage.EquivalenceRequest) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cs := s.careerSubject(id(req.CareerID), id(req.SubjectID))
+	equivalent := s.careerSubject(id(req.EquivalentCareerID), id(req.EquivalentSubjectID))
+	if cs == nil || equivalent == nil {
+		return 0, fmt.Errorf("could not find career and subject: %w", storage.ErrNotFound)
+	}
+
+	if s.equivalent(cs.id, equivalent.id) {
+		return 0, fmt.Errorf("equivalence already exist: %w", storage.ErrResourceAlreadyExist)
+	}
+
+	e := careerEquivalence{id: s.nextID("career_subject_equivalence"), careerSubjectID: cs.id, equivalentCareerSubjectID: equivalent.id}
+	s.equivalences = append(s.equivalences, e)
+	return e.id, nil
+}
+
+func (s *Storage) equivalent(careerSubjectID, equivalentCareerSubjectID int) bool {
+	for _, e := range s.equivalences {
+		if (e.careerSubjectID == careerSubjectID && e.equivalentCareerSubjectID == equivalentCareerSubjectID) ||
+			(e.careerSubjectID == equivalentCareerSubjectID && e.equivalentCareerSubjectID == careerSubjectID) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *Storage) DeleteEquivalence(equivalenceID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, e := range s.equivalences {
+		if e.id == id(equivalenceID) {
+			s.equivalences = append(s.equivalences[:i], s.equivalences[i+1:]...)
+			return nil
+		}
+	}
+
+	return notFound("equivalence")
+}
+
+func (s *Storage) GetEquivalences(careerID string) ([]storage.Equivalence, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	response := []storage.Equivalence{}
+	for _, e := range s.equivalences {
+		cs, equivalent := s.careerSubjectByID(e.careerSubjectID), s.careerSubjectByID(e.equivalentCareerSubjectID)
+		if equivalent.careerID == id(careerID) {
+			cs, equivalent = equivalent, cs
+		} else if cs.careerID != id(careerID) {
+			continue
+		}
+
+		response = append(response, storage.Equivalence{
+			ID:                    e.id,
+			CareerID:              cs.careerID,
+			SubjectID:             cs.subjectID,
+			SubjectName:           s.subject(cs.subjectID).name,
+			EquivalentCareerID:    equivalent.careerID,
+			EquivalentSubjectID:   equivalent.subjectID,
+			EquivalentSubjectName: s.subject(equivalent.subjectID).name,
+		})
+	}
+
+	return response, nil
+}
+
+// AssignStudentToCareerWithCredits assigns the career to the student and approves the subjects of the career
+// equivalent to the ones approved in other careers, matching them in the same order as the MySQL storage does.
+func (s *Storage) AssignStudentToCareerWithCredits(studentEmail, careerID, creditedAt string) ([]storage.StudentEquivalence, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st := s.studentByEmail(studentEmail)
+	if st == nil {
+		return nil, notFound("student")
+	}
+
+	if s.career(id(careerID)) == nil {
+		return nil, fmt.Errorf("could not find career: %w", storage.ErrNotFound)
+	}
+
+	s.studentCareers = append(s.studentCareers, studentCareer{studentID: st.id, careerID: id(careerID)})
+
+	var targets []careerSubject
+	for _, cs := range s.careerSubjects {
+		if cs.careerID == id(careerID) && !s.approved(st.id, cs.id) {
+			targets = append(targets, cs)
+		}
+	}
+
+	var approved []studentSubject
+	for _, ss := range s.studentSubjects {
+		if ss.studentID == st.id && ss.status == "APROBADA" && s.careerSubjectByID(ss.careerSubjectID).careerID != id(careerID) {
+			approved = append(approved, ss)
+		}
+	}
+
+	sort.SliceStable(targets, func(i, j int) bool {
+		if targets[i].subjectID != targets[j].subjectID {
+			return targets[i].subjectID < targets[j].subjectID
+		}
+
+		return targets[i].id < targets[j].id
+	})
+
+	sort.SliceStable(approved, func(i, j int) bool {
+		a, b := s.careerSubjectByID(approved[i].careerSubjectID), s.careerSubjectByID(approved[j].careerSubjectID)
+		if a.careerID != b.careerID {
+			return a.careerID < b.careerID
+		}
+
+		if a.subjectID != b.subjectID {
+			return a.subjectID < b.subjectID
+		}
+
+		return a.id < b.id
+	})
+
+	credits := []storage.StudentEquivalence{}
+	for i, target := range targets {
+		if i > 0 && targets[i-1].subjectID == target.subjectID {
+			continue
+		}
+
+		for _, ss := range approved {
+			source := s.careerSubjectByID(ss.careerSubjectID)
+			if source.subjectID != target.subjectID && !s.equivalent(target.id, source.id) {
+				continue
+			}
+
+			s.approveStudentSubject(st.id, target.id, ss.termID)
+			e := s.createStudentEquivalence(st.id, target.id, source.id, "APROBADA", true, creditedAt, &creditedAt)
+			credits = append(credits, s.studentEquivalenceResponse(e))
+			break
+		}
+	}
+
+	return credits, nil
+}
+
+func (s *Storage) approved(studentID, careerSubjectID int) bool {
+	for _, ss := range s.studentSubjects {
+		if ss.studentID == studentID && ss.careerSubjectID == careerSubjectID && ss.status == "APROBADA" {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *Storage) createStudentEquivalence(studentID, careerSubjectID, sourceCareerSubjectID int, status string, automatic bool, createdAt string, resolvedAt *string) studentEquivalence {
+	e := studentEquivalence{
+		id:                    s.nextID("student_equivalence"),
+		studentID:             studentID,
+		careerSubjectID:       careerSubjectID,
+		sourceCareerSubjectID: sourceCareerSubjectID,
+		status:                status,
+		automatic:             automatic,
+		createdAt:             createdAt,
+		resolvedAt:            copyString(resolvedAt),
+	}
+
+	s.studentEquivalences = append(s.studentEquivalences, e)
+	return e
+}
+
+func (s *Storage) studentEquivalenceResponse(e studentEquivalence) storage.StudentEquivalence {
+	cs, source := s.careerSubjectByID(e.careerSubjectID), s.careerSubjectByID(e.sourceCareerSubjectID)
+	return storage.StudentEquivalence{
+		ID:                e.id,
+		StudentEmail:      s.student(e.studentID).email,
+		CareerID:          cs.careerID,
+		SubjectID:         cs.subjectID,
+		SubjectName:       s.subject(cs.subjectID).name,
+		SourceCareerID:    source.careerID,
+		SourceSubjectID:   source.subjectID,
+		SourceSubjectName: s.subject(source.subjectID).name,
+		Status:            e.status,
+		Automatic:         e.automatic,
+		CreatedAt:         e.createdAt,
+		ResolvedAt:        copyString(e.resolvedAt),
+	}
+}
+
+func (s *Storage) CreateStudentEquivalence(req storage.StudentEquivalenceRequest) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st, cs, err := s.studentCareerSubject(req.StudentEmail, req.CareerID, req.SubjectID)
+	if err != nil {
+		return 0, err
+	}
+
+	source := s.careerSubject(id(req.SourceCareerID), id(req.SourceSubjectID))
+	if source == nil {
+		return 0, fmt.Errorf("could not find career and subject: %w", storage.ErrNotFound)
+	}
+
+	if !s.approved(st.id, source.id) {
+		return 0, fmt.Errorf("could not find approved source subject: %w", storage.ErrNotFound)
+	}
+
+	if s.approved(st.id, cs.id) {
+		return 0, fmt.Errorf("student subject already approved: %w", storage.ErrResourceAlreadyExist)
+	}
+
+	for _, e := range s.studentEquivalences {
+		if e.studentID == st.id && e.careerSubjectID == cs.id && e.status == "PENDIENTE" {
+			return 0, fmt.Errorf("equivalence request already exist: %w", storage.ErrResourceAlreadyExist)
+		}
+	}
+
+	return s.createStudentEquivalence(st.id, cs.id, source.id, "PENDIENTE", false, req.CreatedAt, nil).id, nil
+}
+
+func (s *Storage) GetStudentEquivalences(studentEmail, careerID string) ([]storage.StudentEquivalence, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	response := []storage.StudentEquivalence{}
+	st := s.studentByEmail(studentEmail)
+	if st == nil {
+		return response, nil
+	}
+
+	for _, e := range s.studentEquivalences {
+		if e.studentID == st.id && s.careerSubjectByID(e.careerSubjectID).careerID == id(careerID) {
+			response = append(response, s.studentEquivalenceResponse(e))
+		}
+	}
+
+	return response, nil
+}
+
+func (s *Storage) GetEquivalenceRequests(status string) ([]storage.StudentEquivalence, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	response := []storage.StudentEquivalence{}
+	for _, e := range s.studentEquivalences {
+		if e.status == status && !e.automatic {
+			response = append(response, s.studentEquivalenceResponse(e))
+		}
+	}
+
+	return response, nil
+}
+
+func (s *Storage) ResolveEquivalenceRequest(req storage.ResolveEquivalenceRequest) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i := range s.studentEquivalences {
+		e := &s.studentEquivalences[i]
+		if e.id != id(req.RequestID) || e.status != "PENDIENTE" {
+			continue
+		}
+
+		e.status, e.resolvedAt = req.Status, copyString(&req.ResolvedAt)
+		if req.Status == "APROBADA" {
+			var termID *int
+			for _, ss := range s.studentSubjects {
+				if ss.studentID == e.studentID && ss.careerSubjectID == e.sourceCareerSubjectID {
+					termID = ss.termID
+				}
+			}
+
+			s.approveStudentSubject(e.studentID, e.careerSubjectID, termID)
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("could not find pending equivalence request: %w", storage.ErrNotFound)
+}
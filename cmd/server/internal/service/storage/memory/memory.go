@@ -0,0 +1,346 @@
+// Package memory implements the service storage in memory with the semantics of the MySQL storage, so the
+// service can be tested without a database.
+package memory
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
+)
+
+type (
+	subject struct {
+		id   int
+		name string
+		uri  *string
+		meet *string
+	}
+
+	careerSubject struct {
+		id        int
+		careerID  int
+		subjectID int
+		hours     *int
+		kind      *string
+		points    *int
+	}
+
+	correlative struct {
+		careerSubjectID            int
+		correlativeCareerSubjectID int
+		requirement                string
+	}
+
+	professorship struct {
+		id              int
+		careerSubjectID int
+		termID          *int
+		name            string
+	}
+
+	schedule struct {
+		id              int
+		professorshipID int
+		day             int
+		start           string
+		end             string
+	}
+
+	material struct {
+		id              int
+		professorshipID int
+		uri             string
+		description     string
+	}
+
+	professorshipProfessor struct {
+		professorshipID int
+		professorID     int
+		role            string
+	}
+
+	student struct {
+		id                int
+		name              string
+		email             string
+		passwordHash      string
+		role              string
+		calendarTokenHash *string
+	}
+
+	studentCareer struct {
+		studentID int
+		careerID  int
+	}
+
+	studentSubject struct {
+		studentID       int
+		careerSubjectID int
+		status          string
+		description     *string
+		termID          *int
+	}
+
+	exam struct {
+		id              int
+		studentID       int
+		careerSubjectID int
+		grade           int
+		date            string
+	}
+
+	enrollment struct {
+		studentID       int
+		professorshipID int
+	}
+
+	emailChange struct {
+		id        int
+		studentID int
+		oldEmail  string
+		newEmail  string
+		changedAt string
+	}
+
+	careerEquivalence struct {
+		id                        int
+		careerSubjectID           int
+		equivalentCareerSubjectID int
+	}
+
+	studentEquivalence struct {
+		id                    int
+		studentID             int
+		careerSubjectID       int
+		sourceCareerSubjectID int
+		status                string
+		automatic             bool
+		createdAt             string
+		resolvedAt            *string
+	}
+)
+
+// Storage keeps a table per resource, ordered by id. It's safe for concurrent use.
+type Storage struct {
+	mu  sync.Mutex
+	ids map[string]int
+
+	// policies keeps the faculties that changed the default policy.
+	policies map[int]storage.FacultyPolicy
+
+	faculties               []storage.Faculty
+	careers                 []storage.Career
+	subjects                []subject
+	careerSubjects          []careerSubject
+	correlatives            []correlative
+	terms                   []storage.Term
+	professorships          []professorship
+	schedules               []schedule
+	materials               []material
+	professors              []storage.Professor
+	professorshipProfessors []professorshipProfessor
+	students                []student
+	studentCareers          []studentCareer
+	studentSubjects         []studentSubject
+	exams                   []exam
+	enrollments             []enrollment
+	emailChanges            []emailChange
+	equivalences            []careerEquivalence
+	studentEquivalences     []studentEquivalence
+}
+
+func NewStorage() *Storage {
+	return &Storage{ids: map[string]int{}, policies: map[int]storage.FacultyPolicy{}}
+}
+
+// CreateProfessor adds a professor, which the service storage can only read.
+func (s *Storage) CreateProfessor(name string) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p := storage.Professor{ID: s.nextID("professor"), Name: name}
+	s.professors = append(s.professors, p)
+	return p.ID, nil
+}
+
+// AssignProfessor adds the professor to the staff of the professorship with the given role.
+func (s *Storage) AssignProfessor(professorshipID, professorID int, role string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.professorship(professorshipID) == nil || s.professor(professorID) == nil {
+		return fmt.Errorf("could not find professorship professor reference: %w", storage.ErrNotFound)
+	}
+
+	s.professorshipProfessors = append(s.professorshipProfessors, professorshipProfessor{professorshipID: professorshipID, professorID: professorID, role: role})
+	return nil
+}
+
+func (s *Storage) nextID(table string) int {
+	s.ids[table]++
+	return s.ids[table]
+}
+
+// id parses the ids received as strings. Invalid ids match no resource, as in MySQL where they are compared as 0.
+func id(value string) int {
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0
+	}
+
+	return n
+}
+
+func copyString(value *string) *string {
+	if value == nil {
+		return nil
+	}
+
+	v := *value
+	return &v
+}
+
+func copyInt(value *int) *int {
+	if value == nil {
+		return nil
+	}
+
+	v := *value
+	return &v
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+
+	return *value
+}
+
+func (s *Storage) faculty(facultyID int) *storage.Faculty {
+	for i := range s.faculties {
+		if s.faculties[i].ID == facultyID {
+			return &s.faculties[i]
+		}
+	}
+
+	return nil
+}
+
+func (s *Storage) career(careerID int) *storage.Career {
+	for i := range s.careers {
+		if s.careers[i].ID == careerID {
+			return &s.careers[i]
+		}
+	}
+
+	return nil
+}
+
+func (s *Storage) subject(subjectID int) *subject {
+	for i := range s.subjects {
+		if s.subjects[i].id == subjectID {
+			return &s.subjects[i]
+		}
+	}
+
+	return nil
+}
+
+func (s *Storage) careerSubjectByID(careerSubjectID int) *careerSubject {
+	for i := range s.careerSubjects {
+		if s.careerSubjects[i].id == careerSubjectID {
+			return &s.careerSubjects[i]
+		}
+	}
+
+	return nil
+}
+
+// careerSubject returns the career subject with the lowest id, like the MySQL storage does for legacy plans that
+// repeat them.
+func (s *Storage) careerSubject(careerID, subjectID int) *careerSubject {
+	for i := range s.careerSubjects {
+		if s.careerSubjects[i].careerID == careerID && s.careerSubjects[i].subjectID == subjectID {
+			return &s.careerSubjects[i]
+		}
+	}
+
+	return nil
+}
+
+func (s *Storage) term(termID int) *storage.Term {
+	for i := range s.terms {
+		if s.terms[i].ID == termID {
+			return &s.terms[i]
+		}
+	}
+
+	return nil
+}
+
+func (s *Storage) termName(termID *int) *string {
+	if termID == nil {
+		return nil
+	}
+
+	t := s.term(*termID)
+	if t == nil {
+		return nil
+	}
+
+	name := fmt.Sprintf("%d-%d", t.Year, t.Cuatrimestre)
+	return &name
+}
+
+func (s *Storage) professorship(professorshipID int) *professorship {
+	for i := range s.professorships {
+		if s.professorships[i].id == professorshipID {
+			return &s.professorships[i]
+		}
+	}
+
+	return nil
+}
+
+func (s *Storage) professor(professorID int) *storage.Professor {
+	for i := range s.professors {
+		if s.professors[i].ID == professorID {
+			return &s.professors[i]
+		}
+	}
+
+	return nil
+}
+
+func (s *Storage) student(studentID int) *student {
+	for i := range s.students {
+		if s.students[i].id == studentID {
+			return &s.students[i]
+		}
+	}
+
+	return nil
+}
+
+func (s *Storage) studentByEmail(studentEmail string) *student {
+	for i := range s.students {
+		if s.students[i].email == studentEmail {
+			return &s.students[i]
+		}
+	}
+
+	return nil
+}
+
+func (s *Storage) assignedToCareer(studentID, careerID int) bool {
+	for _, sc := range s.studentCareers {
+		if sc.studentID == studentID && sc.careerID == careerID {
+			return true
+		}
+	}
+
+	return false
+}
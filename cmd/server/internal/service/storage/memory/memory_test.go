@@ -0,0 +1,17 @@
+package memory
+
+import (
+	"testing"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service"
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage/storagetest"
+)
+
+var _ service.Storage = (*Storage)(nil)
+
+func TestStorage_Contract(t *testing.T) {
+	storagetest.Run(t, func(t *testing.T) (service.Storage, storagetest.Fixtures) {
+		s := NewStorage()
+		return s, s
+	})
+}
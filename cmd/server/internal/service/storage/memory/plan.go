@@ -0,0 +1,235 @@
+package memory
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
+)
+
+func (s *Storage) GetCareerPlan(careerID string) (storage.CareerPlan, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.career(id(careerID)) == nil {
+		return storage.CareerPlan{}, notFound("career")
+	}
+
+	plan := storage.CareerPlan{
+		Subjects:       []storage.CareerPlanSubject{},
+		Correlatives:   s.careerCorrelatives(id(careerID)),
+		Professorships: []storage.CareerPlanProfessorship{},
+		Schedules:      []storage.CareerPlanSchedule{},
+	}
+
+	for _, cs := range s.careerSubjects {
+		if cs.careerID != id(careerID) {
+			continue
+		}
+
+		plan.Subjects = append(plan.Subjects, storage.CareerPlanSubject{
+			SubjectID: cs.subjectID,
+			Name:      s.subject(cs.subjectID).name,
+			Type:      copyString(cs.kind),
+			Hours:     copyInt(cs.hours),
+			Points:    copyInt(cs.points),
+		})
+	}
+
+	for _, p := range s.professorships {
+		cs := s.careerSubjectByID(p.careerSubjectID)
+		if cs.careerID != id(careerID) {
+			continue
+		}
+
+		plan.Professorships = append(plan.Professorships, storage.CareerPlanProfessorship{
+			ID:        p.id,
+			SubjectID: cs.subjectID,
+			Name:      p.name,
+			Term:      s.termName(p.termID),
+		})
+
+		for _, sch := range s.professorshipSchedules(p.id) {
+			plan.Schedules = append(plan.Schedules, storage.CareerPlanSchedule{ProfessorshipID: p.id, Day: sch.day, Start: sch.start, End: sch.end})
+		}
+	}
+
+	sort.SliceStable(plan.Schedules, func(i, j int) bool {
+		a, b := plan.Schedules[i], plan.Schedules[j]
+		if a.ProfessorshipID != b.ProfessorshipID {
+			return a.ProfessorshipID < b.ProfessorshipID
+		}
+
+		if a.Day != b.Day {
+			return a.Day < b.Day
+		}
+
+		return a.Start < b.Start
+	})
+
+	return plan, nil
+}
+
+// ImportCareerPlan upserts the subjects of the plan into the career as the MySQL storage does. Correlatives are
+// resolved before any change, so a failed import leaves the storage untouched like a rolled back transaction.
+func (s *Storage) ImportCareerPlan(req storage.ImportCareerPlanRequest) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	careerID := id(req.CareerID)
+	if s.career(careerID) == nil {
+		return notFound("career")
+	}
+
+	imported := make(map[string]bool, len(req.Subjects))
+	for _, subject := range req.Subjects {
+		imported[subject.Name] = true
+	}
+
+	for _, subject := range req.Subjects {
+		for _, correlative := range subject.Correlatives {
+			if !imported[correlative.SubjectName] && s.careerSubjectByName(careerID, correlative.SubjectName) == nil {
+				return fmt.Errorf("could not find correlative [subject: %s]: %w", correlative.SubjectName, storage.ErrNotFound)
+			}
+		}
+	}
+
+	if err := s.checkImportedCorrelatives(careerID, req.Subjects, imported); err != nil {
+		return err
+	}
+
+	careerSubjectIDs := make(map[string]int, len(req.Subjects))
+	for _, subject := range req.Subjects {
+		careerSubjectIDs[subject.Name] = s.upsertCareerSubject(careerID, subject)
+	}
+
+	for _, subject := range req.Subjects {
+		careerSubjectID := careerSubjectIDs[subject.Name]
+
+		var correlatives []correlative
+		for _, c := range s.correlatives {
+			if c.careerSubjectID != careerSubjectID {
+				correlatives = append(correlatives, c)
+			}
+		}
+
+		for _, c := range subject.Correlatives {
+			correlativeID, exist := careerSubjectIDs[c.SubjectName]
+			if !exist {
+				correlativeID = s.careerSubjectByName(careerID, c.SubjectName).id
+			}
+
+			correlatives = append(correlatives, correlative{careerSubjectID: careerSubjectID, correlativeCareerSubjectID: correlativeID, requirement: c.Requirement})
+		}
+
+		s.correlatives = correlatives
+		for _, p := range subject.Professorships {
+			s.upsertProfessorship(careerSubjectID, p)
+		}
+	}
+
+	return nil
+}
+
+// checkImportedCorrelatives checks the correlatives the career would have after the import, before changing
+// anything. Subjects are identified by name as the new ones have no id yet.
+func (s *Storage) checkImportedCorrelatives(careerID int, subjects []storage.ImportSubject, imported map[string]bool) error {
+	ids := map[string]int{}
+	id := func(name string) int {
+		if _, exist := ids[name]; !exist {
+			ids[name] = len(ids) + 1
+		}
+
+		return ids[name]
+	}
+
+	var correlatives []storage.Correlative
+	for _, subject := range subjects {
+		for _, c := range subject.Correlatives {
+			correlatives = append(correlatives, storage.Correlative{SubjectID: id(subject.Name), CorrelativeID: id(c.SubjectName)})
+		}
+	}
+
+	for _, c := range s.careerCorrelatives(careerID) {
+		name := s.subject(c.SubjectID).name
+		if imported[name] {
+			continue
+		}
+
+		correlatives = append(correlatives, storage.Correlative{SubjectID: id(name), CorrelativeID: id(s.subject(c.CorrelativeID).name)})
+	}
+
+	if storage.CheckCorrelatives(correlatives) != nil {
+		// The ids are made up, so only the error is kept.
+		return storage.ErrCorrelativesCycle
+	}
+
+	return nil
+}
+
+func (s *Storage) careerSubjectByName(careerID int, name string) *careerSubject {
+	for i := range s.careerSubjects {
+		cs := &s.careerSubjects[i]
+		if cs.careerID == careerID && s.subject(cs.subjectID).name == name {
+			return cs
+		}
+	}
+
+	return nil
+}
+
+func (s *Storage) upsertCareerSubject(careerID int, subject storage.ImportSubject) int {
+	subjectID := 0
+	for _, sub := range s.subjects {
+		if sub.name == subject.Name {
+			subjectID = sub.id
+			break
+		}
+	}
+
+	if subjectID == 0 {
+		subjectID = s.createSubject(subject.Name, nil, nil)
+	}
+
+	cs := s.careerSubject(careerID, subjectID)
+	if cs == nil {
+		return s.createCareerSubject(careerID, subjectID, subject.Hours, subject.Type, subject.Points)
+	}
+
+	cs.hours, cs.kind, cs.points = copyInt(subject.Hours), copyString(subject.Type), copyInt(subject.Points)
+	return cs.id
+}
+
+func (s *Storage) upsertProfessorship(careerSubjectID int, imported storage.ImportProfessorship) {
+	professorshipID := 0
+	for _, p := range s.professorships {
+		if p.careerSubjectID == careerSubjectID && p.name == imported.Name && sameTerm(p.termID, imported.TermID) {
+			professorshipID = p.id
+			break
+		}
+	}
+
+	if professorshipID == 0 {
+		professorshipID = s.createProfessorship(careerSubjectID, imported.TermID, imported.Name)
+	}
+
+	s.deleteProfessorshipSchedules(professorshipID)
+	for _, sch := range imported.Schedules {
+		s.createSchedule(professorshipID, sch.Day, sch.Start, sch.End)
+	}
+}
+
+// sameTerm compares the terms as the MySQL null-safe equal operator.
+// overlappingTerms reports whether two professorships are dictated in the same term. Professorships without a
+// term overlap every term.
+func overlappingTerms(a, b *int) bool {
+	return a == nil || b == nil || *a == *b
+}
+
+func sameTerm(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	return *a == *b
+}
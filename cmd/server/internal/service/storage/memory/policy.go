@@ -0,0 +1,38 @@
+package memory
+
+import (
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
+)
+
+// defaultStatuses are the allowed statuses of a faculty that didn't change its policy, as in the faculty table.
+var defaultStatuses = []string{"PENDIENTE", "CURSANDO", "REGULARIZADA", "APROBADA", "LIBRE", "RECURSANDO"}
+
+func (s *Storage) GetFacultyPolicy(facultyID string) (storage.FacultyPolicy, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.faculty(id(facultyID)) == nil {
+		return storage.FacultyPolicy{}, notFound("faculty")
+	}
+
+	policy, exist := s.policies[id(facultyID)]
+	if !exist {
+		policy = storage.FacultyPolicy{FacultyID: id(facultyID), MaxCareersPerStudent: 2, AllowedStatuses: defaultStatuses, EnforceCorrelatives: true}
+	}
+
+	policy.AllowedStatuses = append([]string(nil), policy.AllowedStatuses...)
+	return policy, nil
+}
+
+func (s *Storage) UpdateFacultyPolicy(req storage.FacultyPolicy) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.faculty(req.FacultyID) == nil {
+		return notFound("faculty")
+	}
+
+	req.AllowedStatuses = append([]string(nil), req.AllowedStatuses...)
+	s.policies[req.FacultyID] = req
+	return nil
+}
@@ -0,0 +1,40 @@
+package memory
+
+import (
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
+)
+
+func (s *Storage) GetSearchCatalog() (storage.SearchCatalog, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	response := storage.SearchCatalog{
+		Subjects:       make([]storage.SearchSubject, 0, len(s.subjects)),
+		Careers:        make([]storage.Career, 0, len(s.careers)),
+		Professors:     make([]storage.Professor, 0, len(s.professors)),
+		Professorships: make([]storage.SearchProfessorship, 0, len(s.professorships)),
+	}
+
+	for _, sub := range s.subjects {
+		response.Subjects = append(response.Subjects, storage.SearchSubject{ID: sub.id, Name: sub.name})
+	}
+
+	for _, c := range s.careers {
+		response.Careers = append(response.Careers, storage.Career{ID: c.ID, FacultyID: c.FacultyID, Name: c.Name, URI: copyString(c.URI)})
+	}
+
+	response.Professors = append(response.Professors, s.professors...)
+
+	for _, p := range s.professorships {
+		cs := s.careerSubjectByID(p.careerSubjectID)
+		response.Professorships = append(response.Professorships, storage.SearchProfessorship{
+			ID:          p.id,
+			Name:        p.name,
+			CareerID:    cs.careerID,
+			SubjectID:   cs.subjectID,
+			SubjectName: s.subject(cs.subjectID).name,
+		})
+	}
+
+	return response, nil
+}
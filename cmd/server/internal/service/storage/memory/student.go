@@ -0,0 +1,678 @@
+package memory
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
+)
+
+func (s *Storage) CreateStudent(name, studentEmail, passwordHash string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.studentByEmail(studentEmail) != nil {
+		return storage.ErrResourceAlreadyExist
+	}
+
+	s.students = append(s.students, student{
+		id:           s.nextID("student"),
+		name:         name,
+		email:        studentEmail,
+		passwordHash: passwordHash,
+		role:         "STUDENT",
+	})
+
+	return nil
+}
+
+func (s *Storage) GetStudentCredentials(studentEmail string) (storage.Credentials, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st := s.studentByEmail(studentEmail)
+	if st == nil {
+		return storage.Credentials{}, fmt.Errorf("could not find student credentials: %w", storage.ErrNotFound)
+	}
+
+	return storage.Credentials{StudentID: st.id, PasswordHash: st.passwordHash, Role: st.role}, nil
+}
+
+func (s *Storage) GetStudentCareerIDs(studentEmail string) ([]int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st := s.studentByEmail(studentEmail)
+	if st == nil {
+		return nil, nil
+	}
+
+	var careerIDs []int
+	for _, sc := range s.studentCareers {
+		if sc.studentID == st.id {
+			careerIDs = append(careerIDs, sc.careerID)
+		}
+	}
+
+	return careerIDs, nil
+}
+
+func (s *Storage) AssignStudentToCareer(studentEmail, careerID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st := s.studentByEmail(studentEmail)
+	if st == nil {
+		return fmt.Errorf("could not find student: %w", storage.ErrNotFound)
+	}
+
+	if s.career(id(careerID)) == nil {
+		return fmt.Errorf("could not find career: %w", storage.ErrNotFound)
+	}
+
+	s.studentCareers = append(s.studentCareers, studentCareer{studentID: st.id, careerID: id(careerID)})
+	return nil
+}
+
+func (s *Storage) UnassignStudentFromCareer(studentEmail, careerID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st := s.studentByEmail(studentEmail)
+	if st == nil {
+		return notFound("student")
+	}
+
+	if !s.assignedToCareer(st.id, id(careerID)) {
+		return fmt.Errorf("could not find student assigned to career: %w", storage.ErrNotFound)
+	}
+
+	s.unassignStudentFromCareer(st.id, id(careerID))
+	return nil
+}
+
+// unassignStudentFromCareer removes the assignment with the subject statuses, exams, enrollments and equivalences of
+// the career. The subjects approved in other careers through equivalences credited from it go back to PENDIENTE.
+func (s *Storage) unassignStudentFromCareer(studentID, careerID int) {
+	inCareer := func(careerSubjectID int) bool {
+		return s.careerSubjectByID(careerSubjectID).careerID == careerID
+	}
+
+	var exams []exam
+	for _, e := range s.exams {
+		if e.studentID != studentID || !inCareer(e.careerSubjectID) {
+			exams = append(exams, e)
+		}
+	}
+
+	var enrollments []enrollment
+	for _, e := range s.enrollments {
+		if e.studentID != studentID || !inCareer(s.professorship(e.professorshipID).careerSubjectID) {
+			enrollments = append(enrollments, e)
+		}
+	}
+
+	var studentSubjects []studentSubject
+	for _, ss := range s.studentSubjects {
+		if ss.studentID != studentID || !inCareer(ss.careerSubjectID) {
+			studentSubjects = append(studentSubjects, ss)
+		}
+	}
+
+	var studentEquivalences []studentEquivalence
+	for _, e := range s.studentEquivalences {
+		if e.studentID != studentID || (!inCareer(e.careerSubjectID) && !inCareer(e.sourceCareerSubjectID)) {
+			studentEquivalences = append(studentEquivalences, e)
+			continue
+		}
+
+		if e.status != "APROBADA" || !inCareer(e.sourceCareerSubjectID) {
+			continue
+		}
+
+		for i := range studentSubjects {
+			ss := &studentSubjects[i]
+			if ss.studentID == studentID && ss.careerSubjectID == e.careerSubjectID && ss.status == "APROBADA" {
+				ss.status, ss.termID = "PENDIENTE", nil
+			}
+		}
+	}
+
+	var studentCareers []studentCareer
+	for _, sc := range s.studentCareers {
+		if sc.studentID != studentID || sc.careerID != careerID {
+			studentCareers = append(studentCareers, sc)
+		}
+	}
+
+	s.exams, s.enrollments, s.studentSubjects = exams, enrollments, studentSubjects
+	s.studentEquivalences, s.studentCareers = studentEquivalences, studentCareers
+}
+
+// TransferStudentCareer approves in the new career the approved subjects it shares with the old one, moving their
+// exams and the equivalences credited from them, before removing the old career as the MySQL storage does.
+func (s *Storage) TransferStudentCareer(req storage.TransferStudentCareerRequest) ([]int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st := s.studentByEmail(req.StudentEmail)
+	if st == nil {
+		return nil, notFound("student")
+	}
+
+	from, to := id(req.FromCareerID), id(req.ToCareerID)
+	if !s.assignedToCareer(st.id, from) {
+		return nil, fmt.Errorf("could not find student assigned to career: %w", storage.ErrNotFound)
+	}
+
+	if s.career(to) == nil {
+		return nil, notFound("career")
+	}
+
+	if !s.assignedToCareer(st.id, to) {
+		s.studentCareers = append(s.studentCareers, studentCareer{studentID: st.id, careerID: to})
+	}
+
+	var approved []studentSubject
+	for _, ss := range s.studentSubjects {
+		if ss.studentID == st.id && ss.status == "APROBADA" && s.careerSubjectByID(ss.careerSubjectID).careerID == from {
+			approved = append(approved, ss)
+		}
+	}
+
+	sort.SliceStable(approved, func(i, j int) bool {
+		a, b := s.careerSubjectByID(approved[i].careerSubjectID), s.careerSubjectByID(approved[j].careerSubjectID)
+		if a.subjectID != b.subjectID {
+			return a.subjectID < b.subjectID
+		}
+
+		return a.id < b.id
+	})
+
+	subjectIDs := []int{}
+	for _, ss := range approved {
+		subjectID := s.careerSubjectByID(ss.careerSubjectID).subjectID
+		target := s.careerSubject(to, subjectID)
+		if target == nil {
+			continue
+		}
+
+		for i := range s.exams {
+			if s.exams[i].studentID == st.id && s.exams[i].careerSubjectID == ss.careerSubjectID {
+				s.exams[i].careerSubjectID = target.id
+			}
+		}
+
+		for i := range s.studentEquivalences {
+			if s.studentEquivalences[i].studentID == st.id && s.studentEquivalences[i].sourceCareerSubjectID == ss.careerSubjectID {
+				s.studentEquivalences[i].sourceCareerSubjectID = target.id
+			}
+		}
+
+		if len(subjectIDs) > 0 && subjectIDs[len(subjectIDs)-1] == subjectID {
+			continue
+		}
+
+		s.upsertStudentSubject(st.id, target.id, "APROBADA", ss.description, ss.termID)
+		subjectIDs = append(subjectIDs, subjectID)
+	}
+
+	s.unassignStudentFromCareer(st.id, from)
+	return subjectIDs, nil
+}
+
+// studentCareerSubject finds the student and the career subject written by the requests of a student, checking
+// the student is assigned to the career.
+func (s *Storage) studentCareerSubject(studentEmail, careerID, subjectID string) (*student, *careerSubject, error) {
+	st := s.studentByEmail(studentEmail)
+	if st == nil {
+		return nil, nil, fmt.Errorf("could not find student: %w", storage.ErrNotFound)
+	}
+
+	if !s.assignedToCareer(st.id, id(careerID)) {
+		return nil, nil, fmt.Errorf("could not find student assigned to career: %w", storage.ErrNotFound)
+	}
+
+	cs := s.careerSubject(id(careerID), id(subjectID))
+	if cs == nil {
+		return nil, nil, fmt.Errorf("could not find career and subject: %w", storage.ErrNotFound)
+	}
+
+	return st, cs, nil
+}
+
+func (s *Storage) UpdateStudentSubject(req storage.UpdateStudentSubjectRequest) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st, cs, err := s.studentCareerSubject(req.StudentEmail, req.CareerID, req.SubjectID)
+	if err != nil {
+		return err
+	}
+
+	if req.TermID != nil && s.term(*req.TermID) == nil {
+		return fmt.Errorf("could not find term: %w", storage.ErrNotFound)
+	}
+
+	s.upsertStudentSubject(st.id, cs.id, req.Status, req.Description, req.TermID)
+	return nil
+}
+
+// upsertStudentSubject keeps the term of the previous status when no term is given, as the MySQL upsert does.
+func (s *Storage) upsertStudentSubject(studentID, careerSubjectID int, status string, description *string, termID *int) {
+	for i := range s.studentSubjects {
+		ss := &s.studentSubjects[i]
+		if ss.studentID != studentID || ss.careerSubjectID != careerSubjectID {
+			continue
+		}
+
+		ss.status = status
+		ss.description = copyString(description)
+		if termID != nil {
+			ss.termID = copyInt(termID)
+		}
+
+		return
+	}
+
+	s.studentSubjects = append(s.studentSubjects, studentSubject{
+		studentID:       studentID,
+		careerSubjectID: careerSubjectID,
+		status:          status,
+		description:     copyString(description),
+		termID:          copyInt(termID),
+	})
+}
+
+// approveStudentSubject approves the subject for equivalences, keeping the description the student wrote.
+func (s *Storage) approveStudentSubject(studentID, careerSubjectID int, termID *int) {
+	var description *string
+	for _, ss := range s.studentSubjects {
+		if ss.studentID == studentID && ss.careerSubjectID == careerSubjectID {
+			description = ss.description
+		}
+	}
+
+	s.upsertStudentSubject(studentID, careerSubjectID, "APROBADA", description, termID)
+}
+
+func (s *Storage) GetStudentSubjects(studentEmail, careerID string) ([]storage.StudentSubject, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st := s.studentByEmail(studentEmail)
+	if st == nil {
+		return nil, storage.ErrNotFound
+	}
+
+	var careerSubjects []careerSubject
+	for _, cs := range s.careerSubjects {
+		if cs.careerID == id(careerID) {
+			careerSubjects = append(careerSubjects, cs)
+		}
+	}
+
+	sort.SliceStable(careerSubjects, func(i, j int) bool {
+		return careerSubjects[i].subjectID < careerSubjects[j].subjectID
+	})
+
+	var response []storage.StudentSubject
+	for i, cs := range careerSubjects {
+		if i > 0 && careerSubjects[i-1].subjectID == cs.subjectID {
+			continue
+		}
+
+		studentSubject := storage.StudentSubject{
+			ID:     cs.subjectID,
+			Status: "PENDIENTE",
+			Name:   s.subject(cs.subjectID).name,
+			Type:   stringValue(cs.kind),
+			Hours:  copyInt(cs.hours),
+			Points: copyInt(cs.points),
+		}
+
+		for _, ss := range s.studentSubjects {
+			if ss.studentID != st.id || ss.careerSubjectID != cs.id {
+				continue
+			}
+
+			studentSubject.Status = ss.status
+			if ss.description != nil && *ss.description != "" {
+				studentSubject.Description = copyString(ss.description)
+			}
+
+			studentSubject.Term = s.termName(ss.termID)
+		}
+
+		response = append(response, studentSubject)
+	}
+
+	if response == nil {
+		return nil, storage.ErrNotFound
+	}
+
+	return response, nil
+}
+
+func (s *Storage) CreateExam(req storage.CreateExamRequest) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st, cs, err := s.studentCareerSubject(req.StudentEmail, req.CareerID, req.SubjectID)
+	if err != nil {
+		return 0, err
+	}
+
+	e := exam{id: s.nextID("exam"), studentID: st.id, careerSubjectID: cs.id, grade: req.Grade, date: req.Date}
+	s.exams = append(s.exams, e)
+	return e.id, nil
+}
+
+func (s *Storage) GetStudentExams(studentEmail, careerID string) ([]storage.Exam, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var exams []exam
+	if st := s.studentByEmail(studentEmail); st != nil {
+		for _, e := range s.exams {
+			if e.studentID == st.id && s.careerSubjectByID(e.careerSubjectID).careerID == id(careerID) {
+				exams = append(exams, e)
+			}
+		}
+	}
+
+	sort.SliceStable(exams, func(i, j int) bool {
+		return exams[i].date < exams[j].date
+	})
+
+	response := make([]storage.Exam, 0, len(exams))
+	for _, e := range exams {
+		response = append(response, storage.Exam{
+			SubjectID: s.careerSubjectByID(e.careerSubjectID).subjectID,
+			Grade:     e.grade,
+			Date:      e.date,
+		})
+	}
+
+	return response, nil
+}
+
+func (s *Storage) EnrollStudentInProfessorship(studentEmail, professorshipID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st := s.studentByEmail(studentEmail)
+	if st == nil {
+		return fmt.Errorf("could not find student: %w", storage.ErrNotFound)
+	}
+
+	p := s.professorship(id(professorshipID))
+	if p == nil {
+		return fmt.Errorf("could not find professorship: %w", storage.ErrNotFound)
+	}
+
+	if !s.assignedToCareer(st.id, s.careerSubjectByID(p.careerSubjectID).careerID) {
+		return fmt.Errorf("could not find student assigned to career: %w", storage.ErrNotFound)
+	}
+
+	for _, e := range s.enrollments {
+		enrolled := s.professorship(e.professorshipID)
+		if e.studentID == st.id && enrolled.careerSubjectID == p.careerSubjectID && overlappingTerms(enrolled.termID, p.termID) {
+			return fmt.Errorf("student already enrolled in subject: %w", storage.ErrResourceAlreadyExist)
+		}
+	}
+
+	s.enrollments = append(s.enrollments, enrollment{studentID: st.id, professorshipID: p.id})
+	return nil
+}
+
+func (s *Storage) DeleteStudentProfessorship(studentEmail, professorshipID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if st := s.studentByEmail(studentEmail); st != nil {
+		for i, e := range s.enrollments {
+			if e.studentID == st.id && e.professorshipID == id(professorshipID) {
+				s.enrollments = append(s.enrollments[:i], s.enrollments[i+1:]...)
+				return nil
+			}
+		}
+	}
+
+	return fmt.Errorf("could not find student enrollment: %w", storage.ErrNotFound)
+}
+
+func (s *Storage) GetStudentSchedules(studentEmail, termID string) ([]storage.StudentSchedule, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st := s.studentByEmail(studentEmail)
+	if st == nil {
+		return nil, fmt.Errorf("could not find student: %w", storage.ErrNotFound)
+	}
+
+	response := []storage.StudentSchedule{}
+
+	for _, e := range s.enrollments {
+		if e.studentID != st.id {
+			continue
+		}
+
+		p := s.professorship(e.professorshipID)
+		if termID != "" && p.termID != nil && *p.termID != id(termID) {
+			continue
+		}
+
+		sub := s.subject(s.careerSubjectByID(p.careerSubjectID).subjectID)
+		for _, sch := range s.professorshipSchedules(p.id) {
+			response = append(response, storage.StudentSchedule{
+				ProfessorshipID:   p.id,
+				ProfessorshipName: p.name,
+				SubjectName:       sub.name,
+				Meet:              copyString(sub.meet),
+				Day:               sch.day,
+				Start:             sch.start,
+				End:               sch.end,
+			})
+		}
+	}
+
+	sort.SliceStable(response, func(i, j int) bool {
+		a, b := response[i], response[j]
+		if a.Day != b.Day {
+			return a.Day < b.Day
+		}
+
+		if a.Start != b.Start {
+			return a.Start < b.Start
+		}
+
+		return a.ProfessorshipID < b.ProfessorshipID
+	})
+
+	return response, nil
+}
+
+func (s *Storage) GetStudent(studentID string) (storage.Student, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st := s.student(id(studentID))
+	if st == nil {
+		return storage.Student{}, notFound("student")
+	}
+
+	return storage.Student{ID: st.id, Name: st.name, Email: st.email, Role: st.role}, nil
+}
+
+func (s *Storage) UpdateStudent(req storage.UpdateStudentRequest) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st := s.student(id(req.StudentID))
+	if st == nil {
+		return notFound("student")
+	}
+
+	if req.Name != nil {
+		st.name = *req.Name
+	}
+
+	if req.PasswordHash != nil {
+		st.passwordHash = *req.PasswordHash
+	}
+
+	return nil
+}
+
+func (s *Storage) ChangeStudentEmail(req storage.ChangeStudentEmailRequest) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st := s.student(id(req.StudentID))
+	if st == nil {
+		return notFound("student")
+	}
+
+	if st.email == req.Email {
+		return nil
+	}
+
+	if s.studentByEmail(req.Email) != nil {
+		return fmt.Errorf("student email already exist: %w", storage.ErrResourceAlreadyExist)
+	}
+
+	s.emailChanges = append(s.emailChanges, emailChange{
+		id:        s.nextID("student_email_history"),
+		studentID: st.id,
+		oldEmail:  st.email,
+		newEmail:  req.Email,
+		changedAt: req.ChangedAt,
+	})
+
+	st.email = req.Email
+	return nil
+}
+
+func (s *Storage) GetStudentEmailHistory(studentID string) ([]storage.EmailChange, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var changes []emailChange
+	for _, c := range s.emailChanges {
+		if c.studentID == id(studentID) {
+			changes = append(changes, c)
+		}
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		if changes[i].changedAt != changes[j].changedAt {
+			return changes[i].changedAt < changes[j].changedAt
+		}
+
+		return changes[i].id < changes[j].id
+	})
+
+	response := make([]storage.EmailChange, 0, len(changes))
+	for _, c := range changes {
+		response = append(response, storage.EmailChange{OldEmail: c.oldEmail, NewEmail: c.newEmail, ChangedAt: c.changedAt})
+	}
+
+	return response, nil
+}
+
+// DeleteStudent removes the student with every row that references it, as the MySQL storage does.
+func (s *Storage) DeleteStudent(studentID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st := s.student(id(studentID))
+	if st == nil {
+		return notFound("student")
+	}
+
+	var exams []exam
+	for _, e := range s.exams {
+		if e.studentID != st.id {
+			exams = append(exams, e)
+		}
+	}
+
+	var enrollments []enrollment
+	for _, e := range s.enrollments {
+		if e.studentID != st.id {
+			enrollments = append(enrollments, e)
+		}
+	}
+
+	var studentSubjects []studentSubject
+	for _, ss := range s.studentSubjects {
+		if ss.studentID != st.id {
+			studentSubjects = append(studentSubjects, ss)
+		}
+	}
+
+	var studentCareers []studentCareer
+	for _, sc := range s.studentCareers {
+		if sc.studentID != st.id {
+			studentCareers = append(studentCareers, sc)
+		}
+	}
+
+	var emailChanges []emailChange
+	for _, c := range s.emailChanges {
+		if c.studentID != st.id {
+			emailChanges = append(emailChanges, c)
+		}
+	}
+
+	var studentEquivalences []studentEquivalence
+	for _, e := range s.studentEquivalences {
+		if e.studentID != st.id {
+			studentEquivalences = append(studentEquivalences, e)
+		}
+	}
+
+	var students []student
+	for _, other := range s.students {
+		if other.id != st.id {
+			students = append(students, other)
+		}
+	}
+
+	s.exams, s.enrollments, s.studentSubjects = exams, enrollments, studentSubjects
+	s.studentCareers, s.emailChanges, s.studentEquivalences, s.students = studentCareers, emailChanges, studentEquivalences, students
+	return nil
+}
+
+func (s *Storage) SetStudentCalendarToken(studentEmail string, tokenHash *string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st := s.studentByEmail(studentEmail)
+	if st == nil {
+		return notFound("student")
+	}
+
+	for _, other := range s.students {
+		if tokenHash != nil && other.id != st.id && other.calendarTokenHash != nil && *other.calendarTokenHash == *tokenHash {
+			return fmt.Errorf("student calendar token already exist: %w", storage.ErrResourceAlreadyExist)
+		}
+	}
+
+	st.calendarTokenHash = copyString(tokenHash)
+	return nil
+}
+
+func (s *Storage) GetStudentEmailByCalendarToken(tokenHash string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, st := range s.students {
+		if st.calendarTokenHash != nil && *st.calendarTokenHash == tokenHash {
+			return st.email, nil
+		}
+	}
+
+	return "", fmt.Errorf("could not find calendar token: %w", storage.ErrNotFound)
+}
@@ -0,0 +1,337 @@
+package storage
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type CareerPlanSubject struct {
+	SubjectID int
+	Name      string
+	Type      *string
+	Hours     *int
+	Points    *int
+}
+
+type CareerPlanProfessorship struct {
+	ID        int
+	SubjectID int
+	Name      string
+	Term      *string
+}
+
+type CareerPlanSchedule struct {
+	ProfessorshipID int
+	Day             int
+	Start           string
+	End             string
+}
+
+type CareerPlan struct {
+	Subjects       []CareerPlanSubject
+	Correlatives   []Correlative
+	Professorships []CareerPlanProfessorship
+	Schedules      []CareerPlanSchedule
+}
+
+const (
+	getCareerPlanSubjects = `SELECT s.id, s.name, cs.type, cs.hours, cs.points
+FROM career_subject cs
+         INNER JOIN subject s ON s.id = cs.subject_id
+WHERE cs.career_id = ?
+ORDER BY cs.id;`
+
+	getCareerPlanProfessorships = `SELECT p.id, cs.subject_id, p.name, CONCAT(t.year, '-', t.cuatrimestre) term
+FROM professorship p
+         INNER JOIN career_subject cs ON cs.id = p.career_subject_id
+         LEFT JOIN term t ON t.id = p.term_id
+WHERE cs.career_id = ?
+ORDER BY p.id;`
+
+	getCareerPlanSchedules = `SELECT s.professorship_id, s.day, s.start, s.end
+FROM schedule s
+         INNER JOIN professorship p ON p.id = s.professorship_id
+         INNER JOIN career_subject cs ON cs.id = p.career_subject_id
+WHERE cs.career_id = ?
+ORDER BY s.professorship_id, s.day, s.start;`
+)
+
+// GetCareerPlan returns the current curriculum of the career: its subjects, correlatives, professorships
+// and schedules.
+func (s *Storage) GetCareerPlan(careerID string) (CareerPlan, error) {
+	var results int
+	if err := s.db.Get(&results, checkCareerExist, careerID); err != nil {
+		return CareerPlan{}, err
+	}
+
+	if results == 0 {
+		return CareerPlan{}, fmt.Errorf("could not find career: %w", ErrNotFound)
+	}
+
+	var subjects []struct {
+		SubjectID int     `db:"id"`
+		Name      string  `db:"name"`
+		Type      *string `db:"type"`
+		Hours     *int    `db:"hours"`
+		Points    *int    `db:"points"`
+	}
+
+	if err := s.db.Select(&subjects, getCareerPlanSubjects, careerID); err != nil {
+		return CareerPlan{}, err
+	}
+
+	correlatives, err := s.GetCorrelatives(careerID)
+	if err != nil {
+		return CareerPlan{}, err
+	}
+
+	var professorships []struct {
+		ID        int     `db:"id"`
+		SubjectID int     `db:"subject_id"`
+		Name      string  `db:"name"`
+		Term      *string `db:"term"`
+	}
+
+	if err := s.db.Select(&professorships, getCareerPlanProfessorships, careerID); err != nil {
+		return CareerPlan{}, err
+	}
+
+	var schedules []struct {
+		ProfessorshipID int    `db:"professorship_id"`
+		Day             int    `db:"day"`
+		Start           string `db:"start"`
+		End             string `db:"end"`
+	}
+
+	if err := s.db.Select(&schedules, getCareerPlanSchedules, careerID); err != nil {
+		return CareerPlan{}, err
+	}
+
+	plan := CareerPlan{
+		Subjects:       make([]CareerPlanSubject, 0, len(subjects)),
+		Correlatives:   correlatives,
+		Professorships: make([]CareerPlanProfessorship, 0, len(professorships)),
+		Schedules:      make([]CareerPlanSchedule, 0, len(schedules)),
+	}
+
+	for _, subject := range subjects {
+		plan.Subjects = append(plan.Subjects, CareerPlanSubject(subject))
+	}
+
+	for _, professorship := range professorships {
+		plan.Professorships = append(plan.Professorships, CareerPlanProfessorship(professorship))
+	}
+
+	for _, schedule := range schedules {
+		plan.Schedules = append(plan.Schedules, CareerPlanSchedule(schedule))
+	}
+
+	return plan, nil
+}
+
+type ImportSchedule struct {
+	Day   int
+	Start string
+	End   string
+}
+
+type ImportProfessorship struct {
+	Name      string
+	TermID    *int
+	Schedules []ImportSchedule
+}
+
+type ImportCorrelative struct {
+	SubjectName string
+	Requirement string
+}
+
+type ImportSubject struct {
+	Name           string
+	Type           *string
+	Hours          *int
+	Points         *int
+	Correlatives   []ImportCorrelative
+	Professorships []ImportProfessorship
+}
+
+type ImportCareerPlanRequest struct {
+	CareerID string
+	Subjects []ImportSubject
+}
+
+const (
+	getSubjectIDByName              = `SELECT id FROM subject WHERE name = ? ORDER BY id LIMIT 1;`
+	getCareerSubjectIDBySubjectName = `SELECT cs.id FROM career_subject cs INNER JOIN subject s ON s.id = cs.subject_id WHERE cs.career_id = ? AND s.name = ? ORDER BY cs.id LIMIT 1;`
+	updateImportedCareerSubject     = `UPDATE career_subject SET hours = ?, type = ?, points = ? WHERE id = ?;`
+	deleteCareerSubjectCorrelatives = `DELETE FROM career_subject_correlative WHERE career_subject_id = ?;`
+	createCareerSubjectCorrelative  = `INSERT INTO career_subject_correlative (career_subject_id, correlative_career_subject_id, requirement) VALUES (?, ?, ?);`
+	getProfessorshipByName          = `SELECT id FROM professorship WHERE career_subject_id = ? AND name = ? AND term_id <=> ? ORDER BY id LIMIT 1;`
+)
+
+// ImportCareerPlan upserts the subjects of the plan into the career in a single transaction. Subjects are matched
+// by name and professorships by name and term. Correlatives and schedules of the imported subjects and
+// professorships are replaced, while resources missing from the plan are left untouched. A plan leaving the
+// correlatives with a cycle is not applied and returns ErrCorrelativesCycle.
+func (s *Storage) ImportCareerPlan(req ImportCareerPlanRequest) error {
+	tx, err := s.db.Beginx()
+	if err != nil {
+		return fmt.Errorf("could not begin tx: %v", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var results int
+	if err = tx.Get(&results, checkCareerExist, req.CareerID); err != nil {
+		return err
+	}
+
+	if results == 0 {
+		err = fmt.Errorf("could not find career: %w", ErrNotFound)
+		return err
+	}
+
+	careerSubjectIDs := make(map[string]int, len(req.Subjects))
+	for _, subject := range req.Subjects {
+		var careerSubjectID int
+		if careerSubjectID, err = s.upsertCareerSubject(tx, req.CareerID, subject); err != nil {
+			return err
+		}
+
+		careerSubjectIDs[subject.Name] = careerSubjectID
+	}
+
+	for _, subject := range req.Subjects {
+		careerSubjectID := careerSubjectIDs[subject.Name]
+		if _, err = tx.Exec(deleteCareerSubjectCorrelatives, careerSubjectID); err != nil {
+			return err
+		}
+
+		for _, correlative := range subject.Correlatives {
+			correlativeID, exist := careerSubjectIDs[correlative.SubjectName]
+			if !exist {
+				if err = tx.Get(&correlativeID, getCareerSubjectIDBySubjectName, req.CareerID, correlative.SubjectName); err != nil {
+					if errors.Is(err, sql.ErrNoRows) {
+						err = fmt.Errorf("could not find correlative [subject: %s]: %w", correlative.SubjectName, ErrNotFound)
+					}
+
+					return err
+				}
+			}
+
+			if _, err = tx.Exec(createCareerSubjectCorrelative, careerSubjectID, correlativeID, correlative.Requirement); err != nil {
+				return err
+			}
+		}
+
+		for _, professorship := range subject.Professorships {
+			if err = s.upsertProfessorship(tx, careerSubjectID, professorship); err != nil {
+				return err
+			}
+		}
+	}
+
+	// The plan is checked against the correlatives of the transaction, as another import could have changed them
+	// since the caller read them.
+	var correlatives []Correlative
+	if correlatives, err = getCareerCorrelatives(tx, req.CareerID); err != nil {
+		return err
+	}
+
+	if err = CheckCorrelatives(correlatives); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("could not commit tx: %v", err)
+	}
+
+	return nil
+}
+
+func (s *Storage) upsertCareerSubject(tx *sqlx.Tx, careerID string, subject ImportSubject) (int, error) {
+	var subjectID int
+	if err := tx.Get(&subjectID, getSubjectIDByName, subject.Name); err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return 0, err
+		}
+
+		result, err := tx.Exec(createSubject, subject.Name, nil, nil)
+		if err != nil {
+			return 0, err
+		}
+
+		id, err := result.LastInsertId()
+		if err != nil {
+			return 0, err
+		}
+
+		subjectID = int(id)
+	}
+
+	careerSubjectID, err := s.getCareerSubjectByIDs(tx, careerID, strconv.Itoa(subjectID))
+	if err != nil {
+		if !errors.Is(err, ErrNotFound) {
+			return 0, err
+		}
+
+		result, err := tx.Exec(createCareerSubject, careerID, subjectID, subject.Hours, subject.Type, subject.Points)
+		if err != nil {
+			return 0, err
+		}
+
+		id, err := result.LastInsertId()
+		if err != nil {
+			return 0, err
+		}
+
+		return int(id), nil
+	}
+
+	if _, err := tx.Exec(updateImportedCareerSubject, subject.Hours, subject.Type, subject.Points, careerSubjectID); err != nil {
+		return 0, err
+	}
+
+	return careerSubjectID, nil
+}
+
+func (s *Storage) upsertProfessorship(tx *sqlx.Tx, careerSubjectID int, professorship ImportProfessorship) error {
+	var professorshipID int
+	if err := tx.Get(&professorshipID, getProfessorshipByName, careerSubjectID, professorship.Name, professorship.TermID); err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+
+		result, err := tx.Exec(createProfessorship, careerSubjectID, professorship.TermID, professorship.Name)
+		if err != nil {
+			return err
+		}
+
+		id, err := result.LastInsertId()
+		if err != nil {
+			return err
+		}
+
+		professorshipID = int(id)
+	}
+
+	if _, err := tx.Exec(deleteProfessorshipSchedules, professorshipID); err != nil {
+		return err
+	}
+
+	for _, schedule := range professorship.Schedules {
+		if _, err := tx.Exec(createSchedule, professorshipID, schedule.Day, schedule.Start, schedule.End); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
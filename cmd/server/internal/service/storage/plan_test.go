@@ -0,0 +1,289 @@
+package storage
+
+import (
+	"database/sql"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorage_GetCareerPlan(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	mock.ExpectQuery(`SELECT COUNT(1) FROM career WHERE id = ?;`).
+		WithArgs("1").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery(getCareerPlanSubjects).
+		WithArgs("1").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "type", "hours", "points"}).
+			AddRow(1, "Álgebra", "Obligatoria", 96, 8).
+			AddRow(2, "Análisis I", nil, nil, nil))
+	correlativesQuery := strings.Replace(getCorrelatives, ":careerID", "?", 1)
+	mock.ExpectPrepare(correlativesQuery)
+	mock.ExpectQuery(correlativesQuery).
+		WithArgs("1").
+		WillReturnRows(sqlmock.NewRows([]string{"subject_id", "correlative_id", "requirement"}).AddRow(2, 1, "REGULARIZADA"))
+	mock.ExpectQuery(getCareerPlanProfessorships).
+		WithArgs("1").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "subject_id", "name", "term"}).AddRow(5, 1, "Cátedra A", "2021-1"))
+	mock.ExpectQuery(getCareerPlanSchedules).
+		WithArgs("1").
+		WillReturnRows(sqlmock.NewRows([]string{"professorship_id", "day", "start", "end"}).AddRow(5, 1, "08:00:00", "10:00:00"))
+
+	// When
+	plan, err := storage_.GetCareerPlan("1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	algebraType := "Obligatoria"
+	hours, points := 96, 8
+	term := "2021-1"
+
+	require.Equal(t, CareerPlan{
+		Subjects: []CareerPlanSubject{
+			{SubjectID: 1, Name: "Álgebra", Type: &algebraType, Hours: &hours, Points: &points},
+			{SubjectID: 2, Name: "Análisis I"},
+		},
+		Correlatives:   []Correlative{{SubjectID: 2, CorrelativeID: 1, Requirement: "REGULARIZADA"}},
+		Professorships: []CareerPlanProfessorship{{ID: 5, SubjectID: 1, Name: "Cátedra A", Term: &term}},
+		Schedules:      []CareerPlanSchedule{{ProfessorshipID: 5, Day: 1, Start: "08:00:00", End: "10:00:00"}},
+	}, plan)
+}
+
+func TestStorage_GetCareerPlan_CareerNotFoundError(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	mock.ExpectQuery(`SELECT COUNT(1) FROM career WHERE id = ?;`).
+		WithArgs("1").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+	// When
+	_, err = storage_.GetCareerPlan("1")
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "could not find career: storage: resource not found")
+}
+
+func TestStorage_ImportCareerPlan(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	hours := 96
+	termID := 4
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT COUNT(1) FROM career WHERE id = ?;`).
+		WithArgs("1").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+	// Álgebra already belongs to the career
+	mock.ExpectQuery(getSubjectIDByName).
+		WithArgs("Álgebra").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectQuery(getCareerSubjectByIDs).
+		WithArgs("1", "1").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(10))
+	mock.ExpectExec(updateImportedCareerSubject).
+		WithArgs(hours, nil, nil, 10).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	// Análisis I is a new subject
+	mock.ExpectQuery(getSubjectIDByName).
+		WithArgs("Análisis I").
+		WillReturnError(sql.ErrNoRows)
+	mock.ExpectExec(createSubject).
+		WithArgs("Análisis I", nil, nil).
+		WillReturnResult(sqlmock.NewResult(2, 1))
+	mock.ExpectQuery(getCareerSubjectByIDs).
+		WithArgs("1", "2").
+		WillReturnError(sql.ErrNoRows)
+	mock.ExpectExec(createCareerSubject).
+		WithArgs("1", 2, nil, nil, nil).
+		WillReturnResult(sqlmock.NewResult(11, 1))
+
+	mock.ExpectExec(deleteCareerSubjectCorrelatives).WithArgs(10).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(getProfessorshipByName).
+		WithArgs(10, "Cátedra A", termID).
+		WillReturnError(sql.ErrNoRows)
+	mock.ExpectExec(createProfessorship).
+		WithArgs(10, termID, "Cátedra A").
+		WillReturnResult(sqlmock.NewResult(5, 1))
+	mock.ExpectExec(deleteProfessorshipSchedules).WithArgs(5).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(createSchedule).
+		WithArgs(5, 1, "08:00", "10:00").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	mock.ExpectExec(deleteCareerSubjectCorrelatives).WithArgs(11).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(createCareerSubjectCorrelative).
+		WithArgs(11, 10, "APROBADA").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	correlativesQuery, _, _ := sqlx.Named(getCorrelatives, map[string]interface{}{"careerID": "1"})
+	mock.ExpectPrepare(correlativesQuery)
+	mock.ExpectQuery(correlativesQuery).
+		WithArgs("1").
+		WillReturnRows(sqlmock.NewRows([]string{"subject_id", "correlative_id", "requirement"}).AddRow(2, 1, "APROBADA"))
+	mock.ExpectCommit()
+
+	// When
+	err = storage_.ImportCareerPlan(ImportCareerPlanRequest{
+		CareerID: "1",
+		Subjects: []ImportSubject{
+			{
+				Name:  "Álgebra",
+				Hours: &hours,
+				Professorships: []ImportProfessorship{
+					{Name: "Cátedra A", TermID: &termID, Schedules: []ImportSchedule{{Day: 1, Start: "08:00", End: "10:00"}}},
+				},
+			},
+			{
+				Name:         "Análisis I",
+				Correlatives: []ImportCorrelative{{SubjectName: "Álgebra", Requirement: "APROBADA"}},
+			},
+		},
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStorage_ImportCareerPlan_CorrelativesCycleError(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT COUNT(1) FROM career WHERE id = ?;`).
+		WithArgs("1").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery(getSubjectIDByName).
+		WithArgs("Álgebra").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectQuery(getCareerSubjectByIDs).
+		WithArgs("1", "1").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(10))
+	mock.ExpectExec(updateImportedCareerSubject).
+		WithArgs(nil, nil, nil, 10).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(deleteCareerSubjectCorrelatives).WithArgs(10).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(getCareerSubjectIDBySubjectName).
+		WithArgs("1", "Análisis I").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(11))
+	mock.ExpectExec(createCareerSubjectCorrelative).
+		WithArgs(10, 11, "APROBADA").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	// Análisis I already requires Álgebra
+	correlativesQuery, _, _ := sqlx.Named(getCorrelatives, map[string]interface{}{"careerID": "1"})
+	mock.ExpectPrepare(correlativesQuery)
+	mock.ExpectQuery(correlativesQuery).
+		WithArgs("1").
+		WillReturnRows(sqlmock.NewRows([]string{"subject_id", "correlative_id", "requirement"}).
+			AddRow(1, 2, "APROBADA").
+			AddRow(2, 1, "APROBADA"))
+	mock.ExpectRollback()
+
+	// When
+	err = storage_.ImportCareerPlan(ImportCareerPlanRequest{
+		CareerID: "1",
+		Subjects: []ImportSubject{
+			{Name: "Álgebra", Correlatives: []ImportCorrelative{{SubjectName: "Análisis I", Requirement: "APROBADA"}}},
+		},
+	})
+
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "storage: correlatives have a cycle [subject_id: 1]")
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStorage_ImportCareerPlan_CorrelativeNotFoundError(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT COUNT(1) FROM career WHERE id = ?;`).
+		WithArgs("1").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery(getSubjectIDByName).
+		WithArgs("Análisis I").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+	mock.ExpectQuery(getCareerSubjectByIDs).
+		WithArgs("1", "2").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(11))
+	mock.ExpectExec(updateImportedCareerSubject).
+		WithArgs(nil, nil, nil, 11).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(deleteCareerSubjectCorrelatives).WithArgs(11).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(getCareerSubjectIDBySubjectName).
+		WithArgs("1", "Álgebra").
+		WillReturnError(sql.ErrNoRows)
+	mock.ExpectRollback()
+
+	// When
+	err = storage_.ImportCareerPlan(ImportCareerPlanRequest{
+		CareerID: "1",
+		Subjects: []ImportSubject{
+			{Name: "Análisis I", Correlatives: []ImportCorrelative{{SubjectName: "Álgebra", Requirement: "APROBADA"}}},
+		},
+	})
+
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "could not find correlative [subject: Álgebra]: storage: resource not found")
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,51 @@
+package storage
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// FacultyPolicy holds the rules the careers of a faculty apply to their students.
+type FacultyPolicy struct {
+	FacultyID            int
+	MaxCareersPerStudent int
+	AllowedStatuses      []string
+	EnforceCorrelatives  bool
+}
+
+const (
+	getFacultyPolicy    = `SELECT id, max_careers_per_student, allowed_statuses, enforce_correlatives FROM faculty WHERE id = ?;`
+	updateFacultyPolicy = `UPDATE faculty SET max_careers_per_student = ?, allowed_statuses = ?, enforce_correlatives = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?;`
+)
+
+func (s *Storage) GetFacultyPolicy(facultyID string) (FacultyPolicy, error) {
+	var policy struct {
+		FacultyID            int    `db:"id"`
+		MaxCareersPerStudent int    `db:"max_careers_per_student"`
+		AllowedStatuses      string `db:"allowed_statuses"`
+		EnforceCorrelatives  bool   `db:"enforce_correlatives"`
+	}
+
+	if err := s.db.Get(&policy, getFacultyPolicy, facultyID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return FacultyPolicy{}, fmt.Errorf("could not find faculty: %w", ErrNotFound)
+		}
+
+		return FacultyPolicy{}, err
+	}
+
+	return FacultyPolicy{
+		FacultyID:            policy.FacultyID,
+		MaxCareersPerStudent: policy.MaxCareersPerStudent,
+		AllowedStatuses:      strings.Split(policy.AllowedStatuses, ","),
+		EnforceCorrelatives:  policy.EnforceCorrelatives,
+	}, nil
+}
+
+// UpdateFacultyPolicy replaces the policy of the faculty. The allowed statuses are kept as a comma separated list.
+func (s *Storage) UpdateFacultyPolicy(req FacultyPolicy) error {
+	return s.updateResource("faculty", checkFacultyExist, []interface{}{req.FacultyID}, updateFacultyPolicy,
+		req.MaxCareersPerStudent, strings.Join(req.AllowedStatuses, ","), req.EnforceCorrelatives, req.FacultyID)
+}
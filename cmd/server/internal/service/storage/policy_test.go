@@ -0,0 +1,92 @@
+package storage
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorage_GetFacultyPolicy(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	mock.ExpectQuery(getFacultyPolicy).
+		WithArgs("1").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "max_careers_per_student", "allowed_statuses", "enforce_correlatives"}).
+			AddRow(1, 3, "PENDIENTE,CURSANDO,APROBADA", false))
+
+	// When
+	policy, err := storage_.GetFacultyPolicy("1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Equal(t, FacultyPolicy{FacultyID: 1, MaxCareersPerStudent: 3, AllowedStatuses: []string{"PENDIENTE", "CURSANDO", "APROBADA"}}, policy)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStorage_GetFacultyPolicy_NotFoundError(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	mock.ExpectQuery(getFacultyPolicy).
+		WithArgs("1").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "max_careers_per_student", "allowed_statuses", "enforce_correlatives"}))
+
+	// When
+	_, err = storage_.GetFacultyPolicy("1")
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.True(t, errors.Is(err, ErrNotFound))
+}
+
+func TestStorage_UpdateFacultyPolicy(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT COUNT(1) FROM faculty WHERE id = ?;`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectExec(updateFacultyPolicy).
+		WithArgs(3, "PENDIENTE,APROBADA", true, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	// When
+	err = storage_.UpdateFacultyPolicy(FacultyPolicy{FacultyID: 1, MaxCareersPerStudent: 3, AllowedStatuses: []string{"PENDIENTE", "APROBADA"}, EnforceCorrelatives: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,149 @@
+package storage
+
+import (
+	"database/sql"
+	"errors"
+)
+
+type ProfessorshipProfessor struct {
+	ProfessorshipID int
+	ID              int
+	Name            string
+	Role            string
+}
+
+const getProfessorshipsProfessors = `SELECT pp.professorship_id, pr.id, pr.name, pp.role
+FROM professorship_professor pp
+         INNER JOIN professor pr ON pr.id = pp.professor_id
+         INNER JOIN professorship p ON p.id = pp.professorship_id
+         INNER JOIN career_subject cs ON p.career_subject_id = cs.id
+WHERE cs.subject_id = :subjectID AND cs.career_id = :careerID;`
+
+func (s *Storage) GetProfessorshipsProfessors(subjectID, careerID string) ([]ProfessorshipProfessor, error) {
+	stmt, err := s.db.PrepareNamed(getProfessorshipsProfessors)
+	if err != nil {
+		return nil, err
+	}
+
+	defer stmt.Close()
+
+	params := map[string]interface{}{"subjectID": subjectID, "careerID": careerID}
+
+	var professors []struct {
+		ProfessorshipID int    `db:"professorship_id"`
+		ID              int    `db:"id"`
+		Name            string `db:"name"`
+		Role            string `db:"role"`
+	}
+
+	if err := stmt.Select(&professors, params); err != nil {
+		return nil, err
+	}
+
+	response := make([]ProfessorshipProfessor, 0, len(professors))
+	for _, professor := range professors {
+		response = append(response, ProfessorshipProfessor{
+			ProfessorshipID: professor.ProfessorshipID,
+			ID:              professor.ID,
+			Name:            professor.Name,
+			Role:            professor.Role,
+		})
+	}
+
+	return response, nil
+}
+
+type Professor struct {
+	ID   int
+	Name string
+}
+
+const getProfessor = `SELECT id, name FROM professor WHERE id = :professorID;`
+
+func (s *Storage) GetProfessor(professorID string) (Professor, error) {
+	stmt, err := s.db.PrepareNamed(getProfessor)
+	if err != nil {
+		return Professor{}, err
+	}
+
+	defer stmt.Close()
+
+	params := map[string]interface{}{"professorID": professorID}
+
+	var professor struct {
+		ID   int    `db:"id"`
+		Name string `db:"name"`
+	}
+
+	if err := stmt.Get(&professor, params); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Professor{}, ErrNotFound
+		}
+
+		return Professor{}, err
+	}
+
+	return Professor{
+		ID:   professor.ID,
+		Name: professor.Name,
+	}, nil
+}
+
+type ProfessorProfessorship struct {
+	ID          int
+	Name        string
+	Role        string
+	SubjectID   int
+	SubjectName string
+	CareerID    int
+	CareerName  string
+}
+
+const getProfessorProfessorships = `SELECT p.id, p.name, pp.role, s.id subject_id, s.name subject_name, c.id career_id, c.name career_name
+FROM professorship_professor pp
+         INNER JOIN professorship p ON p.id = pp.professorship_id
+         INNER JOIN career_subject cs ON cs.id = p.career_subject_id
+         INNER JOIN subject s ON s.id = cs.subject_id
+         INNER JOIN career c ON c.id = cs.career_id
+WHERE pp.professor_id = :professorID
+ORDER BY c.id, s.id, p.id;`
+
+func (s *Storage) GetProfessorProfessorships(professorID string) ([]ProfessorProfessorship, error) {
+	stmt, err := s.db.PrepareNamed(getProfessorProfessorships)
+	if err != nil {
+		return nil, err
+	}
+
+	defer stmt.Close()
+
+	params := map[string]interface{}{"professorID": professorID}
+
+	var professorships []struct {
+		ID          int    `db:"id"`
+		Name        string `db:"name"`
+		Role        string `db:"role"`
+		SubjectID   int    `db:"subject_id"`
+		SubjectName string `db:"subject_name"`
+		CareerID    int    `db:"career_id"`
+		CareerName  string `db:"career_name"`
+	}
+
+	if err := stmt.Select(&professorships, params); err != nil {
+		return nil, err
+	}
+
+	response := make([]ProfessorProfessorship, 0, len(professorships))
+	for _, professorship := range professorships {
+		response = append(response, ProfessorProfessorship{
+			ID:          professorship.ID,
+			Name:        professorship.Name,
+			Role:        professorship.Role,
+			SubjectID:   professorship.SubjectID,
+			SubjectName: professorship.SubjectName,
+			CareerID:    professorship.CareerID,
+			CareerName:  professorship.CareerName,
+		})
+	}
+
+	return response, nil
+}
@@ -0,0 +1,207 @@
+package storage
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorage_GetProfessorshipsProfessors(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	q := `SELECT pp.professorship_id, pr.id, pr.name, pp.role
+FROM professorship_professor pp
+         INNER JOIN professor pr ON pr.id = pp.professor_id
+         INNER JOIN professorship p ON p.id = pp.professorship_id
+         INNER JOIN career_subject cs ON p.career_subject_id = cs.id
+WHERE cs.subject_id = ? AND cs.career_id = ?;`
+	mock.ExpectPrepare(q).WillReturnError(nil)
+	mock.ExpectQuery(q).
+		WithArgs("1", "2").
+		WillReturnError(nil).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"professorship_id", "id", "name", "role"}).
+				AddRow(3, 4, "Professor 1", "TITULAR"))
+
+	// When
+	professors, err := storage_.GetProfessorshipsProfessors("1", "2")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Equal(t, []ProfessorshipProfessor{{ProfessorshipID: 3, ID: 4, Name: "Professor 1", Role: "TITULAR"}}, professors)
+}
+
+func TestStorage_GetProfessorshipsProfessors_ExecuteStmtError(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	q := `SELECT pp.professorship_id, pr.id, pr.name, pp.role
+FROM professorship_professor pp
+         INNER JOIN professor pr ON pr.id = pp.professor_id
+         INNER JOIN professorship p ON p.id = pp.professorship_id
+         INNER JOIN career_subject cs ON p.career_subject_id = cs.id
+WHERE cs.subject_id = ? AND cs.career_id = ?;`
+	mock.ExpectPrepare(q).WillReturnError(nil)
+	mock.ExpectQuery(q).WillReturnError(errors.New("error"))
+
+	// When
+	_, err = storage_.GetProfessorshipsProfessors("1", "2")
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "error")
+}
+
+func TestStorage_GetProfessor(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	q := `SELECT id, name FROM professor WHERE id = ?;`
+	mock.ExpectPrepare(q).WillReturnError(nil)
+	mock.ExpectQuery(q).
+		WithArgs("4").
+		WillReturnError(nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(4, "Professor 1"))
+
+	// When
+	professor, err := storage_.GetProfessor("4")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Equal(t, Professor{ID: 4, Name: "Professor 1"}, professor)
+}
+
+func TestStorage_GetProfessor_NotFoundError(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	q := `SELECT id, name FROM professor WHERE id = ?;`
+	mock.ExpectPrepare(q).WillReturnError(nil)
+	mock.ExpectQuery(q).
+		WithArgs("4").
+		WillReturnError(nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}))
+
+	// When
+	_, err = storage_.GetProfessor("4")
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "storage: resource not found")
+}
+
+func TestStorage_GetProfessorProfessorships(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	q := `SELECT p.id, p.name, pp.role, s.id subject_id, s.name subject_name, c.id career_id, c.name career_name
+FROM professorship_professor pp
+         INNER JOIN professorship p ON p.id = pp.professorship_id
+         INNER JOIN career_subject cs ON cs.id = p.career_subject_id
+         INNER JOIN subject s ON s.id = cs.subject_id
+         INNER JOIN career c ON c.id = cs.career_id
+WHERE pp.professor_id = ?
+ORDER BY c.id, s.id, p.id;`
+	mock.ExpectPrepare(q).WillReturnError(nil)
+	mock.ExpectQuery(q).
+		WithArgs("4").
+		WillReturnError(nil).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"id", "name", "role", "subject_id", "subject_name", "career_id", "career_name"}).
+				AddRow(3, "CATEDRA 1", "JTP", 1, "Subject 1", 2, "Career 2"))
+
+	// When
+	professorships, err := storage_.GetProfessorProfessorships("4")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Equal(t, []ProfessorProfessorship{{
+		ID:          3,
+		Name:        "CATEDRA 1",
+		Role:        "JTP",
+		SubjectID:   1,
+		SubjectName: "Subject 1",
+		CareerID:    2,
+		CareerName:  "Career 2",
+	}}, professorships)
+}
+
+func TestStorage_GetProfessorProfessorships_PrepareStmtError(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	q := `SELECT p.id, p.name, pp.role, s.id subject_id, s.name subject_name, c.id career_id, c.name career_name
+FROM professorship_professor pp
+         INNER JOIN professorship p ON p.id = pp.professorship_id
+         INNER JOIN career_subject cs ON cs.id = p.career_subject_id
+         INNER JOIN subject s ON s.id = cs.subject_id
+         INNER JOIN career c ON c.id = cs.career_id
+WHERE pp.professor_id = ?
+ORDER BY c.id, s.id, p.id;`
+	mock.ExpectPrepare(q).WillReturnError(errors.New("error"))
+
+	// When
+	_, err = storage_.GetProfessorProfessorships("4")
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "error")
+}
@@ -0,0 +1,65 @@
+package storage
+
+import (
+	"github.com/jmoiron/sqlx"
+)
+
+// ProfessorshipSchedule is a schedule of a professorship. Professorships without schedules have a single one with
+// no day, start nor end.
+type ProfessorshipSchedule struct {
+	ProfessorshipID int
+	Name            string
+	SubjectID       int
+	SubjectName     string
+	Day             *int
+	Start           *string
+	End             *string
+}
+
+const getProfessorshipsSchedules = `SELECT p.id professorship_id, p.name, cs.subject_id, sub.name subject_name, s.day, s.start, s.end
+FROM professorship p
+         LEFT JOIN schedule s ON p.id = s.professorship_id
+         INNER JOIN career_subject cs ON p.career_subject_id = cs.id
+         INNER JOIN subject sub ON sub.id = cs.subject_id
+WHERE p.id IN (?)
+ORDER BY s.day, s.start, p.id;`
+
+func (s *Storage) GetProfessorshipsSchedules(professorshipIDs []int) ([]ProfessorshipSchedule, error) {
+	query, args, err := sqlx.In(getProfessorshipsSchedules, professorshipIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	var schedules []struct {
+		ProfessorshipID int     `db:"professorship_id"`
+		Name            string  `db:"name"`
+		SubjectID       int     `db:"subject_id"`
+		SubjectName     string  `db:"subject_name"`
+		Day             *int    `db:"day"`
+		Start           *string `db:"start"`
+		End             *string `db:"end"`
+	}
+
+	if err := s.db.Select(&schedules, s.db.Rebind(query), args...); err != nil {
+		return nil, err
+	}
+
+	if schedules == nil {
+		return nil, ErrNotFound
+	}
+
+	response := make([]ProfessorshipSchedule, 0, len(schedules))
+	for _, schedule := range schedules {
+		response = append(response, ProfessorshipSchedule{
+			ProfessorshipID: schedule.ProfessorshipID,
+			Name:            schedule.Name,
+			SubjectID:       schedule.SubjectID,
+			SubjectName:     schedule.SubjectName,
+			Day:             schedule.Day,
+			Start:           schedule.Start,
+			End:             schedule.End,
+		})
+	}
+
+	return response, nil
+}
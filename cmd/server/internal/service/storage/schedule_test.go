@@ -0,0 +1,81 @@
+package storage
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorage_GetProfessorshipsSchedules(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	mock.ExpectQuery(`SELECT p.id professorship_id, p.name, cs.subject_id, sub.name subject_name, s.day, s.start, s.end
+FROM professorship p
+         LEFT JOIN schedule s ON p.id = s.professorship_id
+         INNER JOIN career_subject cs ON p.career_subject_id = cs.id
+         INNER JOIN subject sub ON sub.id = cs.subject_id
+WHERE p.id IN (?, ?, ?)
+ORDER BY s.day, s.start, p.id;`).
+		WithArgs(1, 2, 3).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"professorship_id", "name", "subject_id", "subject_name", "day", "start", "end"}).
+				AddRow(3, "K1023", 5, "Inglés", nil, nil, nil).
+				AddRow(1, "K1021", 3, "Física I", 1, "19:00:00", "22:00:00").
+				AddRow(2, "K1022", 4, "Química", 1, "18:00:00", "20:00:00"))
+
+	// When
+	schedules, err := storage_.GetProfessorshipsSchedules([]int{1, 2, 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	day, firstStart, firstEnd, secondStart, secondEnd := 1, "19:00:00", "22:00:00", "18:00:00", "20:00:00"
+	require.Equal(t, []ProfessorshipSchedule{
+		{ProfessorshipID: 3, Name: "K1023", SubjectID: 5, SubjectName: "Inglés"},
+		{ProfessorshipID: 1, Name: "K1021", SubjectID: 3, SubjectName: "Física I", Day: &day, Start: &firstStart, End: &firstEnd},
+		{ProfessorshipID: 2, Name: "K1022", SubjectID: 4, SubjectName: "Química", Day: &day, Start: &secondStart, End: &secondEnd},
+	}, schedules)
+}
+
+func TestStorage_GetProfessorshipsSchedules_NotFoundError(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	mock.ExpectQuery(`SELECT p.id professorship_id, p.name, cs.subject_id, sub.name subject_name, s.day, s.start, s.end
+FROM professorship p
+         LEFT JOIN schedule s ON p.id = s.professorship_id
+         INNER JOIN career_subject cs ON p.career_subject_id = cs.id
+         INNER JOIN subject sub ON sub.id = cs.subject_id
+WHERE p.id IN (?)
+ORDER BY s.day, s.start, p.id;`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"professorship_id", "name", "subject_id", "subject_name", "day", "start", "end"}))
+
+	// When
+	_, err = storage_.GetProfessorshipsSchedules([]int{1})
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.True(t, errors.Is(err, ErrNotFound))
+}
@@ -0,0 +1,96 @@
+package storage
+
+// SearchCatalog holds the names of the catalog resources that can be searched.
+type SearchCatalog struct {
+	Subjects       []SearchSubject
+	Careers        []Career
+	Professors     []Professor
+	Professorships []SearchProfessorship
+}
+
+type SearchSubject struct {
+	ID   int
+	Name string
+}
+
+type SearchProfessorship struct {
+	ID          int
+	Name        string
+	CareerID    int
+	SubjectID   int
+	SubjectName string
+}
+
+const (
+	getSearchSubjects       = `SELECT id, name FROM subject ORDER BY id;`
+	getSearchCareers        = `SELECT id, faculty_id, name, uri FROM career ORDER BY id;`
+	getSearchProfessors     = `SELECT id, name FROM professor ORDER BY id;`
+	getSearchProfessorships = `SELECT p.id, p.name, cs.career_id, cs.subject_id, s.name AS subject_name
+FROM professorship p
+         INNER JOIN career_subject cs ON cs.id = p.career_subject_id
+         INNER JOIN subject s ON s.id = cs.subject_id
+ORDER BY p.id;`
+)
+
+// GetSearchCatalog reads every subject, career, professor and professorship, so the service can index them.
+func (s *Storage) GetSearchCatalog() (SearchCatalog, error) {
+	var subjects []struct {
+		ID   int    `db:"id"`
+		Name string `db:"name"`
+	}
+
+	if err := s.db.Select(&subjects, getSearchSubjects); err != nil {
+		return SearchCatalog{}, err
+	}
+
+	var careers []career
+	if err := s.db.Select(&careers, getSearchCareers); err != nil {
+		return SearchCatalog{}, err
+	}
+
+	var professors []struct {
+		ID   int    `db:"id"`
+		Name string `db:"name"`
+	}
+
+	if err := s.db.Select(&professors, getSearchProfessors); err != nil {
+		return SearchCatalog{}, err
+	}
+
+	var professorships []struct {
+		ID          int    `db:"id"`
+		Name        string `db:"name"`
+		CareerID    int    `db:"career_id"`
+		SubjectID   int    `db:"subject_id"`
+		SubjectName string `db:"subject_name"`
+	}
+
+	if err := s.db.Select(&professorships, getSearchProfessorships); err != nil {
+		return SearchCatalog{}, err
+	}
+
+	response := SearchCatalog{
+		Subjects:       make([]SearchSubject, 0, len(subjects)),
+		Careers:        make([]Career, 0, len(careers)),
+		Professors:     make([]Professor, 0, len(professors)),
+		Professorships: make([]SearchProfessorship, 0, len(professorships)),
+	}
+
+	for _, subject := range subjects {
+		response.Subjects = append(response.Subjects, SearchSubject(subject))
+	}
+
+	for _, c := range careers {
+		response.Careers = append(response.Careers, Career(c))
+	}
+
+	for _, professor := range professors {
+		response.Professors = append(response.Professors, Professor(professor))
+	}
+
+	for _, professorship := range professorships {
+		response.Professorships = append(response.Professorships, SearchProfessorship(professorship))
+	}
+
+	return response, nil
+}
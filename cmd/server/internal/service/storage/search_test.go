@@ -0,0 +1,66 @@
+package storage
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorage_GetSearchCatalog(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	mock.ExpectQuery(getSearchSubjects).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(1, "Análisis Matemático"))
+	mock.ExpectQuery(getSearchCareers).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "faculty_id", "name", "uri"}).AddRow(2, 1, "Licenciatura en Sistemas", nil))
+	mock.ExpectQuery(getSearchProfessors).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(3, "José Pérez"))
+	mock.ExpectQuery(getSearchProfessorships).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "career_id", "subject_id", "subject_name"}).AddRow(4, "Cátedra A", 2, 1, "Análisis Matemático"))
+
+	// When
+	catalog, err := storage_.GetSearchCatalog()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Equal(t, SearchCatalog{
+		Subjects:       []SearchSubject{{ID: 1, Name: "Análisis Matemático"}},
+		Careers:        []Career{{ID: 2, FacultyID: 1, Name: "Licenciatura en Sistemas"}},
+		Professors:     []Professor{{ID: 3, Name: "José Pérez"}},
+		Professorships: []SearchProfessorship{{ID: 4, Name: "Cátedra A", CareerID: 2, SubjectID: 1, SubjectName: "Análisis Matemático"}},
+	}, catalog)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStorage_GetSearchCatalog_Error(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	mock.ExpectQuery(getSearchSubjects).WillReturnError(errors.New("error"))
+
+	// When
+	_, err = storage_.GetSearchCatalog()
+
+	// Then
+	require.EqualError(t, err, "error")
+}
@@ -0,0 +1,194 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/mattn/go-sqlite3"
+)
+
+const (
+	DriverMySQL  = "mysql"
+	DriverSQLite = "sqlite"
+
+	// sqliteDriverName is the database/sql driver that runs the MySQL queries of Storage against SQLite.
+	sqliteDriverName = "sqlite3-studentapi"
+)
+
+func init() {
+	sql.Register(sqliteDriverName, sqliteDriver{
+		driver: &sqlite3.SQLiteDriver{ConnectHook: configureSQLiteConn},
+	})
+
+	sqlx.BindDriver(sqliteDriverName, sqlx.QUESTION)
+}
+
+// Open opens a database of the given driver, mysql or sqlite.
+func Open(driver, source string) (*sqlx.DB, error) {
+	switch driver {
+	case DriverMySQL:
+		return sqlx.Open("mysql", source)
+	case DriverSQLite:
+		return sqlx.Open(sqliteDriverName, source)
+	default:
+		return nil, fmt.Errorf("unknown database driver %s: expected %s or %s", driver, DriverMySQL, DriverSQLite)
+	}
+}
+
+// IsSQLite reports whether the database was opened with the sqlite driver.
+func IsSQLite(db *sqlx.DB) bool {
+	return db.DriverName() == sqliteDriverName
+}
+
+func configureSQLiteConn(conn *sqlite3.SQLiteConn) error {
+	for _, pragma := range []string{"PRAGMA foreign_keys = ON;", "PRAGMA busy_timeout = 5000;"} {
+		if _, err := conn.Exec(pragma, nil); err != nil {
+			return err
+		}
+	}
+
+	if err := conn.RegisterFunc("concat", sqliteConcat, true); err != nil {
+		return err
+	}
+
+	return conn.RegisterFunc("date_format", sqliteDateFormat, true)
+}
+
+// sqliteConcat behaves as the MySQL CONCAT: it returns NULL when any of its arguments is NULL. The driver
+// passes NULL arguments as a nil []byte.
+func sqliteConcat(args ...interface{}) interface{} {
+	var b strings.Builder
+	for _, arg := range args {
+		switch v := arg.(type) {
+		case nil:
+			return nil
+		case []byte:
+			if v == nil {
+				return nil
+			}
+
+			b.Write(v)
+		default:
+			fmt.Fprint(&b, v)
+		}
+	}
+
+	return b.String()
+}
+
+var mysqlDateFormat = strings.NewReplacer("%Y", "2006", "%m", "01", "%d", "02", "%H", "15", "%i", "04", "%s", "05")
+
+// sqliteDateFormat supports the specifiers of the MySQL DATE_FORMAT used by the storage queries.
+func sqliteDateFormat(value interface{}, format string) interface{} {
+	var raw string
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case []byte:
+		if v == nil {
+			return nil
+		}
+
+		raw = string(v)
+	case string:
+		raw = v
+	default:
+		raw = fmt.Sprint(v)
+	}
+
+	for _, layout := range []string{"2006-01-02", "2006-01-02 15:04:05", time.RFC3339} {
+		if t, err := time.Parse(layout, raw); err == nil {
+			return t.Format(mysqlDateFormat.Replace(format))
+		}
+	}
+
+	return nil
+}
+
+// sqliteSyntax rewrites the MySQL specific syntax of the storage queries to its SQLite equivalent.
+var sqliteSyntax = strings.NewReplacer(
+	"<=>", "IS",
+	"INSERT IGNORE", "INSERT OR IGNORE",
+	"ON DUPLICATE KEY UPDATE", "ON CONFLICT DO UPDATE SET",
+)
+
+type sqliteDriver struct {
+	driver *sqlite3.SQLiteDriver
+}
+
+func (d sqliteDriver) Open(name string) (driver.Conn, error) {
+	conn, err := d.driver.Open(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return sqliteConn{conn}, nil
+}
+
+type sqliteConn struct {
+	driver.Conn
+}
+
+func (c sqliteConn) Prepare(query string) (driver.Stmt, error) {
+	return c.PrepareContext(context.Background(), query)
+}
+
+func (c sqliteConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	stmt, err := c.Conn.(driver.ConnPrepareContext).PrepareContext(ctx, sqliteSyntax.Replace(query))
+	if err != nil {
+		return nil, err
+	}
+
+	return sqliteStmt{Stmt: stmt, delete: strings.HasPrefix(strings.ToUpper(strings.TrimSpace(query)), "DELETE")}, nil
+}
+
+func (c sqliteConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return c.Conn.(driver.ConnBeginTx).BeginTx(ctx, opts)
+}
+
+type sqliteStmt struct {
+	driver.Stmt
+	delete bool
+}
+
+func (s sqliteStmt) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	result, err := s.Stmt.(driver.StmtExecContext).ExecContext(ctx, args)
+	if err != nil {
+		return nil, s.translateError(err)
+	}
+
+	return result, nil
+}
+
+func (s sqliteStmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	return s.Stmt.(driver.StmtQueryContext).QueryContext(ctx, args)
+}
+
+// translateError tells apart the foreign key violations, that SQLite reports with a single code, by the
+// statement that raised them: deletes fail because of rows that reference the deleted one, while inserts
+// and updates fail because of a missing referenced row.
+func (s sqliteStmt) translateError(err error) error {
+	var se sqlite3.Error
+	if !errors.As(err, &se) {
+		return err
+	}
+
+	switch se.ExtendedCode {
+	case sqlite3.ErrConstraintUnique, sqlite3.ErrConstraintPrimaryKey:
+		return &constraintError{violation: duplicateEntry, err: err}
+	case sqlite3.ErrConstraintForeignKey:
+		if s.delete {
+			return &constraintError{violation: referencedRow, err: err}
+		}
+
+		return &constraintError{violation: missingReference, err: err}
+	default:
+		return err
+	}
+}
@@ -0,0 +1,172 @@
+package storage_test
+
+import (
+	"errors"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/migrations"
+)
+
+func newSQLiteDB(t *testing.T) *sqlx.DB {
+	db, err := storage.Open(storage.DriverSQLite, "file:"+filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() { _ = db.Close() })
+
+	migrator, err := migrations.NewMigrator(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := migrator.Up(); err != nil {
+		t.Fatal(err)
+	}
+
+	return db
+}
+
+func newSQLiteStorage(t *testing.T) *storage.Storage {
+	db := newSQLiteDB(t)
+	if _, err := db.Exec(`INSERT INTO term (year, cuatrimestre, start, end) VALUES (2021, 1, '2021-03-01', '2021-07-31');`); err != nil {
+		t.Fatal(err)
+	}
+
+	return storage.NewStorage(db)
+}
+
+func TestSQLiteStorage_StudentRecord(t *testing.T) {
+	// Given
+	s := newSQLiteStorage(t)
+
+	facultyID, err := s.CreateFaculty(storage.FacultyRequest{Name: "Exactas"})
+	require.NoError(t, err)
+
+	careerID, err := s.CreateCareer(storage.CareerRequest{FacultyID: facultyID, Name: "Sistemas"})
+	require.NoError(t, err)
+
+	career := strconv.Itoa(careerID)
+	subjectType := "OBLIGATORIA"
+	var subjects []string
+	for _, name := range []string{"Álgebra", "Análisis I"} {
+		subjectID, err := s.CreateSubject(storage.SubjectRequest{Name: name})
+		require.NoError(t, err)
+
+		subjects = append(subjects, strconv.Itoa(subjectID))
+		_, err = s.CreateCareerSubject(storage.CareerSubjectRequest{CareerID: career, SubjectID: strconv.Itoa(subjectID), Type: &subjectType})
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, s.CreateStudent("example", "example@gmail.com", "hash"))
+	require.NoError(t, s.AssignStudentToCareer("example@gmail.com", career))
+
+	term, err := s.GetTerm(2021, 1)
+	require.NoError(t, err)
+
+	// When
+	require.NoError(t, s.UpdateStudentSubject(storage.UpdateStudentSubjectRequest{
+		StudentEmail: "example@gmail.com",
+		CareerID:     career,
+		SubjectID:    subjects[0],
+		Status:       "CURSANDO",
+		TermID:       &term.ID,
+	}))
+
+	require.NoError(t, s.UpdateStudentSubject(storage.UpdateStudentSubjectRequest{
+		StudentEmail: "example@gmail.com",
+		CareerID:     career,
+		SubjectID:    subjects[0],
+		Status:       "APROBADA",
+	}))
+
+	_, err = s.CreateExam(storage.CreateExamRequest{StudentEmail: "example@gmail.com", CareerID: career, SubjectID: subjects[0], Grade: 8, Date: "2021-07-10"})
+	require.NoError(t, err)
+
+	studentSubjects, err := s.GetStudentSubjects("example@gmail.com", career)
+	require.NoError(t, err)
+
+	exams, err := s.GetStudentExams("example@gmail.com", career)
+	require.NoError(t, err)
+
+	// Then
+	require.Len(t, studentSubjects, 2)
+	require.Equal(t, "APROBADA", studentSubjects[0].Status)
+	require.Equal(t, "2021-1", *studentSubjects[0].Term)
+	require.Equal(t, "PENDIENTE", studentSubjects[1].Status)
+	require.Nil(t, studentSubjects[1].Term)
+	require.Equal(t, []storage.Exam{{SubjectID: 1, Grade: 8, Date: "2021-07-10"}}, exams)
+	require.Equal(t, storage.Term{ID: term.ID, Year: 2021, Cuatrimestre: 1, Start: "2021-03-01", End: "2021-07-31"}, term)
+}
+
+func TestSQLiteStorage_CatalogConstraints(t *testing.T) {
+	// Given
+	s := newSQLiteStorage(t)
+
+	facultyID, err := s.CreateFaculty(storage.FacultyRequest{Name: "Exactas"})
+	require.NoError(t, err)
+
+	careerID, err := s.CreateCareer(storage.CareerRequest{FacultyID: facultyID, Name: "Sistemas"})
+	require.NoError(t, err)
+
+	require.NoError(t, s.CreateStudent("example", "example@gmail.com", "hash"))
+
+	// When
+	_, missingFacultyErr := s.CreateCareer(storage.CareerRequest{FacultyID: 99, Name: "Física"})
+	inUseErr := s.DeleteFaculty(strconv.Itoa(facultyID))
+	duplicatedErr := s.CreateStudent("example", "example@gmail.com", "hash")
+
+	// Then
+	require.True(t, errors.Is(missingFacultyErr, storage.ErrNotFound), missingFacultyErr)
+	require.True(t, errors.Is(inUseErr, storage.ErrResourceInUse), inUseErr)
+	require.True(t, errors.Is(duplicatedErr, storage.ErrResourceAlreadyExist), duplicatedErr)
+	require.NoError(t, s.DeleteCareer(strconv.Itoa(careerID)))
+}
+
+func TestSQLiteStorage_ImportCareerPlan(t *testing.T) {
+	// Given
+	s := newSQLiteStorage(t)
+
+	facultyID, err := s.CreateFaculty(storage.FacultyRequest{Name: "Exactas"})
+	require.NoError(t, err)
+
+	careerID, err := s.CreateCareer(storage.CareerRequest{FacultyID: facultyID, Name: "Sistemas"})
+	require.NoError(t, err)
+
+	career := strconv.Itoa(careerID)
+	hours := 96
+	req := storage.ImportCareerPlanRequest{
+		CareerID: career,
+		Subjects: []storage.ImportSubject{
+			{
+				Name:  "Álgebra",
+				Hours: &hours,
+				Professorships: []storage.ImportProfessorship{
+					{Name: "Cátedra A", Schedules: []storage.ImportSchedule{{Day: 1, Start: "08:00", End: "10:00"}}},
+				},
+			},
+			{Name: "Análisis I", Correlatives: []storage.ImportCorrelative{{SubjectName: "Álgebra", Requirement: "APROBADA"}}},
+		},
+	}
+
+	// When
+	require.NoError(t, s.ImportCareerPlan(req))
+	require.NoError(t, s.ImportCareerPlan(req))
+
+	plan, err := s.GetCareerPlan(career)
+	require.NoError(t, err)
+
+	// Then
+	require.Len(t, plan.Subjects, 2)
+	require.Equal(t, 96, *plan.Subjects[0].Hours)
+	require.Equal(t, []storage.Correlative{{SubjectID: plan.Subjects[1].SubjectID, CorrelativeID: plan.Subjects[0].SubjectID, Requirement: "APROBADA"}}, plan.Correlatives)
+	require.Len(t, plan.Professorships, 1)
+	require.Nil(t, plan.Professorships[0].Term)
+	require.Equal(t, []storage.CareerPlanSchedule{{ProfessorshipID: plan.Professorships[0].ID, Day: 1, Start: "08:00", End: "10:00"}}, plan.Schedules)
+}
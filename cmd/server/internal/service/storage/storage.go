@@ -4,7 +4,6 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
-	"github.com/go-sql-driver/mysql"
 
 	"github.com/jmoiron/sqlx"
 )
@@ -23,17 +22,19 @@ func NewStorage(db *sqlx.DB) *Storage {
 }
 
 type StudentSubject struct {
-	ID            int
-	CorrelativeID int
-	Status        string
-	Name          string
-	Type          string
-	Description   *string
+	ID          int
+	Status      string
+	Name        string
+	Type        string
+	Hours       *int
+	Points      *int
+	Description *string
+	Term        *string
 }
 
-const createStudent = `INSERT INTO student (name, email) VALUES (:name, :email)`
+const createStudent = `INSERT INTO student (name, email, password_hash) VALUES (:name, :email, :passwordHash)`
 
-func (s *Storage) CreateStudent(name, studentEmail string) error {
+func (s *Storage) CreateStudent(name, studentEmail, passwordHash string) error {
 	stmt, err := s.db.PrepareNamed(createStudent)
 	if err != nil {
 		return err
@@ -41,17 +42,11 @@ func (s *Storage) CreateStudent(name, studentEmail string) error {
 
 	defer stmt.Close()
 
-	params := map[string]interface{}{"name": name, "email": studentEmail}
+	params := map[string]interface{}{"name": name, "email": studentEmail, "passwordHash": passwordHash}
 
 	_, err = stmt.Exec(params)
 	if err != nil {
-		me, ok := err.(*mysql.MySQLError)
-		if !ok {
-			return err
-		}
-
-		// Duplicate entry
-		if me.Number == 1062 {
+		if constraintViolation(err) == duplicateEntry {
 			return ErrResourceAlreadyExist
 		}
 
@@ -61,6 +56,41 @@ func (s *Storage) CreateStudent(name, studentEmail string) error {
 	return nil
 }
 
+type Credentials struct {
+	StudentID    int
+	PasswordHash string
+	Role         string
+}
+
+const getStudentCredentials = `SELECT id, password_hash, role FROM student WHERE email = :email AND password_hash IS NOT NULL`
+
+func (s *Storage) GetStudentCredentials(studentEmail string) (Credentials, error) {
+	stmt, err := s.db.PrepareNamed(getStudentCredentials)
+	if err != nil {
+		return Credentials{}, err
+	}
+
+	defer stmt.Close()
+
+	params := map[string]interface{}{"email": studentEmail}
+
+	var credentials struct {
+		StudentID    int    `db:"id"`
+		PasswordHash string `db:"password_hash"`
+		Role         string `db:"role"`
+	}
+
+	if err := stmt.Get(&credentials, params); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Credentials{}, fmt.Errorf("could not find student credentials: %w", ErrNotFound)
+		}
+
+		return Credentials{}, err
+	}
+
+	return Credentials(credentials), nil
+}
+
 const getStudentCareerIDs = `SELECT career_id FROM student_career sc
     INNER JOIN student s ON sc.student_id = s.id
 WHERE s.email = :email;`
@@ -98,7 +128,7 @@ const (
 	createStudentWithCareer = `INSERT INTO student_career (student_id, career_id) VALUES (?, ?);`
 )
 
-func (s *Storage) AssignStudentToCareer(studentEmail, careerID string) error {
+func (s *Storage) AssignStudentToCareer(studentEmail, careerID string) (err error) {
 	tx, err := s.db.Beginx()
 	if err != nil {
 		return fmt.Errorf("could not begin tx: %v", err)
@@ -148,7 +178,7 @@ func (s *Storage) AssignStudentToCareer(studentEmail, careerID string) error {
 	return nil
 }
 
-func (s *Storage) UpdateStudentSubject(req UpdateStudentSubjectRequest) error {
+func (s *Storage) UpdateStudentSubject(req UpdateStudentSubjectRequest) (err error) {
 	tx, err := s.db.Beginx()
 	if err != nil {
 		return fmt.Errorf("could not begin tx: %v", err)
@@ -174,7 +204,7 @@ func (s *Storage) UpdateStudentSubject(req UpdateStudentSubjectRequest) error {
 		return err
 	}
 
-	if err := s.updateStudentSubject(tx, studentID, careerSubjectID, req.Status, req.Description); err != nil {
+	if err := s.updateStudentSubject(tx, studentID, careerSubjectID, req.Status, req.Description, req.TermID); err != nil {
 		return err
 	}
 
@@ -215,7 +245,7 @@ func (s *Storage) checkStudentAssignedToCareer(tx *sqlx.Tx, studentID int, caree
 	return nil
 }
 
-const getCareerSubjectByIDs = `SELECT id FROM career_subject WHERE career_id = ? AND subject_id = ?`
+const getCareerSubjectByIDs = `SELECT id FROM career_subject WHERE career_id = ? AND subject_id = ? ORDER BY id LIMIT 1`
 
 func (s *Storage) getCareerSubjectByIDs(tx *sqlx.Tx, careerID, subjectID string) (int, error) {
 	var id int
@@ -231,13 +261,29 @@ func (s *Storage) getCareerSubjectByIDs(tx *sqlx.Tx, careerID, subjectID string)
 }
 
 const updateStudentSubject = `INSERT INTO student_career_subject
-    (student_id, career_subject_id, status, description)
-VALUES (?, ?, ?, ?)
+    (student_id, career_subject_id, status, description, term_id)
+VALUES (?, ?, ?, ?, ?)
 ON DUPLICATE KEY UPDATE status      = ?,
-                        description = ?;`
+                        description = ?,
+                        term_id     = IFNULL(?, term_id);`
+
+func (s *Storage) updateStudentSubject(tx *sqlx.Tx, studentID, careerSubjectID int, status string, description *string, termID *int) error {
+	if _, err := tx.Exec(updateStudentSubject, studentID, careerSubjectID, status, description, termID, status, description, termID); err != nil {
+		return err
+	}
 
-func (s *Storage) updateStudentSubject(tx *sqlx.Tx, studentID, careerSubjectID int, status string, description *string) error {
-	if _, err := tx.Exec(updateStudentSubject, studentID, careerSubjectID, status, description, status, description); err != nil {
+	return nil
+}
+
+// approveStudentSubject approves the subject for equivalences, keeping the description the student wrote.
+const approveStudentSubject = `INSERT INTO student_career_subject
+    (student_id, career_subject_id, status, description, term_id)
+VALUES (?, ?, 'APROBADA', NULL, ?)
+ON DUPLICATE KEY UPDATE status  = 'APROBADA',
+                        term_id = IFNULL(?, term_id);`
+
+func (s *Storage) approveStudentSubject(tx *sqlx.Tx, studentID, careerSubjectID int, termID *int) error {
+	if _, err := tx.Exec(approveStudentSubject, studentID, careerSubjectID, termID, termID); err != nil {
 		return err
 	}
 
@@ -250,19 +296,24 @@ type UpdateStudentSubjectRequest struct {
 	SubjectID    string
 	Status       string
 	Description  *string
+	TermID       *int
 }
 
 const getStudentSubjects = `SELECT cs.subject_id,
        s.name,
-       cs.correlative_id,
        cs.type,
+       cs.hours,
+       cs.points,
        IFNULL(scs.status, 'PENDIENTE') status,
-       scs.description
+       scs.description,
+       CONCAT(t.year, '-', t.cuatrimestre) term
 FROM student AS st
          INNER JOIN career_subject cs ON cs.career_id = :careerID
          INNER JOIN subject s on s.id = cs.subject_id
          LEFT JOIN student_career_subject scs ON scs.student_id = st.id AND scs.career_subject_id = cs.id
-WHERE st.email = :email`
+         LEFT JOIN term t ON t.id = scs.term_id
+WHERE st.email = :email
+ORDER BY cs.subject_id, cs.id`
 
 func (s *Storage) GetStudentSubjects(studentEmail, careerID string) ([]StudentSubject, error) {
 	stmt, err := s.db.PrepareNamed(getStudentSubjects)
@@ -275,12 +326,14 @@ func (s *Storage) GetStudentSubjects(studentEmail, careerID string) ([]StudentSu
 	params := map[string]interface{}{"email": studentEmail, "careerID": careerID}
 
 	var studentSubjects []struct {
-		ID            int64   `db:"subject_id"`
-		CorrelativeID *int64  `db:"correlative_id"`
-		Description   *string `db:"description"`
-		Status        string  `db:"status"`
-		Name          string  `db:"name"`
-		Type          string  `db:"type"`
+		ID          int64   `db:"subject_id"`
+		Description *string `db:"description"`
+		Status      string  `db:"status"`
+		Name        string  `db:"name"`
+		Type        string  `db:"type"`
+		Hours       *int    `db:"hours"`
+		Points      *int    `db:"points"`
+		Term        *string `db:"term"`
 	}
 
 	if err := stmt.Select(&studentSubjects, params); err != nil {
@@ -292,10 +345,10 @@ func (s *Storage) GetStudentSubjects(studentEmail, careerID string) ([]StudentSu
 	}
 
 	response := make([]StudentSubject, 0, len(studentSubjects))
-	for _, studentSubject := range studentSubjects {
-		var correlativeID int
-		if studentSubject.CorrelativeID != nil {
-			correlativeID = int(*studentSubject.CorrelativeID)
+	for i, studentSubject := range studentSubjects {
+		// Legacy plans repeat career_subject rows per correlative; the lowest id is the one students update.
+		if i > 0 && studentSubjects[i-1].ID == studentSubject.ID {
+			continue
 		}
 
 		var description *string
@@ -304,12 +357,14 @@ func (s *Storage) GetStudentSubjects(studentEmail, careerID string) ([]StudentSu
 		}
 
 		response = append(response, StudentSubject{
-			ID:            int(studentSubject.ID),
-			CorrelativeID: correlativeID,
-			Description:   description,
-			Status:        studentSubject.Status,
-			Name:          studentSubject.Name,
-			Type:          studentSubject.Type,
+			ID:          int(studentSubject.ID),
+			Description: description,
+			Status:      studentSubject.Status,
+			Name:        studentSubject.Name,
+			Type:        studentSubject.Type,
+			Hours:       studentSubject.Hours,
+			Points:      studentSubject.Points,
+			Term:        studentSubject.Term,
 		})
 	}
 
@@ -371,21 +426,28 @@ func (s *Storage) GetSubjectDetails(subjectID, careerID string) (SubjectDetails,
 	}, nil
 }
 
+// Professorship is a schedule of a professorship. A professorship without schedules has a single one without day,
+// start and end.
 type Professorship struct {
-	Day   int
+	ID    int
+	Day   *int
 	Name  string
-	Start string
-	End   string
+	Start *string
+	End   *string
 }
 
-const getProfessorships = `SELECT p.name, s.day, s.start, s.end
+const getProfessorships = `SELECT p.id, p.name, s.day, s.start, s.end
 FROM professorship p
-         INNER JOIN schedule s on p.id = s.professorship_id
+         LEFT JOIN schedule s on p.id = s.professorship_id
          INNER JOIN career_subject cs on p.career_subject_id = cs.id
-WHERE cs.subject_id = :subjectID AND cs.career_id = :careerID
+WHERE cs.subject_id = :subjectID
+  AND cs.career_id = :careerID
+  AND (:termID = '' OR p.term_id = :termID OR p.term_id IS NULL)
 ORDER BY day;`
 
-func (s *Storage) GetProfessorships(subjectID, careerID string) ([]Professorship, error) {
+// GetProfessorships returns the professorships of the subject dictated in the term and the ones without a term,
+// which predate terms. Every professorship is returned when the term is empty.
+func (s *Storage) GetProfessorships(subjectID, careerID, termID string) ([]Professorship, error) {
 	stmt, err := s.db.PrepareNamed(getProfessorships)
 	if err != nil {
 		return nil, err
@@ -393,13 +455,14 @@ func (s *Storage) GetProfessorships(subjectID, careerID string) ([]Professorship
 
 	defer stmt.Close()
 
-	params := map[string]interface{}{"subjectID": subjectID, "careerID": careerID}
+	params := map[string]interface{}{"subjectID": subjectID, "careerID": careerID, "termID": termID}
 
 	var professorships []struct {
-		Day   int    `db:"day"`
-		Name  string `db:"name"`
-		Start string `db:"start"`
-		End   string `db:"end"`
+		ID    int     `db:"id"`
+		Day   *int    `db:"day"`
+		Name  string  `db:"name"`
+		Start *string `db:"start"`
+		End   *string `db:"end"`
 	}
 
 	if err := stmt.Select(&professorships, params); err != nil {
@@ -413,6 +476,7 @@ func (s *Storage) GetProfessorships(subjectID, careerID string) ([]Professorship
 	response := make([]Professorship, 0, len(professorships))
 	for _, professorship := range professorships {
 		response = append(response, Professorship{
+			ID:    professorship.ID,
 			Day:   professorship.Day,
 			Name:  professorship.Name,
 			Start: professorship.Start,
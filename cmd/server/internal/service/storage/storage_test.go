@@ -22,15 +22,15 @@ func TestStorage_CreateStudent(t *testing.T) {
 
 	storage_ := NewStorage(sqlx.NewDb(db, ""))
 
-	q := `INSERT INTO student (name, email) VALUES (?, ?)`
+	q := `INSERT INTO student (name, email, password_hash) VALUES (?, ?, ?)`
 	mock.ExpectPrepare(q).WillReturnError(nil)
 	mock.ExpectExec(q).
-		WithArgs("example", "example@gmail.com").
+		WithArgs("example", "example@gmail.com", "hash").
 		WillReturnError(nil).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 
 	// When
-	err = storage_.CreateStudent("example", "example@gmail.com")
+	err = storage_.CreateStudent("example", "example@gmail.com", "hash")
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -50,11 +50,11 @@ func TestStorage_CreateStudent_PrepareStmtError(t *testing.T) {
 
 	storage_ := NewStorage(sqlx.NewDb(db, ""))
 
-	q := `INSERT INTO student (name, email) VALUES (?, ?)`
+	q := `INSERT INTO student (name, email, password_hash) VALUES (?, ?, ?)`
 	mock.ExpectPrepare(q).WillReturnError(errors.New("error"))
 
 	// When
-	err = storage_.CreateStudent("example", "example@gmail.com")
+	err = storage_.CreateStudent("example", "example@gmail.com", "hash")
 	if err == nil {
 		t.Fatal("test must fail")
 	}
@@ -74,14 +74,14 @@ func TestStorage_CreateStudent_ExecuteStmtError(t *testing.T) {
 
 	storage_ := NewStorage(sqlx.NewDb(db, ""))
 
-	q := `INSERT INTO student (name, email) VALUES (?, ?)`
+	q := `INSERT INTO student (name, email, password_hash) VALUES (?, ?, ?)`
 	mock.ExpectPrepare(q).WillReturnError(nil)
 	mock.ExpectExec(q).
-		WithArgs("example", "example@gmail.com").
+		WithArgs("example", "example@gmail.com", "hash").
 		WillReturnError(errors.New("error"))
 
 	// When
-	err = storage_.CreateStudent("example", "example@gmail.com")
+	err = storage_.CreateStudent("example", "example@gmail.com", "hash")
 	if err == nil {
 		t.Fatal("test must fail")
 	}
@@ -101,14 +101,14 @@ func TestStorage_CreateStudent_DuplicateEntryError(t *testing.T) {
 
 	storage_ := NewStorage(sqlx.NewDb(db, ""))
 
-	q := `INSERT INTO student (name, email) VALUES (?, ?)`
+	q := `INSERT INTO student (name, email, password_hash) VALUES (?, ?, ?)`
 	mock.ExpectPrepare(q).WillReturnError(nil)
 	mock.ExpectExec(q).
-		WithArgs("example", "example@gmail.com").
+		WithArgs("example", "example@gmail.com", "hash").
 		WillReturnError(&mysql.MySQLError{Number: 1062})
 
 	// When
-	err = storage_.CreateStudent("example", "example@gmail.com")
+	err = storage_.CreateStudent("example", "example@gmail.com", "hash")
 	if err == nil {
 		t.Fatal("test must fail")
 	}
@@ -117,6 +117,61 @@ func TestStorage_CreateStudent_DuplicateEntryError(t *testing.T) {
 	require.EqualError(t, err, "storage: resource already exist")
 }
 
+func TestStorage_GetStudentCredentials(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	q := `SELECT id, password_hash, role FROM student WHERE email = ? AND password_hash IS NOT NULL`
+	mock.ExpectPrepare(q).WillReturnError(nil)
+	mock.ExpectQuery(q).
+		WithArgs("example@gmail.com").
+		WillReturnError(nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "password_hash", "role"}).AddRow(1, "hash", "ADMIN"))
+
+	// When
+	credentials, err := storage_.GetStudentCredentials("example@gmail.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Equal(t, Credentials{StudentID: 1, PasswordHash: "hash", Role: "ADMIN"}, credentials)
+}
+
+func TestStorage_GetStudentCredentials_NotFoundError(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	q := `SELECT id, password_hash, role FROM student WHERE email = ? AND password_hash IS NOT NULL`
+	mock.ExpectPrepare(q).WillReturnError(nil)
+	mock.ExpectQuery(q).
+		WithArgs("example@gmail.com").
+		WillReturnError(sql.ErrNoRows)
+
+	// When
+	_, err = storage_.GetStudentCredentials("example@gmail.com")
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "could not find student credentials: storage: resource not found")
+}
+
 func TestStorage_GetStudentCareerIDs(t *testing.T) {
 	// Given
 	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
@@ -538,15 +593,15 @@ func TestStorage_UpdateStudentSubject(t *testing.T) {
 		WillReturnError(nil).
 		WillReturnRows(sqlmock.NewRows([]string{"COUNT(1)"}).AddRow(1))
 
-	q = `SELECT id FROM career_subject WHERE career_id = ? AND subject_id = ?`
+	q = `SELECT id FROM career_subject WHERE career_id = ? AND subject_id = ? ORDER BY id LIMIT 1`
 	mock.ExpectQuery(q).
 		WithArgs("1", "1").
 		WillReturnError(nil).
 		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
 
-	q = `INSERT INTO student_career_subject (student_id, career_subject_id, status, description) VALUES (?, ?, ?, ?) ON DUPLICATE KEY UPDATE status = ?, description = ?;`
+	q = `INSERT INTO student_career_subject (student_id, career_subject_id, status, description, term_id) VALUES (?, ?, ?, ?, ?) ON DUPLICATE KEY UPDATE status = ?, description = ?, term_id = IFNULL(?, term_id);`
 	mock.ExpectExec(q).
-		WithArgs(1, 2, "PENDIENTE", nil, "PENDIENTE", nil).
+		WithArgs(1, 2, "PENDIENTE", nil, nil, "PENDIENTE", nil, nil).
 		WillReturnError(nil).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 
@@ -777,7 +832,7 @@ func TestStorage_UpdateStudentSubject_GetCareerSubjectByIDsError(t *testing.T) {
 		WillReturnError(nil).
 		WillReturnRows(sqlmock.NewRows([]string{"COUNT(1)"}).AddRow(1))
 
-	q = `SELECT id FROM career_subject WHERE career_id = ? AND subject_id = ?`
+	q = `SELECT id FROM career_subject WHERE career_id = ? AND subject_id = ? ORDER BY id LIMIT 1`
 	mock.ExpectQuery(q).
 		WithArgs("1", "1").
 		WillReturnError(errors.New("error"))
@@ -826,7 +881,7 @@ func TestStorage_UpdateStudentSubject_GetCareerSubjectByIDsNotFoundError(t *test
 		WillReturnError(nil).
 		WillReturnRows(sqlmock.NewRows([]string{"COUNT(1)"}).AddRow(1))
 
-	q = `SELECT id FROM career_subject WHERE career_id = ? AND subject_id = ?`
+	q = `SELECT id FROM career_subject WHERE career_id = ? AND subject_id = ? ORDER BY id LIMIT 1`
 	mock.ExpectQuery(q).
 		WithArgs("1", "1").
 		WillReturnError(sql.ErrNoRows)
@@ -875,15 +930,15 @@ func TestStorage_UpdateStudentSubject_UpdateStudentSubjectError(t *testing.T) {
 		WillReturnError(nil).
 		WillReturnRows(sqlmock.NewRows([]string{"COUNT(1)"}).AddRow(1))
 
-	q = `SELECT id FROM career_subject WHERE career_id = ? AND subject_id = ?`
+	q = `SELECT id FROM career_subject WHERE career_id = ? AND subject_id = ? ORDER BY id LIMIT 1`
 	mock.ExpectQuery(q).
 		WithArgs("1", "1").
 		WillReturnError(nil).
 		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
 
-	q = `INSERT INTO student_career_subject (student_id, career_subject_id, status, description) VALUES (?, ?, ?, ?) ON DUPLICATE KEY UPDATE status = ?, description = ?;`
+	q = `INSERT INTO student_career_subject (student_id, career_subject_id, status, description, term_id) VALUES (?, ?, ?, ?, ?) ON DUPLICATE KEY UPDATE status = ?, description = ?, term_id = IFNULL(?, term_id);`
 	mock.ExpectExec(q).
-		WithArgs(1, 2, "PENDIENTE", nil, "PENDIENTE", nil).
+		WithArgs(1, 2, "PENDIENTE", nil, nil, "PENDIENTE", nil, nil).
 		WillReturnError(errors.New("error"))
 
 	mock.ExpectCommit()
@@ -930,15 +985,15 @@ func TestStorage_UpdateStudentSubject_CommitTxError(t *testing.T) {
 		WillReturnError(nil).
 		WillReturnRows(sqlmock.NewRows([]string{"COUNT(1)"}).AddRow(1))
 
-	q = `SELECT id FROM career_subject WHERE career_id = ? AND subject_id = ?`
+	q = `SELECT id FROM career_subject WHERE career_id = ? AND subject_id = ? ORDER BY id LIMIT 1`
 	mock.ExpectQuery(q).
 		WithArgs("1", "1").
 		WillReturnError(nil).
 		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
 
-	q = `INSERT INTO student_career_subject (student_id, career_subject_id, status, description) VALUES (?, ?, ?, ?) ON DUPLICATE KEY UPDATE status = ?, description = ?;`
+	q = `INSERT INTO student_career_subject (student_id, career_subject_id, status, description, term_id) VALUES (?, ?, ?, ?, ?) ON DUPLICATE KEY UPDATE status = ?, description = ?, term_id = IFNULL(?, term_id);`
 	mock.ExpectExec(q).
-		WithArgs(1, 2, "PENDIENTE", nil, "PENDIENTE", nil).
+		WithArgs(1, 2, "PENDIENTE", nil, nil, "PENDIENTE", nil, nil).
 		WillReturnError(nil).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 
@@ -974,22 +1029,26 @@ func TestStorage_GetStudentSubjects(t *testing.T) {
 
 	q := `SELECT cs.subject_id,
        s.name,
-       cs.correlative_id,
        cs.type,
+       cs.hours,
+       cs.points,
        IFNULL(scs.status, 'PENDIENTE') status,
-       scs.description
+       scs.description,
+       CONCAT(t.year, '-', t.cuatrimestre) term
 FROM student AS st
          INNER JOIN career_subject cs ON cs.career_id = ?
          INNER JOIN subject s on s.id = cs.subject_id
          LEFT JOIN student_career_subject scs ON scs.student_id = st.id AND scs.career_subject_id = cs.id
-WHERE st.email = ?`
+         LEFT JOIN term t ON t.id = scs.term_id
+WHERE st.email = ?
+ORDER BY cs.subject_id, cs.id`
 	mock.ExpectPrepare(q).WillReturnError(nil)
 	mock.ExpectQuery(q).
 		WithArgs("1", "example@gmail.com").
 		WillReturnError(nil).
 		WillReturnRows(
-			sqlmock.NewRows([]string{"subject_id", "name", "correlative_id", "type", "status", "description"}).
-				AddRow(2, "Subject 2", nil, "REQUIRED", "PENDING", nil))
+			sqlmock.NewRows([]string{"subject_id", "name", "type", "hours", "points", "status", "description", "term"}).
+				AddRow(2, "Subject 2", "REQUIRED", 96, nil, "PENDING", nil, "2021-1"))
 
 	// When
 	subjects, err := storage_.GetStudentSubjects("example@gmail.com", "1")
@@ -1004,14 +1063,16 @@ WHERE st.email = ?`
 	for _, subject := range subjects {
 		require.Equal(t, 2, subject.ID)
 		require.Equal(t, "Subject 2", subject.Name)
-		require.Equal(t, 0, subject.CorrelativeID)
 		require.Equal(t, "REQUIRED", subject.Type)
+		require.Equal(t, 96, *subject.Hours)
+		require.Equal(t, "2021-1", *subject.Term)
+		require.Nil(t, subject.Points)
 		require.Equal(t, "PENDING", subject.Status)
 		require.Nil(t, subject.Description)
 	}
 }
 
-func TestStorage_GetStudentSubjects_SubjectHasCorrelative(t *testing.T) {
+func TestStorage_GetStudentSubjects_DuplicatedCareerSubjects(t *testing.T) {
 	// Given
 	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
 	if err != nil {
@@ -1024,22 +1085,27 @@ func TestStorage_GetStudentSubjects_SubjectHasCorrelative(t *testing.T) {
 
 	q := `SELECT cs.subject_id,
        s.name,
-       cs.correlative_id,
        cs.type,
+       cs.hours,
+       cs.points,
        IFNULL(scs.status, 'PENDIENTE') status,
-       scs.description
+       scs.description,
+       CONCAT(t.year, '-', t.cuatrimestre) term
 FROM student AS st
          INNER JOIN career_subject cs ON cs.career_id = ?
          INNER JOIN subject s on s.id = cs.subject_id
          LEFT JOIN student_career_subject scs ON scs.student_id = st.id AND scs.career_subject_id = cs.id
-WHERE st.email = ?`
+         LEFT JOIN term t ON t.id = scs.term_id
+WHERE st.email = ?
+ORDER BY cs.subject_id, cs.id`
 	mock.ExpectPrepare(q).WillReturnError(nil)
 	mock.ExpectQuery(q).
 		WithArgs("1", "example@gmail.com").
 		WillReturnError(nil).
 		WillReturnRows(
-			sqlmock.NewRows([]string{"subject_id", "name", "correlative_id", "type", "status", "description"}).
-				AddRow(2, "Subject 2", 1, "REQUIRED", "PENDING", nil))
+			sqlmock.NewRows([]string{"subject_id", "name", "type", "hours", "points", "status", "description"}).
+				AddRow(2, "Subject 2", "REQUIRED", nil, nil, "APROBADA", nil).
+				AddRow(2, "Subject 2", "REQUIRED", nil, nil, "PENDIENTE", nil))
 
 	// When
 	subjects, err := storage_.GetStudentSubjects("example@gmail.com", "1")
@@ -1054,9 +1120,8 @@ WHERE st.email = ?`
 	for _, subject := range subjects {
 		require.Equal(t, 2, subject.ID)
 		require.Equal(t, "Subject 2", subject.Name)
-		require.Equal(t, 1, subject.CorrelativeID)
 		require.Equal(t, "REQUIRED", subject.Type)
-		require.Equal(t, "PENDING", subject.Status)
+		require.Equal(t, "APROBADA", subject.Status)
 		require.Nil(t, subject.Description)
 	}
 }
@@ -1074,22 +1139,26 @@ func TestStorage_GetStudentSubjects_SubjectHasDescription(t *testing.T) {
 
 	q := `SELECT cs.subject_id,
        s.name,
-       cs.correlative_id,
        cs.type,
+       cs.hours,
+       cs.points,
        IFNULL(scs.status, 'PENDIENTE') status,
-       scs.description
+       scs.description,
+       CONCAT(t.year, '-', t.cuatrimestre) term
 FROM student AS st
          INNER JOIN career_subject cs ON cs.career_id = ?
          INNER JOIN subject s on s.id = cs.subject_id
          LEFT JOIN student_career_subject scs ON scs.student_id = st.id AND scs.career_subject_id = cs.id
-WHERE st.email = ?`
+         LEFT JOIN term t ON t.id = scs.term_id
+WHERE st.email = ?
+ORDER BY cs.subject_id, cs.id`
 	mock.ExpectPrepare(q).WillReturnError(nil)
 	mock.ExpectQuery(q).
 		WithArgs("1", "example@gmail.com").
 		WillReturnError(nil).
 		WillReturnRows(
-			sqlmock.NewRows([]string{"subject_id", "name", "correlative_id", "type", "status", "description"}).
-				AddRow(2, "Subject 2", nil, "REQUIRED", "PENDING", "..."))
+			sqlmock.NewRows([]string{"subject_id", "name", "type", "hours", "points", "status", "description"}).
+				AddRow(2, "Subject 2", "REQUIRED", nil, nil, "PENDING", "..."))
 
 	// When
 	subjects, err := storage_.GetStudentSubjects("example@gmail.com", "1")
@@ -1104,7 +1173,6 @@ WHERE st.email = ?`
 	for _, subject := range subjects {
 		require.Equal(t, 2, subject.ID)
 		require.Equal(t, "Subject 2", subject.Name)
-		require.Equal(t, 0, subject.CorrelativeID)
 		require.Equal(t, "REQUIRED", subject.Type)
 		require.Equal(t, "PENDING", subject.Status)
 		require.NotNil(t, subject.Description)
@@ -1125,15 +1193,19 @@ func TestStorage_GetStudentSubjects_PrepareStmtError(t *testing.T) {
 
 	q := `SELECT cs.subject_id,
        s.name,
-       cs.correlative_id,
        cs.type,
+       cs.hours,
+       cs.points,
        IFNULL(scs.status, 'PENDIENTE') status,
-       scs.description
+       scs.description,
+       CONCAT(t.year, '-', t.cuatrimestre) term
 FROM student AS st
          INNER JOIN career_subject cs ON cs.career_id = ?
          INNER JOIN subject s on s.id = cs.subject_id
          LEFT JOIN student_career_subject scs ON scs.student_id = st.id AND scs.career_subject_id = cs.id
-WHERE st.email = ?`
+         LEFT JOIN term t ON t.id = scs.term_id
+WHERE st.email = ?
+ORDER BY cs.subject_id, cs.id`
 	mock.ExpectPrepare(q).WillReturnError(errors.New("error"))
 
 	// When
@@ -1159,15 +1231,19 @@ func TestStorage_GetStudentSubjects_ExecuteStmtError(t *testing.T) {
 
 	q := `SELECT cs.subject_id,
        s.name,
-       cs.correlative_id,
        cs.type,
+       cs.hours,
+       cs.points,
        IFNULL(scs.status, 'PENDIENTE') status,
-       scs.description
+       scs.description,
+       CONCAT(t.year, '-', t.cuatrimestre) term
 FROM student AS st
          INNER JOIN career_subject cs ON cs.career_id = ?
          INNER JOIN subject s on s.id = cs.subject_id
          LEFT JOIN student_career_subject scs ON scs.student_id = st.id AND scs.career_subject_id = cs.id
-WHERE st.email = ?`
+         LEFT JOIN term t ON t.id = scs.term_id
+WHERE st.email = ?
+ORDER BY cs.subject_id, cs.id`
 	mock.ExpectPrepare(q).WillReturnError(nil)
 	mock.ExpectQuery(q).
 		WithArgs("1", "example@gmail.com").
@@ -1295,35 +1371,35 @@ func TestStorage_GetProfessorships(t *testing.T) {
 
 	storage_ := NewStorage(sqlx.NewDb(db, ""))
 
-	q := `SELECT p.name, s.day, s.start, s.end
+	q := `SELECT p.id, p.name, s.day, s.start, s.end
 FROM professorship p
-         INNER JOIN schedule s on p.id = s.professorship_id
+         LEFT JOIN schedule s on p.id = s.professorship_id
          INNER JOIN career_subject cs on p.career_subject_id = cs.id
-WHERE cs.subject_id = ? AND cs.career_id = ?
+WHERE cs.subject_id = ?
+  AND cs.career_id = ?
+  AND (? = '' OR p.term_id = ? OR p.term_id IS NULL)
 ORDER BY day;`
 	mock.ExpectPrepare(q).WillReturnError(nil)
 	mock.ExpectQuery(q).
-		WithArgs("1", "2").
+		WithArgs("1", "2", "", "").
 		WillReturnError(nil).
 		WillReturnRows(
-			sqlmock.NewRows([]string{"name", "day", "start", "end"}).
-				AddRow("Professorship 1", 1, "17:00:00", "21:00:00"))
+			sqlmock.NewRows([]string{"id", "name", "day", "start", "end"}).
+				AddRow(4, "Professorship 2", nil, nil, nil).
+				AddRow(3, "Professorship 1", 1, "17:00:00", "21:00:00"))
 
 	// When
-	professorships, err := storage_.GetProfessorships("1", "2")
+	professorships, err := storage_.GetProfessorships("1", "2", "")
 	if err != nil {
 		t.Fatal(err)
 	}
 
 	// Then
-	require.Len(t, professorships, 1)
-
-	for _, professorship := range professorships {
-		require.Equal(t, "Professorship 1", professorship.Name)
-		require.Equal(t, 1, professorship.Day)
-		require.Equal(t, "17:00:00", professorship.Start)
-		require.Equal(t, "21:00:00", professorship.End)
-	}
+	day, start, end := 1, "17:00:00", "21:00:00"
+	require.Equal(t, []Professorship{
+		{ID: 4, Name: "Professorship 2"},
+		{ID: 3, Day: &day, Name: "Professorship 1", Start: &start, End: &end},
+	}, professorships)
 }
 
 func TestStorage_GetProfessorships_PrepareStmtError(t *testing.T) {
@@ -1337,16 +1413,18 @@ func TestStorage_GetProfessorships_PrepareStmtError(t *testing.T) {
 
 	storage_ := NewStorage(sqlx.NewDb(db, ""))
 
-	q := `SELECT p.name, s.day, s.start, s.end
+	q := `SELECT p.id, p.name, s.day, s.start, s.end
 FROM professorship p
-         INNER JOIN schedule s on p.id = s.professorship_id
+         LEFT JOIN schedule s on p.id = s.professorship_id
          INNER JOIN career_subject cs on p.career_subject_id = cs.id
-WHERE cs.subject_id = ? AND cs.career_id = ?
+WHERE cs.subject_id = ?
+  AND cs.career_id = ?
+  AND (? = '' OR p.term_id = ? OR p.term_id IS NULL)
 ORDER BY day;`
 	mock.ExpectPrepare(q).WillReturnError(errors.New("error"))
 
 	// When
-	_, err = storage_.GetProfessorships("1", "2")
+	_, err = storage_.GetProfessorships("1", "2", "")
 	if err == nil {
 		t.Fatal("test must fail")
 	}
@@ -1366,17 +1444,19 @@ func TestStorage_GetProfessorships_ExecuteStmtError(t *testing.T) {
 
 	storage_ := NewStorage(sqlx.NewDb(db, ""))
 
-	q := `SELECT p.name, s.day, s.start, s.end
+	q := `SELECT p.id, p.name, s.day, s.start, s.end
 FROM professorship p
-         INNER JOIN schedule s on p.id = s.professorship_id
+         LEFT JOIN schedule s on p.id = s.professorship_id
          INNER JOIN career_subject cs on p.career_subject_id = cs.id
-WHERE cs.subject_id = ? AND cs.career_id = ?
+WHERE cs.subject_id = ?
+  AND cs.career_id = ?
+  AND (? = '' OR p.term_id = ? OR p.term_id IS NULL)
 ORDER BY day;`
 	mock.ExpectPrepare(q).WillReturnError(nil)
 	mock.ExpectQuery(q).WillReturnError(errors.New("error"))
 
 	// When
-	_, err = storage_.GetProfessorships("1", "2")
+	_, err = storage_.GetProfessorships("1", "2", "")
 	if err == nil {
 		t.Fatal("test must fail")
 	}
@@ -0,0 +1,1129 @@
+// Package storagetest holds the contract test suite that every implementation of service.Storage must pass.
+package storagetest
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service"
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
+)
+
+// Fixtures creates the resources that service.Storage can only read.
+type Fixtures interface {
+	CreateProfessor(name string) (int, error)
+	AssignProfessor(professorshipID, professorID int, role string) error
+}
+
+// Factory returns an empty storage and its fixtures. It's called once per test.
+type Factory func(t *testing.T) (service.Storage, Fixtures)
+
+// Run runs the contract test suite against the storages built by newStorage.
+func Run(t *testing.T, newStorage Factory) {
+	tt := []struct {
+		name string
+		test func(t *testing.T, s service.Storage, f Fixtures)
+	}{
+		{name: "students", test: testStudents},
+		{name: "student lifecycle", test: testStudentLifecycle},
+		{name: "calendar token", test: testCalendarToken},
+		{name: "career assignment", test: testCareerAssignment},
+		{name: "career transfer", test: testCareerTransfer},
+		{name: "equivalences", test: testEquivalences},
+		{name: "student subjects", test: testStudentSubjects},
+		{name: "exams", test: testExams},
+		{name: "faculties and careers", test: testFacultiesAndCareers},
+		{name: "faculty policies", test: testFacultyPolicies},
+		{name: "subjects", test: testSubjects},
+		{name: "professorships", test: testProfessorships},
+		{name: "materials", test: testMaterials},
+		{name: "enrollment", test: testEnrollment},
+		{name: "enrollment terms", test: testEnrollmentTerms},
+		{name: "professors", test: testProfessors},
+		{name: "search catalog", test: testSearchCatalog},
+		{name: "terms", test: testTerms},
+		{name: "career plan", test: testCareerPlan},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			s, f := newStorage(t)
+			tc.test(t, s, f)
+		})
+	}
+}
+
+const (
+	studentEmail = "example@gmail.com"
+	missingID    = "99"
+)
+
+type catalog struct {
+	facultyID int
+	careerID  int
+	career    string
+	subjects  []string
+}
+
+var subjectType = "OBLIGATORIA"
+
+// newCatalog creates a career with the subjects Álgebra and Análisis I.
+func newCatalog(t *testing.T, s service.Storage) catalog {
+	facultyID, err := s.CreateFaculty(storage.FacultyRequest{Name: "Exactas"})
+	require.NoError(t, err)
+
+	careerID, err := s.CreateCareer(storage.CareerRequest{FacultyID: facultyID, Name: "Sistemas"})
+	require.NoError(t, err)
+
+	c := catalog{facultyID: facultyID, careerID: careerID, career: strconv.Itoa(careerID)}
+	for _, name := range []string{"Álgebra", "Análisis I"} {
+		subjectID, err := s.CreateSubject(storage.SubjectRequest{Name: name})
+		require.NoError(t, err)
+
+		c.subjects = append(c.subjects, strconv.Itoa(subjectID))
+		_, err = s.CreateCareerSubject(storage.CareerSubjectRequest{CareerID: c.career, SubjectID: strconv.Itoa(subjectID), Type: &subjectType})
+		require.NoError(t, err)
+	}
+
+	return c
+}
+
+func atoi(t *testing.T, id string) int {
+	n, err := strconv.Atoi(id)
+	require.NoError(t, err)
+
+	return n
+}
+
+func requireError(t *testing.T, err, target error) {
+	t.Helper()
+	require.True(t, errors.Is(err, target), "expected %v, got %v", target, err)
+}
+
+func testStudents(t *testing.T, s service.Storage, _ Fixtures) {
+	require.NoError(t, s.CreateStudent("example", studentEmail, "hash"))
+	requireError(t, s.CreateStudent("other", studentEmail, "other hash"), storage.ErrResourceAlreadyExist)
+
+	credentials, err := s.GetStudentCredentials(studentEmail)
+	require.NoError(t, err)
+	require.Equal(t, storage.Credentials{StudentID: 1, PasswordHash: "hash", Role: "STUDENT"}, credentials)
+
+	_, err = s.GetStudentCredentials("unknown@gmail.com")
+	requireError(t, err, storage.ErrNotFound)
+}
+
+func testStudentLifecycle(t *testing.T, s service.Storage, _ Fixtures) {
+	c := newCatalog(t, s)
+	require.NoError(t, s.CreateStudent("example", studentEmail, "hash"))
+	require.NoError(t, s.CreateStudent("other", "other@gmail.com", "hash"))
+
+	// The storage is empty, so the students get the first ids.
+	studentID, otherID := "1", "2"
+
+	student, err := s.GetStudent(studentID)
+	require.NoError(t, err)
+	require.Equal(t, storage.Student{ID: 1, Name: "example", Email: studentEmail, Role: "STUDENT"}, student)
+
+	_, err = s.GetStudent(missingID)
+	requireError(t, err, storage.ErrNotFound)
+
+	name, passwordHash := "updated", "new hash"
+	require.NoError(t, s.UpdateStudent(storage.UpdateStudentRequest{StudentID: studentID, Name: &name}))
+	require.NoError(t, s.UpdateStudent(storage.UpdateStudentRequest{StudentID: studentID, PasswordHash: &passwordHash}))
+	requireError(t, s.UpdateStudent(storage.UpdateStudentRequest{StudentID: missingID, Name: &name}), storage.ErrNotFound)
+
+	student, err = s.GetStudent(studentID)
+	require.NoError(t, err)
+	require.Equal(t, "updated", student.Name)
+
+	credentials, err := s.GetStudentCredentials(studentEmail)
+	require.NoError(t, err)
+	require.Equal(t, "new hash", credentials.PasswordHash)
+
+	change := storage.ChangeStudentEmailRequest{StudentID: studentID, Email: "other@gmail.com", ChangedAt: "2021-03-01 10:00:00"}
+	requireError(t, s.ChangeStudentEmail(change), storage.ErrResourceAlreadyExist)
+
+	change.StudentID = missingID
+	requireError(t, s.ChangeStudentEmail(change), storage.ErrNotFound)
+
+	require.NoError(t, s.ChangeStudentEmail(storage.ChangeStudentEmailRequest{StudentID: studentID, Email: "new@gmail.com", ChangedAt: "2021-03-01 10:00:00"}))
+	require.NoError(t, s.ChangeStudentEmail(storage.ChangeStudentEmailRequest{StudentID: studentID, Email: "new@gmail.com", ChangedAt: "2021-03-02 10:00:00"}))
+	require.NoError(t, s.ChangeStudentEmail(storage.ChangeStudentEmailRequest{StudentID: studentID, Email: studentEmail, ChangedAt: "2021-03-03 10:00:00"}))
+
+	history, err := s.GetStudentEmailHistory(studentID)
+	require.NoError(t, err)
+	require.Equal(t, []storage.EmailChange{
+		{OldEmail: studentEmail, NewEmail: "new@gmail.com", ChangedAt: "2021-03-01 10:00:00"},
+		{OldEmail: "new@gmail.com", NewEmail: studentEmail, ChangedAt: "2021-03-03 10:00:00"},
+	}, history)
+
+	history, err = s.GetStudentEmailHistory(otherID)
+	require.NoError(t, err)
+	require.Empty(t, history)
+
+	require.NoError(t, s.AssignStudentToCareer(studentEmail, c.career))
+	require.NoError(t, s.UpdateStudentSubject(storage.UpdateStudentSubjectRequest{StudentEmail: studentEmail, CareerID: c.career, SubjectID: c.subjects[0], Status: "APROBADA"}))
+	_, err = s.CreateExam(storage.CreateExamRequest{StudentEmail: studentEmail, CareerID: c.career, SubjectID: c.subjects[0], Grade: 8, Date: "2021-07-10"})
+	require.NoError(t, err)
+
+	professorshipID, err := s.CreateProfessorship(storage.CreateProfessorshipRequest{CareerID: c.career, SubjectID: c.subjects[0], Name: "Cátedra 1"})
+	require.NoError(t, err)
+	require.NoError(t, s.EnrollStudentInProfessorship(studentEmail, strconv.Itoa(professorshipID)))
+
+	require.NoError(t, s.DeleteStudent(studentID))
+	requireError(t, s.DeleteStudent(studentID), storage.ErrNotFound)
+
+	_, err = s.GetStudent(studentID)
+	requireError(t, err, storage.ErrNotFound)
+
+	history, err = s.GetStudentEmailHistory(studentID)
+	require.NoError(t, err)
+	require.Empty(t, history)
+
+	// The catalog is no longer referenced by the student.
+	require.NoError(t, s.DeleteProfessorship(strconv.Itoa(professorshipID)))
+	require.NoError(t, s.DeleteCareerSubject(c.career, c.subjects[0]))
+
+	_, err = s.GetStudent(otherID)
+	require.NoError(t, err)
+}
+
+func testCalendarToken(t *testing.T, s service.Storage, _ Fixtures) {
+	require.NoError(t, s.CreateStudent("example", studentEmail, "hash"))
+	require.NoError(t, s.CreateStudent("other", "other@gmail.com", "hash"))
+
+	tokenHash, otherHash := "hash", "other hash"
+	require.NoError(t, s.SetStudentCalendarToken(studentEmail, &tokenHash))
+	requireError(t, s.SetStudentCalendarToken("other@gmail.com", &tokenHash), storage.ErrResourceAlreadyExist)
+	requireError(t, s.SetStudentCalendarToken("unknown@gmail.com", &tokenHash), storage.ErrNotFound)
+
+	email, err := s.GetStudentEmailByCalendarToken(tokenHash)
+	require.NoError(t, err)
+	require.Equal(t, studentEmail, email)
+
+	require.NoError(t, s.SetStudentCalendarToken(studentEmail, &otherHash))
+	_, err = s.GetStudentEmailByCalendarToken(tokenHash)
+	requireError(t, err, storage.ErrNotFound)
+
+	// Revoked tokens are stored as NULL, which never matches and doesn't collide with other students.
+	require.NoError(t, s.SetStudentCalendarToken(studentEmail, nil))
+	require.NoError(t, s.SetStudentCalendarToken("other@gmail.com", nil))
+	_, err = s.GetStudentEmailByCalendarToken(otherHash)
+	requireError(t, err, storage.ErrNotFound)
+}
+
+func testCareerAssignment(t *testing.T, s service.Storage, _ Fixtures) {
+	c := newCatalog(t, s)
+	require.NoError(t, s.CreateStudent("example", studentEmail, "hash"))
+
+	careerIDs, err := s.GetStudentCareerIDs(studentEmail)
+	require.NoError(t, err)
+	require.Empty(t, careerIDs)
+
+	requireError(t, s.AssignStudentToCareer("unknown@gmail.com", c.career), storage.ErrNotFound)
+	requireError(t, s.AssignStudentToCareer(studentEmail, missingID), storage.ErrNotFound)
+	require.NoError(t, s.AssignStudentToCareer(studentEmail, c.career))
+
+	careerIDs, err = s.GetStudentCareerIDs(studentEmail)
+	require.NoError(t, err)
+	require.Equal(t, []int{c.careerID}, careerIDs)
+}
+
+func testCareerTransfer(t *testing.T, s service.Storage, f Fixtures) {
+	c := newCatalog(t, s)
+	termID, err := s.CreateTerm(storage.TermRequest{Year: 2021, Cuatrimestre: 1, Start: "2021-03-01", End: "2021-07-31"})
+	require.NoError(t, err)
+
+	// Computación shares Álgebra with Sistemas.
+	otherID, err := s.CreateCareer(storage.CareerRequest{FacultyID: c.facultyID, Name: "Computación"})
+	require.NoError(t, err)
+
+	other := strconv.Itoa(otherID)
+	_, err = s.CreateCareerSubject(storage.CareerSubjectRequest{CareerID: other, SubjectID: c.subjects[0], Type: &subjectType})
+	require.NoError(t, err)
+
+	require.NoError(t, s.CreateStudent("example", studentEmail, "hash"))
+
+	transfer := storage.TransferStudentCareerRequest{StudentEmail: studentEmail, FromCareerID: c.career, ToCareerID: other}
+	_, err = s.TransferStudentCareer(transfer)
+	requireError(t, err, storage.ErrNotFound)
+	requireError(t, s.UnassignStudentFromCareer(studentEmail, c.career), storage.ErrNotFound)
+
+	require.NoError(t, s.AssignStudentToCareer(studentEmail, c.career))
+	for _, subject := range c.subjects {
+		require.NoError(t, s.UpdateStudentSubject(storage.UpdateStudentSubjectRequest{StudentEmail: studentEmail, CareerID: c.career, SubjectID: subject, Status: "APROBADA", TermID: &termID}))
+	}
+
+	_, err = s.CreateExam(storage.CreateExamRequest{StudentEmail: studentEmail, CareerID: c.career, SubjectID: c.subjects[0], Grade: 8, Date: "2021-07-10"})
+	require.NoError(t, err)
+
+	professorshipID, err := s.CreateProfessorship(storage.CreateProfessorshipRequest{CareerID: c.career, SubjectID: c.subjects[1], Name: "Cátedra 1"})
+	require.NoError(t, err)
+	require.NoError(t, s.EnrollStudentInProfessorship(studentEmail, strconv.Itoa(professorshipID)))
+
+	// Física, in a third career, is credited from Álgebra, which moves with the transfer, and Inglés from
+	// Análisis I, which is left behind.
+	thirdID, err := s.CreateCareer(storage.CareerRequest{FacultyID: c.facultyID, Name: "Electrónica"})
+	require.NoError(t, err)
+
+	third := strconv.Itoa(thirdID)
+	var thirdSubjects []string
+	for _, name := range []string{"Física", "Inglés"} {
+		subjectID, err := s.CreateSubject(storage.SubjectRequest{Name: name})
+		require.NoError(t, err)
+
+		_, err = s.CreateCareerSubject(storage.CareerSubjectRequest{CareerID: third, SubjectID: strconv.Itoa(subjectID), Type: &subjectType})
+		require.NoError(t, err)
+
+		thirdSubjects = append(thirdSubjects, strconv.Itoa(subjectID))
+	}
+
+	require.NoError(t, s.AssignStudentToCareer(studentEmail, third))
+	for i, source := range []string{c.subjects[0], c.subjects[1]} {
+		requestID, err := s.CreateStudentEquivalence(storage.StudentEquivalenceRequest{StudentEmail: studentEmail, CareerID: third, SubjectID: thirdSubjects[i], SourceCareerID: c.career, SourceSubjectID: source, CreatedAt: "2021-08-01 12:00:00"})
+		require.NoError(t, err)
+		require.NoError(t, s.ResolveEquivalenceRequest(storage.ResolveEquivalenceRequest{RequestID: strconv.Itoa(requestID), Status: "APROBADA", ResolvedAt: "2021-08-02 12:00:00"}))
+	}
+
+	thirdStatuses := func() []string {
+		subjects, err := s.GetStudentSubjects(studentEmail, third)
+		require.NoError(t, err)
+
+		statuses := make([]string, 0, len(subjects))
+		for _, subject := range subjects {
+			statuses = append(statuses, subject.Status)
+		}
+
+		return statuses
+	}
+
+	_, err = s.TransferStudentCareer(storage.TransferStudentCareerRequest{StudentEmail: studentEmail, FromCareerID: c.career, ToCareerID: missingID})
+	requireError(t, err, storage.ErrNotFound)
+
+	subjectIDs, err := s.TransferStudentCareer(transfer)
+	require.NoError(t, err)
+	require.Equal(t, []int{atoi(t, c.subjects[0])}, subjectIDs)
+
+	careerIDs, err := s.GetStudentCareerIDs(studentEmail)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []int{otherID, thirdID}, careerIDs)
+
+	studentEquivalences, err := s.GetStudentEquivalences(studentEmail, third)
+	require.NoError(t, err)
+	require.Len(t, studentEquivalences, 1)
+	require.Equal(t, atoi(t, thirdSubjects[0]), studentEquivalences[0].SubjectID)
+	require.Equal(t, otherID, studentEquivalences[0].SourceCareerID)
+	require.Equal(t, atoi(t, c.subjects[0]), studentEquivalences[0].SourceSubjectID)
+
+	// Inglés loses the credit of the subject left behind.
+	require.Equal(t, []string{"APROBADA", "PENDIENTE"}, thirdStatuses())
+
+	term := "2021-1"
+	subjects, err := s.GetStudentSubjects(studentEmail, other)
+	require.NoError(t, err)
+	require.Equal(t, []storage.StudentSubject{{ID: atoi(t, c.subjects[0]), Status: "APROBADA", Name: "Álgebra", Type: subjectType, Term: &term}}, subjects)
+
+	exams, err := s.GetStudentExams(studentEmail, other)
+	require.NoError(t, err)
+	require.Equal(t, []storage.Exam{{SubjectID: atoi(t, c.subjects[0]), Grade: 8, Date: "2021-07-10"}}, exams)
+
+	schedules, err := s.GetStudentSchedules(studentEmail, "")
+	require.NoError(t, err)
+	require.Empty(t, schedules)
+
+	_, err = s.GetStudentSchedules("missing@gmail.com", "")
+	requireError(t, err, storage.ErrNotFound)
+
+	// Nothing of the student references the old career anymore.
+	require.NoError(t, s.DeleteProfessorship(strconv.Itoa(professorshipID)))
+	require.NoError(t, s.DeleteCareerSubject(c.career, c.subjects[0]))
+	require.NoError(t, s.DeleteCareerSubject(c.career, c.subjects[1]))
+
+	require.NoError(t, s.UnassignStudentFromCareer(studentEmail, other))
+	requireError(t, s.UnassignStudentFromCareer(studentEmail, other), storage.ErrNotFound)
+
+	studentEquivalences, err = s.GetStudentEquivalences(studentEmail, third)
+	require.NoError(t, err)
+	require.Empty(t, studentEquivalences)
+	require.Equal(t, []string{"PENDIENTE", "PENDIENTE"}, thirdStatuses())
+
+	require.NoError(t, s.UnassignStudentFromCareer(studentEmail, third))
+
+	careerIDs, err = s.GetStudentCareerIDs(studentEmail)
+	require.NoError(t, err)
+	require.Empty(t, careerIDs)
+	require.NoError(t, s.DeleteCareerSubject(other, c.subjects[0]))
+}
+
+func testEquivalences(t *testing.T, s service.Storage, f Fixtures) {
+	c := newCatalog(t, s)
+	termID, err := s.CreateTerm(storage.TermRequest{Year: 2021, Cuatrimestre: 1, Start: "2021-03-01", End: "2021-07-31"})
+	require.NoError(t, err)
+
+	// Computación shares Álgebra with Sistemas and has its own Análisis Matemático and Física.
+	otherID, err := s.CreateCareer(storage.CareerRequest{FacultyID: c.facultyID, Name: "Computación"})
+	require.NoError(t, err)
+
+	other := strconv.Itoa(otherID)
+	subjects := []string{c.subjects[0]}
+	for _, name := range []string{"Análisis Matemático", "Física"} {
+		subjectID, err := s.CreateSubject(storage.SubjectRequest{Name: name})
+		require.NoError(t, err)
+
+		subjects = append(subjects, strconv.Itoa(subjectID))
+	}
+
+	for _, subject := range subjects {
+		_, err = s.CreateCareerSubject(storage.CareerSubjectRequest{CareerID: other, SubjectID: subject, Type: &subjectType})
+		require.NoError(t, err)
+	}
+
+	equivalenceID, err := s.CreateEquivalence(storage.EquivalenceRequest{CareerID: c.career, SubjectID: c.subjects[1], EquivalentCareerID: other, EquivalentSubjectID: subjects[1]})
+	require.NoError(t, err)
+
+	_, err = s.CreateEquivalence(storage.EquivalenceRequest{CareerID: other, SubjectID: subjects[1], EquivalentCareerID: c.career, EquivalentSubjectID: c.subjects[1]})
+	requireError(t, err, storage.ErrResourceAlreadyExist)
+
+	_, err = s.CreateEquivalence(storage.EquivalenceRequest{CareerID: c.career, SubjectID: missingID, EquivalentCareerID: other, EquivalentSubjectID: subjects[1]})
+	requireError(t, err, storage.ErrNotFound)
+
+	equivalences, err := s.GetEquivalences(other)
+	require.NoError(t, err)
+	require.Equal(t, []storage.Equivalence{{
+		ID:                    equivalenceID,
+		CareerID:              otherID,
+		SubjectID:             atoi(t, subjects[1]),
+		SubjectName:           "Análisis Matemático",
+		EquivalentCareerID:    c.careerID,
+		EquivalentSubjectID:   atoi(t, c.subjects[1]),
+		EquivalentSubjectName: "Análisis I",
+	}}, equivalences)
+
+	require.NoError(t, s.CreateStudent("example", studentEmail, "hash"))
+	require.NoError(t, s.AssignStudentToCareer(studentEmail, c.career))
+	for _, subject := range c.subjects {
+		require.NoError(t, s.UpdateStudentSubject(storage.UpdateStudentSubjectRequest{StudentEmail: studentEmail, CareerID: c.career, SubjectID: subject, Status: "APROBADA", TermID: &termID}))
+	}
+
+	_, err = s.AssignStudentToCareerWithCredits(studentEmail, missingID, "2021-04-01 12:00:00")
+	requireError(t, err, storage.ErrNotFound)
+
+	_, err = s.AssignStudentToCareerWithCredits("unknown@gmail.com", other, "2021-04-01 12:00:00")
+	requireError(t, err, storage.ErrNotFound)
+
+	careerIDs, err := s.GetStudentCareerIDs(studentEmail)
+	require.NoError(t, err)
+	require.Equal(t, []int{c.careerID}, careerIDs)
+
+	credits, err := s.AssignStudentToCareerWithCredits(studentEmail, other, "2021-04-01 12:00:00")
+	require.NoError(t, err)
+	require.Len(t, credits, 2)
+	require.Equal(t, []int{atoi(t, c.subjects[0]), atoi(t, subjects[1])}, []int{credits[0].SubjectID, credits[1].SubjectID})
+	require.Equal(t, "Análisis I", credits[1].SourceSubjectName)
+	require.Equal(t, c.careerID, credits[1].SourceCareerID)
+	require.True(t, credits[1].Automatic)
+
+	careerIDs, err = s.GetStudentCareerIDs(studentEmail)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []int{c.careerID, otherID}, careerIDs)
+
+	request := storage.StudentEquivalenceRequest{StudentEmail: studentEmail, CareerID: other, SubjectID: subjects[2], SourceCareerID: c.career, SourceSubjectID: c.subjects[0], CreatedAt: "2021-04-03 12:00:00"}
+	requestID, err := s.CreateStudentEquivalence(request)
+	require.NoError(t, err)
+
+	_, err = s.CreateStudentEquivalence(request)
+	requireError(t, err, storage.ErrResourceAlreadyExist)
+
+	_, err = s.CreateStudentEquivalence(storage.StudentEquivalenceRequest{StudentEmail: studentEmail, CareerID: other, SubjectID: subjects[0], SourceCareerID: c.career, SourceSubjectID: c.subjects[0], CreatedAt: "2021-04-03 12:00:00"})
+	requireError(t, err, storage.ErrResourceAlreadyExist)
+
+	_, err = s.CreateStudentEquivalence(storage.StudentEquivalenceRequest{StudentEmail: studentEmail, CareerID: c.career, SubjectID: c.subjects[0], SourceCareerID: other, SourceSubjectID: subjects[2], CreatedAt: "2021-04-03 12:00:00"})
+	requireError(t, err, storage.ErrNotFound)
+
+	requests, err := s.GetEquivalenceRequests("PENDIENTE")
+	require.NoError(t, err)
+	require.Equal(t, []storage.StudentEquivalence{{
+		ID:                requestID,
+		StudentEmail:      studentEmail,
+		CareerID:          otherID,
+		SubjectID:         atoi(t, subjects[2]),
+		SubjectName:       "Física",
+		SourceCareerID:    c.careerID,
+		SourceSubjectID:   atoi(t, c.subjects[0]),
+		SourceSubjectName: "Álgebra",
+		Status:            "PENDIENTE",
+		CreatedAt:         "2021-04-03 12:00:00",
+	}}, requests)
+
+	// Approving the request keeps the description the student wrote.
+	description := "Comisión B"
+	require.NoError(t, s.UpdateStudentSubject(storage.UpdateStudentSubjectRequest{StudentEmail: studentEmail, CareerID: other, SubjectID: subjects[2], Status: "CURSANDO", Description: &description}))
+
+	resolve := storage.ResolveEquivalenceRequest{RequestID: strconv.Itoa(requestID), Status: "APROBADA", ResolvedAt: "2021-04-04 12:00:00"}
+	require.NoError(t, s.ResolveEquivalenceRequest(resolve))
+	requireError(t, s.ResolveEquivalenceRequest(resolve), storage.ErrNotFound)
+
+	requests, err = s.GetEquivalenceRequests("PENDIENTE")
+	require.NoError(t, err)
+	require.Empty(t, requests)
+
+	term := "2021-1"
+	studentSubjects, err := s.GetStudentSubjects(studentEmail, other)
+	require.NoError(t, err)
+	for _, subject := range studentSubjects {
+		require.Equal(t, "APROBADA", subject.Status)
+		require.Equal(t, &term, subject.Term)
+	}
+	require.Equal(t, &description, studentSubjects[2].Description)
+
+	studentEquivalences, err := s.GetStudentEquivalences(studentEmail, other)
+	require.NoError(t, err)
+	require.Len(t, studentEquivalences, 3)
+	require.Equal(t, "APROBADA", studentEquivalences[2].Status)
+	require.False(t, studentEquivalences[2].Automatic)
+	require.Equal(t, "2021-04-04 12:00:00", *studentEquivalences[2].ResolvedAt)
+
+	requireError(t, s.DeleteCareerSubject(other, subjects[1]), storage.ErrResourceInUse)
+	require.NoError(t, s.DeleteEquivalence(strconv.Itoa(equivalenceID)))
+	requireError(t, s.DeleteEquivalence(strconv.Itoa(equivalenceID)), storage.ErrNotFound)
+
+	require.NoError(t, s.UnassignStudentFromCareer(studentEmail, other))
+	studentEquivalences, err = s.GetStudentEquivalences(studentEmail, other)
+	require.NoError(t, err)
+	require.Empty(t, studentEquivalences)
+
+	// Nothing references the subjects of Computación anymore.
+	require.NoError(t, s.DeleteCareerSubject(other, subjects[1]))
+}
+
+func testStudentSubjects(t *testing.T, s service.Storage, f Fixtures) {
+	c := newCatalog(t, s)
+	termID, err := s.CreateTerm(storage.TermRequest{Year: 2021, Cuatrimestre: 1, Start: "2021-03-01", End: "2021-07-31"})
+	require.NoError(t, err)
+
+	require.NoError(t, s.CreateStudent("example", studentEmail, "hash"))
+
+	req := storage.UpdateStudentSubjectRequest{StudentEmail: studentEmail, CareerID: c.career, SubjectID: c.subjects[0], Status: "CURSANDO"}
+	requireError(t, s.UpdateStudentSubject(req), storage.ErrNotFound)
+
+	require.NoError(t, s.AssignStudentToCareer(studentEmail, c.career))
+
+	missing := req
+	missing.SubjectID = missingID
+	requireError(t, s.UpdateStudentSubject(missing), storage.ErrNotFound)
+
+	description := "Comisión 2"
+	req.Description = &description
+	req.TermID = &termID
+	require.NoError(t, s.UpdateStudentSubject(req))
+
+	subjects, err := s.GetStudentSubjects(studentEmail, c.career)
+	require.NoError(t, err)
+	require.Equal(t, "CURSANDO", subjects[0].Status)
+	require.Equal(t, &description, subjects[0].Description)
+
+	// Updates without term keep the one of the previous status.
+	require.NoError(t, s.UpdateStudentSubject(storage.UpdateStudentSubjectRequest{StudentEmail: studentEmail, CareerID: c.career, SubjectID: c.subjects[0], Status: "APROBADA"}))
+
+	subjects, err = s.GetStudentSubjects(studentEmail, c.career)
+	require.NoError(t, err)
+
+	term := "2021-1"
+	require.Equal(t, []storage.StudentSubject{
+		{ID: atoi(t, c.subjects[0]), Status: "APROBADA", Name: "Álgebra", Type: subjectType, Term: &term},
+		{ID: atoi(t, c.subjects[1]), Status: "PENDIENTE", Name: "Análisis I", Type: subjectType},
+	}, subjects)
+
+	_, err = s.GetStudentSubjects("unknown@gmail.com", c.career)
+	requireError(t, err, storage.ErrNotFound)
+}
+
+func testExams(t *testing.T, s service.Storage, _ Fixtures) {
+	c := newCatalog(t, s)
+	require.NoError(t, s.CreateStudent("example", studentEmail, "hash"))
+
+	req := storage.CreateExamRequest{StudentEmail: studentEmail, CareerID: c.career, SubjectID: c.subjects[1], Grade: 4, Date: "2021-12-10"}
+	_, err := s.CreateExam(req)
+	requireError(t, err, storage.ErrNotFound)
+
+	require.NoError(t, s.AssignStudentToCareer(studentEmail, c.career))
+
+	_, err = s.CreateExam(req)
+	require.NoError(t, err)
+
+	_, err = s.CreateExam(storage.CreateExamRequest{StudentEmail: studentEmail, CareerID: c.career, SubjectID: c.subjects[0], Grade: 8, Date: "2021-07-10"})
+	require.NoError(t, err)
+
+	_, err = s.CreateExam(storage.CreateExamRequest{StudentEmail: studentEmail, CareerID: c.career, SubjectID: missingID, Grade: 8, Date: "2021-07-10"})
+	requireError(t, err, storage.ErrNotFound)
+
+	exams, err := s.GetStudentExams(studentEmail, c.career)
+	require.NoError(t, err)
+	require.Equal(t, []storage.Exam{
+		{SubjectID: atoi(t, c.subjects[0]), Grade: 8, Date: "2021-07-10"},
+		{SubjectID: atoi(t, c.subjects[1]), Grade: 4, Date: "2021-12-10"},
+	}, exams)
+
+	exams, err = s.GetStudentExams("unknown@gmail.com", c.career)
+	require.NoError(t, err)
+	require.Empty(t, exams)
+}
+
+func testFacultiesAndCareers(t *testing.T, s service.Storage, _ Fixtures) {
+	_, err := s.GetFaculties()
+	requireError(t, err, storage.ErrNotFound)
+
+	facultyID, err := s.CreateFaculty(storage.FacultyRequest{Name: "Exactas"})
+	require.NoError(t, err)
+
+	faculty := strconv.Itoa(facultyID)
+	uri := "https://exactas.unlp.edu.ar"
+	require.NoError(t, s.UpdateFaculty(faculty, storage.FacultyRequest{Name: "Ciencias Exactas", URI: &uri}))
+	requireError(t, s.UpdateFaculty(missingID, storage.FacultyRequest{Name: "Ingeniería"}), storage.ErrNotFound)
+
+	faculties, err := s.GetFaculties()
+	require.NoError(t, err)
+	require.Equal(t, []storage.Faculty{{ID: facultyID, Name: "Ciencias Exactas", URI: &uri}}, faculties)
+
+	_, err = s.CreateCareer(storage.CareerRequest{FacultyID: 99, Name: "Física"})
+	requireError(t, err, storage.ErrNotFound)
+
+	careerID, err := s.CreateCareer(storage.CareerRequest{FacultyID: facultyID, Name: "Sistemas"})
+	require.NoError(t, err)
+
+	career := strconv.Itoa(careerID)
+	require.NoError(t, s.UpdateCareer(career, storage.CareerRequest{FacultyID: facultyID, Name: "Licenciatura en Sistemas"}))
+	requireError(t, s.UpdateCareer(career, storage.CareerRequest{FacultyID: 99, Name: "Sistemas"}), storage.ErrNotFound)
+	requireError(t, s.UpdateCareer(missingID, storage.CareerRequest{FacultyID: facultyID, Name: "Sistemas"}), storage.ErrNotFound)
+
+	expected := storage.Career{ID: careerID, FacultyID: facultyID, Name: "Licenciatura en Sistemas"}
+	careers, err := s.GetFacultyCareers(faculty)
+	require.NoError(t, err)
+	require.Equal(t, []storage.Career{expected}, careers)
+
+	got, err := s.GetCareer(career)
+	require.NoError(t, err)
+	require.Equal(t, expected, got)
+
+	_, err = s.GetFacultyCareers(missingID)
+	requireError(t, err, storage.ErrNotFound)
+
+	_, err = s.GetCareer(missingID)
+	requireError(t, err, storage.ErrNotFound)
+
+	requireError(t, s.DeleteFaculty(faculty), storage.ErrResourceInUse)
+	require.NoError(t, s.DeleteCareer(career))
+	requireError(t, s.DeleteCareer(career), storage.ErrNotFound)
+	require.NoError(t, s.DeleteFaculty(faculty))
+	requireError(t, s.DeleteFaculty(faculty), storage.ErrNotFound)
+}
+
+func testFacultyPolicies(t *testing.T, s service.Storage, _ Fixtures) {
+	facultyID, err := s.CreateFaculty(storage.FacultyRequest{Name: "Exactas"})
+	require.NoError(t, err)
+
+	faculty := strconv.Itoa(facultyID)
+	policy, err := s.GetFacultyPolicy(faculty)
+	require.NoError(t, err)
+	require.Equal(t, storage.FacultyPolicy{
+		FacultyID:            facultyID,
+		MaxCareersPerStudent: 2,
+		AllowedStatuses:      []string{"PENDIENTE", "CURSANDO", "REGULARIZADA", "APROBADA", "LIBRE", "RECURSANDO"},
+		EnforceCorrelatives:  true,
+	}, policy)
+
+	_, err = s.GetFacultyPolicy(missingID)
+	requireError(t, err, storage.ErrNotFound)
+
+	updated := storage.FacultyPolicy{FacultyID: facultyID, MaxCareersPerStudent: 1, AllowedStatuses: []string{"PENDIENTE", "APROBADA"}}
+	require.NoError(t, s.UpdateFacultyPolicy(updated))
+	requireError(t, s.UpdateFacultyPolicy(storage.FacultyPolicy{FacultyID: 99, MaxCareersPerStudent: 1, AllowedStatuses: []string{"PENDIENTE"}}), storage.ErrNotFound)
+
+	policy, err = s.GetFacultyPolicy(faculty)
+	require.NoError(t, err)
+	require.Equal(t, updated, policy)
+
+	// Updating the faculty keeps its policy.
+	require.NoError(t, s.UpdateFaculty(faculty, storage.FacultyRequest{Name: "Ciencias Exactas"}))
+	policy, err = s.GetFacultyPolicy(faculty)
+	require.NoError(t, err)
+	require.Equal(t, updated, policy)
+
+	require.NoError(t, s.DeleteFaculty(faculty))
+	_, err = s.GetFacultyPolicy(faculty)
+	requireError(t, err, storage.ErrNotFound)
+}
+
+func testSubjects(t *testing.T, s service.Storage, _ Fixtures) {
+	c := newCatalog(t, s)
+
+	_, err := s.CreateCareerSubject(storage.CareerSubjectRequest{CareerID: c.career, SubjectID: c.subjects[0]})
+	requireError(t, err, storage.ErrResourceAlreadyExist)
+
+	_, err = s.CreateCareerSubject(storage.CareerSubjectRequest{CareerID: c.career, SubjectID: missingID})
+	requireError(t, err, storage.ErrNotFound)
+
+	uri, meet := "https://algebra.exactas.edu.ar", "https://meet.google.com/abc"
+	require.NoError(t, s.UpdateSubject(c.subjects[0], storage.SubjectRequest{Name: "Álgebra Lineal", URI: &uri, Meet: &meet}))
+	requireError(t, s.UpdateSubject(missingID, storage.SubjectRequest{Name: "Física"}), storage.ErrNotFound)
+
+	hours, points := 96, 12
+	optional := "OPTATIVA"
+	require.NoError(t, s.UpdateCareerSubject(storage.CareerSubjectRequest{CareerID: c.career, SubjectID: c.subjects[0], Hours: &hours, Type: &optional, Points: &points}))
+	requireError(t, s.UpdateCareerSubject(storage.CareerSubjectRequest{CareerID: c.career, SubjectID: missingID}), storage.ErrNotFound)
+
+	details, err := s.GetSubjectDetails(c.subjects[0], c.career)
+	require.NoError(t, err)
+	require.Equal(t, storage.SubjectDetails{
+		ID:     atoi(t, c.subjects[0]),
+		Name:   "Álgebra Lineal",
+		Type:   optional,
+		URI:    &uri,
+		Meet:   &meet,
+		Hours:  &hours,
+		Points: &points,
+	}, details)
+
+	_, err = s.GetSubjectDetails(missingID, c.career)
+	requireError(t, err, storage.ErrNotFound)
+
+	requireError(t, s.DeleteSubject(c.subjects[0]), storage.ErrResourceInUse)
+	require.NoError(t, s.DeleteCareerSubject(c.career, c.subjects[1]))
+	requireError(t, s.DeleteCareerSubject(c.career, c.subjects[1]), storage.ErrNotFound)
+	require.NoError(t, s.DeleteSubject(c.subjects[1]))
+	requireError(t, s.DeleteSubject(c.subjects[1]), storage.ErrNotFound)
+}
+
+func testProfessorships(t *testing.T, s service.Storage, f Fixtures) {
+	c := newCatalog(t, s)
+	termID, err := s.CreateTerm(storage.TermRequest{Year: 2021, Cuatrimestre: 1, Start: "2021-03-01", End: "2021-07-31"})
+	require.NoError(t, err)
+
+	term := strconv.Itoa(termID)
+
+	_, err = s.CreateProfessorship(storage.CreateProfessorshipRequest{CareerID: c.career, SubjectID: missingID, Name: "Cátedra A"})
+	requireError(t, err, storage.ErrNotFound)
+
+	first, err := s.CreateProfessorship(storage.CreateProfessorshipRequest{CareerID: c.career, SubjectID: c.subjects[0], Name: "Cátedra A", TermID: &termID})
+	require.NoError(t, err)
+
+	second, err := s.CreateProfessorship(storage.CreateProfessorshipRequest{CareerID: c.career, SubjectID: c.subjects[0], Name: "Cátedra B"})
+	require.NoError(t, err)
+
+	_, err = s.CreateSchedule(storage.ScheduleRequest{ProfessorshipID: missingID, Day: 1, Start: "08:00:00", End: "10:00:00"})
+	requireError(t, err, storage.ErrNotFound)
+
+	firstSchedule, err := s.CreateSchedule(storage.ScheduleRequest{ProfessorshipID: strconv.Itoa(first), Day: 3, Start: "08:00:00", End: "10:00:00"})
+	require.NoError(t, err)
+
+	_, err = s.CreateSchedule(storage.ScheduleRequest{ProfessorshipID: strconv.Itoa(second), Day: 1, Start: "14:00:00", End: "16:00:00"})
+	require.NoError(t, err)
+
+	professorships, err := s.GetProfessorships(c.subjects[0], c.career, "")
+	require.NoError(t, err)
+	require.Equal(t, []storage.Professorship{
+		scheduledProfessorship(second, "Cátedra B", 1, "14:00:00", "16:00:00"),
+		scheduledProfessorship(first, "Cátedra A", 3, "08:00:00", "10:00:00"),
+	}, professorships)
+
+	// Professorships created before terms existed have none and are listed in every term.
+	professorships, err = s.GetProfessorships(c.subjects[0], c.career, term)
+	require.NoError(t, err)
+	require.Equal(t, []storage.Professorship{
+		scheduledProfessorship(second, "Cátedra B", 1, "14:00:00", "16:00:00"),
+		scheduledProfessorship(first, "Cátedra A", 3, "08:00:00", "10:00:00"),
+	}, professorships)
+
+	otherTermID, err := s.CreateTerm(storage.TermRequest{Year: 2021, Cuatrimestre: 2, Start: "2021-08-01", End: "2021-11-30"})
+	require.NoError(t, err)
+
+	professorships, err = s.GetProfessorships(c.subjects[0], c.career, strconv.Itoa(otherTermID))
+	require.NoError(t, err)
+	require.Equal(t, []storage.Professorship{scheduledProfessorship(second, "Cátedra B", 1, "14:00:00", "16:00:00")}, professorships)
+
+	_, err = s.GetProfessorships(c.subjects[1], c.career, "")
+	requireError(t, err, storage.ErrNotFound)
+
+	require.NoError(t, s.UpdateProfessorship(storage.UpdateProfessorshipRequest{ProfessorshipID: strconv.Itoa(second), Name: "Cátedra C", TermID: &termID}))
+	requireError(t, s.UpdateProfessorship(storage.UpdateProfessorshipRequest{ProfessorshipID: missingID, Name: "Cátedra D"}), storage.ErrNotFound)
+
+	schedule := storage.ScheduleRequest{ProfessorshipID: strconv.Itoa(first), ScheduleID: strconv.Itoa(firstSchedule), Day: 2, Start: "08:00:00", End: "10:00:00"}
+	require.NoError(t, s.UpdateSchedule(schedule))
+
+	schedule.ProfessorshipID = strconv.Itoa(second)
+	requireError(t, s.UpdateSchedule(schedule), storage.ErrNotFound)
+
+	schedules, err := s.GetProfessorshipsSchedules([]int{first, second})
+	require.NoError(t, err)
+
+	monday, tuesday := 1, 2
+	mondayStart, mondayEnd, tuesdayStart, tuesdayEnd := "14:00:00", "16:00:00", "08:00:00", "10:00:00"
+	require.Equal(t, []storage.ProfessorshipSchedule{
+		{ProfessorshipID: second, Name: "Cátedra C", SubjectID: atoi(t, c.subjects[0]), SubjectName: "Álgebra", Day: &monday, Start: &mondayStart, End: &mondayEnd},
+		{ProfessorshipID: first, Name: "Cátedra A", SubjectID: atoi(t, c.subjects[0]), SubjectName: "Álgebra", Day: &tuesday, Start: &tuesdayStart, End: &tuesdayEnd},
+	}, schedules)
+
+	requireError(t, s.DeleteSchedule(strconv.Itoa(second), strconv.Itoa(firstSchedule)), storage.ErrNotFound)
+	require.NoError(t, s.DeleteSchedule(strconv.Itoa(first), strconv.Itoa(firstSchedule)))
+
+	// A professorship without schedules is still found.
+	schedules, err = s.GetProfessorshipsSchedules([]int{first, second})
+	require.NoError(t, err)
+	require.Equal(t, []storage.ProfessorshipSchedule{
+		{ProfessorshipID: first, Name: "Cátedra A", SubjectID: atoi(t, c.subjects[0]), SubjectName: "Álgebra"},
+		{ProfessorshipID: second, Name: "Cátedra C", SubjectID: atoi(t, c.subjects[0]), SubjectName: "Álgebra", Day: &monday, Start: &mondayStart, End: &mondayEnd},
+	}, schedules)
+
+	professorships, err = s.GetProfessorships(c.subjects[0], c.career, "")
+	require.NoError(t, err)
+	require.Equal(t, []storage.Professorship{
+		{ID: first, Name: "Cátedra A"},
+		scheduledProfessorship(second, "Cátedra C", 1, "14:00:00", "16:00:00"),
+	}, professorships)
+
+	_, err = s.GetProfessorshipsSchedules([]int{atoi(t, missingID)})
+	requireError(t, err, storage.ErrNotFound)
+}
+
+func scheduledProfessorship(id int, name string, day int, start, end string) storage.Professorship {
+	return storage.Professorship{ID: id, Day: &day, Name: name, Start: &start, End: &end}
+}
+
+func testMaterials(t *testing.T, s service.Storage, _ Fixtures) {
+	c := newCatalog(t, s)
+
+	_, err := s.CreateMaterial(storage.CreateMaterialRequest{ProfessorshipID: missingID, URI: "https://drive.google.com/a", Description: "Apunte"})
+	requireError(t, err, storage.ErrNotFound)
+
+	professorshipID, err := s.CreateProfessorship(storage.CreateProfessorshipRequest{CareerID: c.career, SubjectID: c.subjects[0], Name: "Cátedra A"})
+	require.NoError(t, err)
+
+	professorship := strconv.Itoa(professorshipID)
+	_, err = s.GetMaterials(professorship)
+	requireError(t, err, storage.ErrNotFound)
+
+	first, err := s.CreateMaterial(storage.CreateMaterialRequest{ProfessorshipID: professorship, URI: "https://drive.google.com/a", Description: "Apunte"})
+	require.NoError(t, err)
+
+	second, err := s.CreateMaterial(storage.CreateMaterialRequest{ProfessorshipID: professorship, URI: "https://drive.google.com/b", Description: "Práctica"})
+	require.NoError(t, err)
+
+	update := storage.UpdateMaterialRequest{ProfessorshipID: professorship, MaterialID: strconv.Itoa(first), URI: "https://drive.google.com/c", Description: "Apunte teórico"}
+	require.NoError(t, s.UpdateMaterial(update))
+
+	update.ProfessorshipID = missingID
+	requireError(t, s.UpdateMaterial(update), storage.ErrNotFound)
+
+	materials, err := s.GetMaterials(professorship)
+	require.NoError(t, err)
+	require.Equal(t, []storage.Material{
+		{ID: first, URI: "https://drive.google.com/c", Description: "Apunte teórico"},
+		{ID: second, URI: "https://drive.google.com/b", Description: "Práctica"},
+	}, materials)
+
+	require.NoError(t, s.DeleteMaterial(professorship, strconv.Itoa(second)))
+	requireError(t, s.DeleteMaterial(professorship, strconv.Itoa(second)), storage.ErrNotFound)
+}
+
+func testEnrollment(t *testing.T, s service.Storage, _ Fixtures) {
+	c := newCatalog(t, s)
+	require.NoError(t, s.CreateStudent("example", studentEmail, "hash"))
+
+	var professorships []string
+	for i, subject := range []string{c.subjects[0], c.subjects[0], c.subjects[1]} {
+		id, err := s.CreateProfessorship(storage.CreateProfessorshipRequest{CareerID: c.career, SubjectID: subject, Name: "Cátedra " + strconv.Itoa(i+1)})
+		require.NoError(t, err)
+
+		professorships = append(professorships, strconv.Itoa(id))
+		_, err = s.CreateSchedule(storage.ScheduleRequest{ProfessorshipID: strconv.Itoa(id), Day: 3 - i, Start: "08:00:00", End: "10:00:00"})
+		require.NoError(t, err)
+	}
+
+	requireError(t, s.EnrollStudentInProfessorship("unknown@gmail.com", professorships[0]), storage.ErrNotFound)
+	requireError(t, s.EnrollStudentInProfessorship(studentEmail, missingID), storage.ErrNotFound)
+	requireError(t, s.EnrollStudentInProfessorship(studentEmail, professorships[0]), storage.ErrNotFound)
+
+	require.NoError(t, s.AssignStudentToCareer(studentEmail, c.career))
+	require.NoError(t, s.EnrollStudentInProfessorship(studentEmail, professorships[0]))
+	requireError(t, s.EnrollStudentInProfessorship(studentEmail, professorships[1]), storage.ErrResourceAlreadyExist)
+	require.NoError(t, s.EnrollStudentInProfessorship(studentEmail, professorships[2]))
+
+	_, err := s.CreateMaterial(storage.CreateMaterialRequest{ProfessorshipID: professorships[0], URI: "https://drive.google.com/a", Description: "Apunte"})
+	require.NoError(t, err)
+
+	schedules, err := s.GetStudentSchedules(studentEmail, "")
+	require.NoError(t, err)
+	require.Equal(t, []storage.StudentSchedule{
+		{ProfessorshipID: atoi(t, professorships[2]), ProfessorshipName: "Cátedra 3", SubjectName: "Análisis I", Day: 1, Start: "08:00:00", End: "10:00:00"},
+		{ProfessorshipID: atoi(t, professorships[0]), ProfessorshipName: "Cátedra 1", SubjectName: "Álgebra", Day: 3, Start: "08:00:00", End: "10:00:00"},
+	}, schedules)
+
+	// A failed delete leaves the professorship untouched.
+	requireError(t, s.DeleteProfessorship(professorships[0]), storage.ErrResourceInUse)
+
+	_, err = s.GetMaterials(professorships[0])
+	require.NoError(t, err)
+
+	require.NoError(t, s.DeleteStudentProfessorship(studentEmail, professorships[0]))
+	requireError(t, s.DeleteStudentProfessorship(studentEmail, professorships[0]), storage.ErrNotFound)
+	require.NoError(t, s.DeleteProfessorship(professorships[0]))
+	requireError(t, s.DeleteProfessorship(professorships[0]), storage.ErrNotFound)
+
+	_, err = s.GetMaterials(professorships[0])
+	requireError(t, err, storage.ErrNotFound)
+
+	schedules, err = s.GetStudentSchedules(studentEmail, "")
+	require.NoError(t, err)
+	require.Len(t, schedules, 1)
+}
+
+func testEnrollmentTerms(t *testing.T, s service.Storage, f Fixtures) {
+	c := newCatalog(t, s)
+	require.NoError(t, s.CreateStudent("example", studentEmail, "hash"))
+	require.NoError(t, s.AssignStudentToCareer(studentEmail, c.career))
+
+	previous, err := s.CreateTerm(storage.TermRequest{Year: 2020, Cuatrimestre: 2, Start: "2020-08-10", End: "2020-11-28"})
+	require.NoError(t, err)
+
+	current, err := s.CreateTerm(storage.TermRequest{Year: 2021, Cuatrimestre: 1, Start: "2021-03-15", End: "2021-07-10"})
+	require.NoError(t, err)
+
+	createProfessorship := func(subjectID, name string, termID *int) string {
+		id, err := s.CreateProfessorship(storage.CreateProfessorshipRequest{CareerID: c.career, SubjectID: subjectID, Name: name, TermID: termID})
+		require.NoError(t, err)
+
+		_, err = s.CreateSchedule(storage.ScheduleRequest{ProfessorshipID: strconv.Itoa(id), Day: id, Start: "08:00:00", End: "10:00:00"})
+		require.NoError(t, err)
+
+		return strconv.Itoa(id)
+	}
+
+	failed := createProfessorship(c.subjects[0], "Cátedra 1", &previous)
+	retaken := createProfessorship(c.subjects[0], "Cátedra 2", &current)
+	legacy := createProfessorship(c.subjects[1], "Cátedra 3", nil)
+	overlapping := createProfessorship(c.subjects[1], "Cátedra 4", &current)
+
+	// Retaking a subject in another term is not a duplicate, but a professorship without a term overlaps every term.
+	require.NoError(t, s.EnrollStudentInProfessorship(studentEmail, failed))
+	require.NoError(t, s.EnrollStudentInProfessorship(studentEmail, retaken))
+	requireError(t, s.EnrollStudentInProfessorship(studentEmail, failed), storage.ErrResourceAlreadyExist)
+	require.NoError(t, s.EnrollStudentInProfessorship(studentEmail, legacy))
+	requireError(t, s.EnrollStudentInProfessorship(studentEmail, overlapping), storage.ErrResourceAlreadyExist)
+
+	// Moving a professorship to the term of another enrollment of the subject would duplicate it.
+	requireError(t, s.UpdateProfessorship(storage.UpdateProfessorshipRequest{ProfessorshipID: retaken, Name: "Cátedra 2", TermID: &previous}), storage.ErrResourceAlreadyExist)
+
+	professorshipIDs := func(termID string) []int {
+		schedules, err := s.GetStudentSchedules(studentEmail, termID)
+		require.NoError(t, err)
+
+		var ids []int
+		for _, schedule := range schedules {
+			ids = append(ids, schedule.ProfessorshipID)
+		}
+
+		return ids
+	}
+
+	require.Equal(t, []int{atoi(t, failed), atoi(t, legacy)}, professorshipIDs(strconv.Itoa(previous)))
+	require.Equal(t, []int{atoi(t, retaken), atoi(t, legacy)}, professorshipIDs(strconv.Itoa(current)))
+	require.Equal(t, []int{atoi(t, failed), atoi(t, retaken), atoi(t, legacy)}, professorshipIDs(""))
+}
+
+func testSearchCatalog(t *testing.T, s service.Storage, f Fixtures) {
+	catalog_, err := s.GetSearchCatalog()
+	require.NoError(t, err)
+	require.Equal(t, storage.SearchCatalog{
+		Subjects:       []storage.SearchSubject{},
+		Careers:        []storage.Career{},
+		Professors:     []storage.Professor{},
+		Professorships: []storage.SearchProfessorship{},
+	}, catalog_)
+
+	c := newCatalog(t, s)
+	professorshipID, err := s.CreateProfessorship(storage.CreateProfessorshipRequest{CareerID: c.career, SubjectID: c.subjects[1], Name: "Cátedra A"})
+	require.NoError(t, err)
+
+	professorID, err := f.CreateProfessor("Juan Pérez")
+	require.NoError(t, err)
+
+	catalog_, err = s.GetSearchCatalog()
+	require.NoError(t, err)
+	require.Equal(t, storage.SearchCatalog{
+		Subjects:       []storage.SearchSubject{{ID: atoi(t, c.subjects[0]), Name: "Álgebra"}, {ID: atoi(t, c.subjects[1]), Name: "Análisis I"}},
+		Careers:        []storage.Career{{ID: c.careerID, FacultyID: c.facultyID, Name: "Sistemas"}},
+		Professors:     []storage.Professor{{ID: professorID, Name: "Juan Pérez"}},
+		Professorships: []storage.SearchProfessorship{{ID: professorshipID, Name: "Cátedra A", CareerID: c.careerID, SubjectID: atoi(t, c.subjects[1]), SubjectName: "Análisis I"}},
+	}, catalog_)
+}
+
+func testProfessors(t *testing.T, s service.Storage, f Fixtures) {
+	c := newCatalog(t, s)
+
+	professorshipID, err := s.CreateProfessorship(storage.CreateProfessorshipRequest{CareerID: c.career, SubjectID: c.subjects[0], Name: "Cátedra A"})
+	require.NoError(t, err)
+
+	professorID, err := f.CreateProfessor("Juan Pérez")
+	require.NoError(t, err)
+	require.NoError(t, f.AssignProfessor(professorshipID, professorID, "TITULAR"))
+
+	professor, err := s.GetProfessor(strconv.Itoa(professorID))
+	require.NoError(t, err)
+	require.Equal(t, storage.Professor{ID: professorID, Name: "Juan Pérez"}, professor)
+
+	_, err = s.GetProfessor(missingID)
+	requireError(t, err, storage.ErrNotFound)
+
+	professors, err := s.GetProfessorshipsProfessors(c.subjects[0], c.career)
+	require.NoError(t, err)
+	require.Equal(t, []storage.ProfessorshipProfessor{{ProfessorshipID: professorshipID, ID: professorID, Name: "Juan Pérez", Role: "TITULAR"}}, professors)
+
+	professors, err = s.GetProfessorshipsProfessors(c.subjects[1], c.career)
+	require.NoError(t, err)
+	require.Empty(t, professors)
+
+	professorships, err := s.GetProfessorProfessorships(strconv.Itoa(professorID))
+	require.NoError(t, err)
+	require.Equal(t, []storage.ProfessorProfessorship{{
+		ID:          professorshipID,
+		Name:        "Cátedra A",
+		Role:        "TITULAR",
+		SubjectID:   atoi(t, c.subjects[0]),
+		SubjectName: "Álgebra",
+		CareerID:    c.careerID,
+		CareerName:  "Sistemas",
+	}}, professorships)
+}
+
+func testTerms(t *testing.T, s service.Storage, f Fixtures) {
+	first, err := s.CreateTerm(storage.TermRequest{Year: 2021, Cuatrimestre: 1, Start: "2021-03-01", End: "2021-07-31"})
+	require.NoError(t, err)
+
+	second, err := s.CreateTerm(storage.TermRequest{Year: 2021, Cuatrimestre: 2, Start: "2021-08-01", End: "2021-12-15"})
+	require.NoError(t, err)
+
+	term, err := s.GetTerm(2021, 2)
+	require.NoError(t, err)
+	require.Equal(t, storage.Term{ID: second, Year: 2021, Cuatrimestre: 2, Start: "2021-08-01", End: "2021-12-15"}, term)
+
+	_, err = s.GetTerm(2022, 1)
+	requireError(t, err, storage.ErrNotFound)
+
+	term, err = s.GetCurrentTerm("2021-07-31")
+	require.NoError(t, err)
+	require.Equal(t, first, term.ID)
+
+	_, err = s.GetCurrentTerm("2022-01-10")
+	requireError(t, err, storage.ErrNotFound)
+
+	_, err = s.CreateTerm(storage.TermRequest{Year: 2021, Cuatrimestre: 1, Start: "2021-03-15", End: "2021-07-10"})
+	requireError(t, err, storage.ErrResourceAlreadyExist)
+
+	require.NoError(t, s.UpdateTerm(strconv.Itoa(second), storage.TermRequest{Year: 2022, Cuatrimestre: 1, Start: "2022-03-14", End: "2022-07-09"}))
+	term, err = s.GetTerm(2022, 1)
+	require.NoError(t, err)
+	require.Equal(t, storage.Term{ID: second, Year: 2022, Cuatrimestre: 1, Start: "2022-03-14", End: "2022-07-09"}, term)
+
+	_, err = s.GetTerm(2021, 2)
+	requireError(t, err, storage.ErrNotFound)
+
+	requireError(t, s.UpdateTerm(strconv.Itoa(second), storage.TermRequest{Year: 2021, Cuatrimestre: 1, Start: "2021-03-01", End: "2021-07-31"}), storage.ErrResourceAlreadyExist)
+	requireError(t, s.UpdateTerm(missingID, storage.TermRequest{Year: 2023, Cuatrimestre: 1, Start: "2023-03-01", End: "2023-07-31"}), storage.ErrNotFound)
+}
+
+func testCareerPlan(t *testing.T, s service.Storage, f Fixtures) {
+	c := newCatalog(t, s)
+	termID, err := s.CreateTerm(storage.TermRequest{Year: 2021, Cuatrimestre: 1, Start: "2021-03-01", End: "2021-07-31"})
+	require.NoError(t, err)
+
+	_, err = s.GetCareerPlan(missingID)
+	requireError(t, err, storage.ErrNotFound)
+
+	requireError(t, s.ImportCareerPlan(storage.ImportCareerPlanRequest{CareerID: missingID}), storage.ErrNotFound)
+
+	before, err := s.GetCareerPlan(c.career)
+	require.NoError(t, err)
+
+	hours := 96
+	plan := storage.ImportCareerPlanRequest{
+		CareerID: c.career,
+		Subjects: []storage.ImportSubject{
+			{
+				Name:  "Álgebra",
+				Type:  &subjectType,
+				Hours: &hours,
+				Professorships: []storage.ImportProfessorship{
+					{Name: "Cátedra A", TermID: &termID, Schedules: []storage.ImportSchedule{{Day: 1, Start: "08:00:00", End: "10:00:00"}, {Day: 3, Start: "08:00:00", End: "10:00:00"}}},
+					{Name: "Cátedra A", Schedules: []storage.ImportSchedule{{Day: 2, Start: "18:00:00", End: "20:00:00"}}},
+				},
+			},
+			{
+				Name:         "Análisis II",
+				Type:         &subjectType,
+				Correlatives: []storage.ImportCorrelative{{SubjectName: "Análisis I", Requirement: "REGULARIZADA"}, {SubjectName: "Física", Requirement: "APROBADA"}},
+			},
+		},
+	}
+
+	// A plan with a missing correlative is not applied at all.
+	requireError(t, s.ImportCareerPlan(plan), storage.ErrNotFound)
+
+	after, err := s.GetCareerPlan(c.career)
+	require.NoError(t, err)
+	require.Equal(t, before, after)
+
+	plan.Subjects[1].Correlatives = []storage.ImportCorrelative{{SubjectName: "Análisis I", Requirement: "REGULARIZADA"}, {SubjectName: "Álgebra", Requirement: "APROBADA"}}
+	require.NoError(t, s.ImportCareerPlan(plan))
+	require.NoError(t, s.ImportCareerPlan(plan))
+
+	got, err := s.GetCareerPlan(c.career)
+	require.NoError(t, err)
+	require.Len(t, got.Subjects, 3)
+	require.Len(t, got.Professorships, 2)
+
+	algebra, analysis := atoi(t, c.subjects[0]), atoi(t, c.subjects[1])
+	analysisII := got.Subjects[2].SubjectID
+	first, second := got.Professorships[0].ID, got.Professorships[1].ID
+	term := "2021-1"
+	require.Equal(t, storage.CareerPlan{
+		Subjects: []storage.CareerPlanSubject{
+			{SubjectID: algebra, Name: "Álgebra", Type: &subjectType, Hours: &hours},
+			{SubjectID: analysis, Name: "Análisis I", Type: &subjectType},
+			{SubjectID: analysisII, Name: "Análisis II", Type: &subjectType},
+		},
+		Correlatives: []storage.Correlative{
+			{SubjectID: analysisII, CorrelativeID: algebra, Requirement: "APROBADA"},
+			{SubjectID: analysisII, CorrelativeID: analysis, Requirement: "REGULARIZADA"},
+		},
+		Professorships: []storage.CareerPlanProfessorship{
+			{ID: first, SubjectID: algebra, Name: "Cátedra A", Term: &term},
+			{ID: second, SubjectID: algebra, Name: "Cátedra A"},
+		},
+		Schedules: []storage.CareerPlanSchedule{
+			{ProfessorshipID: first, Day: 1, Start: "08:00:00", End: "10:00:00"},
+			{ProfessorshipID: first, Day: 3, Start: "08:00:00", End: "10:00:00"},
+			{ProfessorshipID: second, Day: 2, Start: "18:00:00", End: "20:00:00"},
+		},
+	}, got)
+
+	correlatives, err := s.GetCorrelatives(c.career)
+	require.NoError(t, err)
+	require.Equal(t, got.Correlatives, correlatives)
+
+	// Análisis II already requires Álgebra, so the plan would leave a cycle and is not applied at all.
+	cycle := storage.ImportCareerPlanRequest{
+		CareerID: c.career,
+		Subjects: []storage.ImportSubject{
+			{Name: "Álgebra", Type: &subjectType, Correlatives: []storage.ImportCorrelative{{SubjectName: "Análisis II", Requirement: "APROBADA"}}},
+		},
+	}
+
+	requireError(t, s.ImportCareerPlan(cycle), storage.ErrCorrelativesCycle)
+
+	after, err = s.GetCareerPlan(c.career)
+	require.NoError(t, err)
+	require.Equal(t, got, after)
+}
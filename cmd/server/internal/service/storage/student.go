@@ -0,0 +1,206 @@
+package storage
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+)
+
+type Student struct {
+	ID    int
+	Name  string
+	Email string
+	Role  string
+}
+
+const getStudent = `SELECT id, name, email, role FROM student WHERE id = ?;`
+
+func (s *Storage) GetStudent(studentID string) (Student, error) {
+	var student struct {
+		ID    int    `db:"id"`
+		Name  string `db:"name"`
+		Email string `db:"email"`
+		Role  string `db:"role"`
+	}
+
+	if err := s.db.Get(&student, getStudent, studentID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Student{}, fmt.Errorf("could not find student: %w", ErrNotFound)
+		}
+
+		return Student{}, err
+	}
+
+	return Student(student), nil
+}
+
+type UpdateStudentRequest struct {
+	StudentID    string
+	Name         *string
+	PasswordHash *string
+}
+
+const (
+	checkStudentExist = `SELECT COUNT(1) FROM student WHERE id = ?;`
+	updateStudent     = `UPDATE student SET name = IFNULL(?, name), password_hash = IFNULL(?, password_hash), updated_at = CURRENT_TIMESTAMP WHERE id = ?;`
+)
+
+// UpdateStudent updates the fields of the request that are not nil.
+func (s *Storage) UpdateStudent(req UpdateStudentRequest) error {
+	return s.updateResource("student", checkStudentExist, []interface{}{req.StudentID}, updateStudent, req.Name, req.PasswordHash, req.StudentID)
+}
+
+type EmailChange struct {
+	OldEmail  string
+	NewEmail  string
+	ChangedAt string
+}
+
+type ChangeStudentEmailRequest struct {
+	StudentID string
+	Email     string
+	ChangedAt string
+}
+
+const (
+	getStudentEmail          = `SELECT email FROM student WHERE id = ?;`
+	updateStudentEmail       = `UPDATE student SET email = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?;`
+	createStudentEmailChange = `INSERT INTO student_email_history (student_id, old_email, new_email, changed_at) VALUES (?, ?, ?, ?);`
+)
+
+// ChangeStudentEmail replaces the email of the student and records the previous one in its history. Changing the
+// email to the current one does nothing.
+func (s *Storage) ChangeStudentEmail(req ChangeStudentEmailRequest) (err error) {
+	tx, err := s.db.Beginx()
+	if err != nil {
+		return fmt.Errorf("could not begin tx: %v", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var email string
+	if err = tx.Get(&email, getStudentEmail, req.StudentID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = fmt.Errorf("could not find student: %w", ErrNotFound)
+		}
+
+		return err
+	}
+
+	if email != req.Email {
+		if _, err = tx.Exec(updateStudentEmail, req.Email, req.StudentID); err != nil {
+			err = translateWriteError("student email", err)
+			return err
+		}
+
+		if _, err = tx.Exec(createStudentEmailChange, req.StudentID, email, req.Email, req.ChangedAt); err != nil {
+			return err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("could not commit tx: %v", err)
+	}
+
+	return nil
+}
+
+const getStudentEmailHistory = `SELECT old_email, new_email, DATE_FORMAT(changed_at, '%Y-%m-%d %H:%i:%s') changed_at
+FROM student_email_history
+WHERE student_id = ?
+ORDER BY changed_at, id;`
+
+func (s *Storage) GetStudentEmailHistory(studentID string) ([]EmailChange, error) {
+	var changes []struct {
+		OldEmail  string `db:"old_email"`
+		NewEmail  string `db:"new_email"`
+		ChangedAt string `db:"changed_at"`
+	}
+
+	if err := s.db.Select(&changes, getStudentEmailHistory, studentID); err != nil {
+		return nil, err
+	}
+
+	response := make([]EmailChange, 0, len(changes))
+	for _, change := range changes {
+		response = append(response, EmailChange(change))
+	}
+
+	return response, nil
+}
+
+// deleteStudentQueries remove the student after every row that references it.
+var deleteStudentQueries = []string{
+	`DELETE FROM exam WHERE student_id = ?;`,
+	`DELETE FROM student_professorship WHERE student_id = ?;`,
+	`DELETE FROM student_career_subject WHERE student_id = ?;`,
+	`DELETE FROM student_career WHERE student_id = ?;`,
+	`DELETE FROM student_email_history WHERE student_id = ?;`,
+	`DELETE FROM student_equivalence WHERE student_id = ?;`,
+	`DELETE FROM student WHERE id = ?;`,
+}
+
+// DeleteStudent removes the student with its careers, subject statuses, exams, enrollments, equivalences and email
+// history.
+func (s *Storage) DeleteStudent(studentID string) (err error) {
+	tx, err := s.db.Beginx()
+	if err != nil {
+		return fmt.Errorf("could not begin tx: %v", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var results int
+	if err = tx.Get(&results, checkStudentExist, studentID); err != nil {
+		return err
+	}
+
+	if results == 0 {
+		err = fmt.Errorf("could not find student: %w", ErrNotFound)
+		return err
+	}
+
+	for _, query := range deleteStudentQueries {
+		if _, err = tx.Exec(query, studentID); err != nil {
+			return err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("could not commit tx: %v", err)
+	}
+
+	return nil
+}
+
+const (
+	checkStudentEmailExist         = `SELECT COUNT(1) FROM student WHERE email = ?;`
+	updateStudentCalendarToken     = `UPDATE student SET calendar_token_hash = ?, updated_at = CURRENT_TIMESTAMP WHERE email = ?;`
+	getStudentEmailByCalendarToken = `SELECT email FROM student WHERE calendar_token_hash = ?;`
+)
+
+// SetStudentCalendarToken replaces the hash of the calendar feed token of the student. A nil hash revokes it.
+func (s *Storage) SetStudentCalendarToken(studentEmail string, tokenHash *string) error {
+	return s.updateResource("student", checkStudentEmailExist, []interface{}{studentEmail}, updateStudentCalendarToken, tokenHash, studentEmail)
+}
+
+func (s *Storage) GetStudentEmailByCalendarToken(tokenHash string) (string, error) {
+	var email string
+	if err := s.db.Get(&email, getStudentEmailByCalendarToken, tokenHash); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", fmt.Errorf("could not find calendar token: %w", ErrNotFound)
+		}
+
+		return "", err
+	}
+
+	return email, nil
+}
@@ -0,0 +1,276 @@
+package storage
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorage_GetStudent(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	mock.ExpectQuery(`SELECT id, name, email, role FROM student WHERE id = ?;`).
+		WithArgs("1").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "email", "role"}).AddRow(1, "Mateo", "mateo@gmail.com", "STUDENT"))
+
+	// When
+	student, err := storage_.GetStudent("1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Equal(t, Student{ID: 1, Name: "Mateo", Email: "mateo@gmail.com", Role: "STUDENT"}, student)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStorage_GetStudent_NotFoundError(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	mock.ExpectQuery(`SELECT id, name, email, role FROM student WHERE id = ?;`).
+		WithArgs("1").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "email", "role"}))
+
+	// When
+	_, err = storage_.GetStudent("1")
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.True(t, errors.Is(err, ErrNotFound))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStorage_UpdateStudent(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	name := "Mateo Ferrari"
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT COUNT(1) FROM student WHERE id = ?;`).
+		WithArgs("1").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectExec(`UPDATE student SET name = IFNULL(?, name), password_hash = IFNULL(?, password_hash), updated_at = CURRENT_TIMESTAMP WHERE id = ?;`).
+		WithArgs(name, nil, "1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	// When
+	err = storage_.UpdateStudent(UpdateStudentRequest{StudentID: "1", Name: &name})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStorage_ChangeStudentEmail(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT email FROM student WHERE id = ?;`).
+		WithArgs("1").
+		WillReturnRows(sqlmock.NewRows([]string{"email"}).AddRow("mateo@gmail.com"))
+	mock.ExpectExec(`UPDATE student SET email = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?;`).
+		WithArgs("mateo@uba.ar", "1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`INSERT INTO student_email_history (student_id, old_email, new_email, changed_at) VALUES (?, ?, ?, ?);`).
+		WithArgs("1", "mateo@gmail.com", "mateo@uba.ar", "2021-03-15 10:00:00").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// When
+	err = storage_.ChangeStudentEmail(ChangeStudentEmailRequest{StudentID: "1", Email: "mateo@uba.ar", ChangedAt: "2021-03-15 10:00:00"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStorage_ChangeStudentEmail_AlreadyExistError(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT email FROM student WHERE id = ?;`).
+		WithArgs("1").
+		WillReturnRows(sqlmock.NewRows([]string{"email"}).AddRow("mateo@gmail.com"))
+	mock.ExpectExec(`UPDATE student SET email = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?;`).
+		WithArgs("juan@gmail.com", "1").
+		WillReturnError(&mysql.MySQLError{Number: 1062})
+	mock.ExpectRollback()
+
+	// When
+	err = storage_.ChangeStudentEmail(ChangeStudentEmailRequest{StudentID: "1", Email: "juan@gmail.com", ChangedAt: "2021-03-15 10:00:00"})
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.True(t, errors.Is(err, ErrResourceAlreadyExist))
+	require.EqualError(t, err, "student email already exist: storage: resource already exist")
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStorage_DeleteStudent(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT COUNT(1) FROM student WHERE id = ?;`).
+		WithArgs("1").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	for _, query := range deleteStudentQueries {
+		mock.ExpectExec(query).
+			WithArgs("1").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+	mock.ExpectCommit()
+
+	// When
+	err = storage_.DeleteStudent("1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStorage_DeleteStudent_NotFoundError(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT COUNT(1) FROM student WHERE id = ?;`).
+		WithArgs("1").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectRollback()
+
+	// When
+	err = storage_.DeleteStudent("1")
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.True(t, errors.Is(err, ErrNotFound))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStorage_SetStudentCalendarToken(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	tokenHash := "hash"
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT COUNT(1) FROM student WHERE email = ?;`).
+		WithArgs("example@gmail.com").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectExec(`UPDATE student SET calendar_token_hash = ?, updated_at = CURRENT_TIMESTAMP WHERE email = ?;`).
+		WithArgs(tokenHash, "example@gmail.com").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	// When
+	err = storage_.SetStudentCalendarToken("example@gmail.com", &tokenHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStorage_GetStudentEmailByCalendarToken_NotFoundError(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	mock.ExpectQuery(`SELECT email FROM student WHERE calendar_token_hash = ?;`).
+		WithArgs("hash").
+		WillReturnError(sql.ErrNoRows)
+
+	// When
+	_, err = storage_.GetStudentEmailByCalendarToken("hash")
+
+	// Then
+	require.True(t, errors.Is(err, ErrNotFound))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
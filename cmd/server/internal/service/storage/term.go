@@ -0,0 +1,87 @@
+package storage
+
+import (
+	"database/sql"
+	"errors"
+)
+
+type Term struct {
+	ID           int
+	Year         int
+	Cuatrimestre int
+	Start        string
+	End          string
+}
+
+type term struct {
+	ID           int    `db:"id"`
+	Year         int    `db:"year"`
+	Cuatrimestre int    `db:"cuatrimestre"`
+	Start        string `db:"start"`
+	End          string `db:"end"`
+}
+
+const getTerm = `SELECT id, year, cuatrimestre, DATE_FORMAT(start, '%Y-%m-%d') start, DATE_FORMAT(end, '%Y-%m-%d') end
+FROM term
+WHERE year = :year AND cuatrimestre = :cuatrimestre;`
+
+func (s *Storage) GetTerm(year, cuatrimestre int) (Term, error) {
+	return s.getTerm(getTerm, map[string]interface{}{"year": year, "cuatrimestre": cuatrimestre})
+}
+
+const getCurrentTerm = `SELECT id, year, cuatrimestre, DATE_FORMAT(start, '%Y-%m-%d') start, DATE_FORMAT(end, '%Y-%m-%d') end
+FROM term
+WHERE start <= :date AND end >= :date
+ORDER BY start DESC
+LIMIT 1;`
+
+func (s *Storage) GetCurrentTerm(date string) (Term, error) {
+	return s.getTerm(getCurrentTerm, map[string]interface{}{"date": date})
+}
+
+type TermRequest struct {
+	Year         int
+	Cuatrimestre int
+	Start        string
+	End          string
+}
+
+const (
+	createTerm     = `INSERT INTO term (year, cuatrimestre, start, end) VALUES (?, ?, ?, ?);`
+	checkTermExist = `SELECT COUNT(1) FROM term WHERE id = ?;`
+	updateTerm     = `UPDATE term SET year = ?, cuatrimestre = ?, start = ?, end = ? WHERE id = ?;`
+)
+
+func (s *Storage) CreateTerm(req TermRequest) (int, error) {
+	return s.createResource("term", createTerm, req.Year, req.Cuatrimestre, req.Start, req.End)
+}
+
+func (s *Storage) UpdateTerm(termID string, req TermRequest) error {
+	return s.updateResource("term", checkTermExist, []interface{}{termID}, updateTerm, req.Year, req.Cuatrimestre, req.Start, req.End, termID)
+}
+
+func (s *Storage) getTerm(query string, params map[string]interface{}) (Term, error) {
+	stmt, err := s.db.PrepareNamed(query)
+	if err != nil {
+		return Term{}, err
+	}
+
+	defer stmt.Close()
+
+	var t term
+	if err := stmt.Get(&t, params); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Term{}, ErrNotFound
+		}
+
+		return Term{}, err
+	}
+
+	return Term{
+		ID:           t.ID,
+		Year:         t.Year,
+		Cuatrimestre: t.Cuatrimestre,
+		Start:        t.Start,
+		End:          t.End,
+	}, nil
+}
@@ -0,0 +1,103 @@
+package storage
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorage_GetTerm(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	q := `SELECT id, year, cuatrimestre, DATE_FORMAT(start, '%Y-%m-%d') start, DATE_FORMAT(end, '%Y-%m-%d') end
+FROM term
+WHERE year = ? AND cuatrimestre = ?;`
+	mock.ExpectPrepare(q).WillReturnError(nil)
+	mock.ExpectQuery(q).
+		WithArgs(2021, 1).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"id", "year", "cuatrimestre", "start", "end"}).
+				AddRow(3, 2021, 1, "2021-03-15", "2021-07-10"))
+
+	// When
+	term, err := storage_.GetTerm(2021, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Equal(t, Term{ID: 3, Year: 2021, Cuatrimestre: 1, Start: "2021-03-15", End: "2021-07-10"}, term)
+}
+
+func TestStorage_GetTerm_NotFoundError(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	q := `SELECT id, year, cuatrimestre, DATE_FORMAT(start, '%Y-%m-%d') start, DATE_FORMAT(end, '%Y-%m-%d') end
+FROM term
+WHERE year = ? AND cuatrimestre = ?;`
+	mock.ExpectPrepare(q).WillReturnError(nil)
+	mock.ExpectQuery(q).
+		WithArgs(2021, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "year", "cuatrimestre", "start", "end"}))
+
+	// When
+	_, err = storage_.GetTerm(2021, 1)
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.True(t, errors.Is(err, ErrNotFound))
+}
+
+func TestStorage_GetCurrentTerm(t *testing.T) {
+	// Given
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	defer db.Close()
+
+	storage_ := NewStorage(sqlx.NewDb(db, ""))
+
+	q := `SELECT id, year, cuatrimestre, DATE_FORMAT(start, '%Y-%m-%d') start, DATE_FORMAT(end, '%Y-%m-%d') end
+FROM term
+WHERE start <= ? AND end >= ?
+ORDER BY start DESC
+LIMIT 1;`
+	mock.ExpectPrepare(q).WillReturnError(nil)
+	mock.ExpectQuery(q).
+		WithArgs("2021-05-01", "2021-05-01").
+		WillReturnRows(
+			sqlmock.NewRows([]string{"id", "year", "cuatrimestre", "start", "end"}).
+				AddRow(3, 2021, 1, "2021-03-15", "2021-07-10"))
+
+	// When
+	term, err := storage_.GetCurrentTerm("2021-05-01")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Equal(t, Term{ID: 3, Year: 2021, Cuatrimestre: 1, Start: "2021-03-15", End: "2021-07-10"}, term)
+}
@@ -0,0 +1,218 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
+)
+
+const dateTimeLayout = "2006-01-02 15:04:05"
+
+func (s *Service) getStudent(studentID string) (storage.Student, error) {
+	student, err := s.storage.GetStudent(studentID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return storage.Student{}, fmt.Errorf("could not find student [student_id: %s]: %w", studentID, ErrNotFound)
+		}
+
+		return storage.Student{}, fmt.Errorf("could not get student [student_id: %s]: %v", studentID, err)
+	}
+
+	return student, nil
+}
+
+// GetStudentEmail returns the email of the student, which identifies it in the rest of the service.
+func (s *Service) GetStudentEmail(studentID string) (string, error) {
+	student, err := s.getStudent(studentID)
+	if err != nil {
+		return "", err
+	}
+
+	return student.Email, nil
+}
+
+func (s *Service) GetStudent(studentID string) ([]byte, error) {
+	student, err := s.getStudent(studentID)
+	if err != nil {
+		return nil, err
+	}
+
+	careerIDs, err := s.storage.GetStudentCareerIDs(student.Email)
+	if err != nil && !errors.Is(err, storage.ErrNotFound) {
+		return nil, fmt.Errorf("could not get student careers [student_id: %s]: %v", studentID, err)
+	}
+
+	history, err := s.storage.GetStudentEmailHistory(studentID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get student email history [student_id: %s]: %v", studentID, err)
+	}
+
+	type emailChange struct {
+		OldEmail  string `json:"old_email"`
+		NewEmail  string `json:"new_email"`
+		ChangedAt string `json:"changed_at"`
+	}
+
+	type response struct {
+		ID           int           `json:"id"`
+		Name         string        `json:"name"`
+		StudentEmail string        `json:"student_email"`
+		Role         string        `json:"role"`
+		CareerIDs    []int         `json:"career_ids"`
+		EmailHistory []emailChange `json:"email_history"`
+	}
+
+	r := response{
+		ID:           student.ID,
+		Name:         student.Name,
+		StudentEmail: student.Email,
+		Role:         student.Role,
+		CareerIDs:    []int{},
+		EmailHistory: make([]emailChange, 0, len(history)),
+	}
+
+	if careerIDs != nil {
+		r.CareerIDs = careerIDs
+	}
+
+	for _, change := range history {
+		r.EmailHistory = append(r.EmailHistory, emailChange(change))
+	}
+
+	b, err := json.Marshal(r)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal response: %v", err)
+	}
+
+	return b, nil
+}
+
+// verifyPassword checks the password of the student, so a stolen token is not enough to take over the account.
+func (s *Service) verifyPassword(studentEmail, password string) error {
+	credentials, err := s.storage.GetStudentCredentials(studentEmail)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return ErrInvalidCredentials
+		}
+
+		return fmt.Errorf("could not get student credentials [student_email: %s]: %v", studentEmail, err)
+	}
+
+	if !comparePassword(credentials.PasswordHash, password) {
+		return ErrInvalidCredentials
+	}
+
+	return nil
+}
+
+type UpdateStudentRequest struct {
+	StudentID       string
+	Name            string
+	Password        string
+	CurrentPassword string
+	IsAdmin         bool
+}
+
+// UpdateStudent updates the name and password of the student, leaving the empty ones untouched. Students have to
+// confirm the current password to change it, while admins may reset it without knowing it.
+func (s *Service) UpdateStudent(req UpdateStudentRequest) error {
+	if req.Password != "" && req.CurrentPassword == "" && !req.IsAdmin {
+		return fmt.Errorf("could not update student [student_id: %s]: %w", req.StudentID, ErrPasswordRequired)
+	}
+
+	student, err := s.getStudent(req.StudentID)
+	if err != nil {
+		return err
+	}
+
+	if req.CurrentPassword != "" {
+		if err := s.verifyPassword(student.Email, req.CurrentPassword); err != nil {
+			return err
+		}
+	}
+
+	update := storage.UpdateStudentRequest{StudentID: req.StudentID, Name: nullableString(req.Name)}
+	if req.Password != "" {
+		passwordHash, err := hashPassword(req.Password)
+		if err != nil {
+			return err
+		}
+
+		update.PasswordHash = &passwordHash
+	}
+
+	if err := s.storage.UpdateStudent(update); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return fmt.Errorf("could not update student [student_id: %s]: %w", req.StudentID, ErrNotFound)
+		}
+
+		return fmt.Errorf("could not update student [student_id: %s]: %v", req.StudentID, err)
+	}
+
+	return nil
+}
+
+type ChangeStudentEmailRequest struct {
+	StudentID    string
+	StudentEmail string
+	Password     string
+	IsAdmin      bool
+}
+
+// ChangeStudentEmail replaces the email of the student, keeping the previous one in its history. Tokens are
+// issued for the student id and keep working, but when the password is given it also logs in with the new email.
+// Only admins may change it without the password.
+func (s *Service) ChangeStudentEmail(req ChangeStudentEmailRequest) ([]byte, error) {
+	if req.Password == "" && !req.IsAdmin {
+		return nil, fmt.Errorf("could not change student email [student_id: %s]: %w", req.StudentID, ErrPasswordRequired)
+	}
+
+	student, err := s.getStudent(req.StudentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Password != "" {
+		if err := s.verifyPassword(student.Email, req.Password); err != nil {
+			return nil, err
+		}
+	}
+
+	err = s.storage.ChangeStudentEmail(storage.ChangeStudentEmailRequest{
+		StudentID: req.StudentID,
+		Email:     req.StudentEmail,
+		ChangedAt: s.now().UTC().Format(dateTimeLayout),
+	})
+
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrResourceAlreadyExist):
+			return nil, ErrStudentAlreadyExist
+		case errors.Is(err, storage.ErrNotFound):
+			return nil, fmt.Errorf("could not change student email [student_id: %s]: %w", req.StudentID, ErrNotFound)
+		default:
+			return nil, fmt.Errorf("could not change student email [student_id: %s]: %v", req.StudentID, err)
+		}
+	}
+
+	if req.Password == "" {
+		return nil, nil
+	}
+
+	return s.Login(req.StudentEmail, req.Password)
+}
+
+// DeleteStudent removes the student with its careers, subject statuses, exams and enrollments.
+func (s *Service) DeleteStudent(studentID string) error {
+	if err := s.storage.DeleteStudent(studentID); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return fmt.Errorf("could not delete student [student_id: %s]: %w", studentID, ErrNotFound)
+		}
+
+		return fmt.Errorf("could not delete student [student_id: %s]: %v", studentID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage/memory"
+)
+
+func TestService_GetStudent(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("GetStudent", "1").Return(storage.Student{ID: 1, Name: "Mateo", Email: "new@gmail.com", Role: RoleStudent}, nil)
+	storage_.On("GetStudentCareerIDs", "new@gmail.com").Return([]int{2}, nil)
+	storage_.On("GetStudentEmailHistory", "1").Return([]storage.EmailChange{
+		{OldEmail: "old@gmail.com", NewEmail: "new@gmail.com", ChangedAt: "2021-04-01 12:00:00"},
+	}, nil)
+
+	s := NewService(&storage_)
+
+	// When
+	b, err := s.GetStudent("1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.JSONEq(t, `{"id":1,"name":"Mateo","student_email":"new@gmail.com","role":"STUDENT","career_ids":[2],"email_history":[{"old_email":"old@gmail.com","new_email":"new@gmail.com","changed_at":"2021-04-01 12:00:00"}]}`, string(b))
+}
+
+func TestService_GetStudent_NotFoundError(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("GetStudent", "1").Return(storage.Student{}, storage.ErrNotFound)
+
+	s := NewService(&storage_)
+
+	// When
+	_, err := s.GetStudent("1")
+
+	// Then
+	require.True(t, errors.Is(err, ErrNotFound))
+}
+
+func TestService_UpdateStudent_InvalidCurrentPasswordError(t *testing.T) {
+	// Given
+	passwordHash, err := hashPassword("secret-password")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	storage_ := storageMock{}
+	storage_.On("GetStudent", "1").Return(storage.Student{ID: 1, Email: "example@gmail.com"}, nil)
+	storage_.On("GetStudentCredentials", "example@gmail.com").Return(storage.Credentials{PasswordHash: passwordHash}, nil)
+
+	s := NewService(&storage_)
+
+	// When
+	err = s.UpdateStudent(UpdateStudentRequest{StudentID: "1", Password: "new-password", CurrentPassword: "wrong-password"})
+
+	// Then
+	require.True(t, errors.Is(err, ErrInvalidCredentials))
+	storage_.AssertNotCalled(t, "UpdateStudent")
+}
+
+func TestService_UpdateStudent_PasswordRequiredError(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	s := NewService(&storage_)
+
+	// When
+	updateErr := s.UpdateStudent(UpdateStudentRequest{StudentID: "1", Password: "new-password"})
+	_, changeErr := s.ChangeStudentEmail(ChangeStudentEmailRequest{StudentID: "1", StudentEmail: "new@gmail.com"})
+
+	// Then
+	require.EqualError(t, updateErr, "could not update student [student_id: 1]: service: password is required")
+	require.EqualError(t, changeErr, "could not change student email [student_id: 1]: service: password is required")
+	storage_.AssertNotCalled(t, "UpdateStudent", mock.Anything)
+	storage_.AssertNotCalled(t, "ChangeStudentEmail", mock.Anything)
+}
+
+func TestService_UpdateStudent_AdminWithoutPassword(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("GetStudent", "1").Return(storage.Student{ID: 1, Email: "example@gmail.com"}, nil)
+	storage_.On("UpdateStudent", mock.AnythingOfType("storage.UpdateStudentRequest")).Return(nil)
+
+	s := NewService(&storage_)
+
+	// When
+	err := s.UpdateStudent(UpdateStudentRequest{StudentID: "1", Password: "new-password", IsAdmin: true})
+
+	// Then
+	require.NoError(t, err)
+	storage_.AssertNotCalled(t, "GetStudentCredentials", mock.Anything)
+}
+
+func TestService_StudentLifecycle_MemoryStorage(t *testing.T) {
+	// Given
+	storage_ := memory.NewStorage()
+	now := time.Date(2021, time.April, 1, 12, 0, 0, 0, time.UTC)
+	s := newAuthService(t, storage_, now)
+
+	require.NoError(t, s.CreateStudent("Mateo", "old@gmail.com", "secret-password"))
+	require.NoError(t, s.CreateStudent("Juan", "juan@gmail.com", "secret-password"))
+
+	// When
+	updateErr := s.UpdateStudent(UpdateStudentRequest{StudentID: "1", Name: "Mateo Ferrari", Password: "new-password", CurrentPassword: "secret-password"})
+	_, duplicateErr := s.ChangeStudentEmail(ChangeStudentEmailRequest{StudentID: "1", StudentEmail: "juan@gmail.com", Password: "new-password"})
+	_, changeErr := s.ChangeStudentEmail(ChangeStudentEmailRequest{StudentID: "1", StudentEmail: "new@gmail.com", Password: "new-password"})
+
+	// Then
+	require.NoError(t, updateErr)
+	require.True(t, errors.Is(duplicateErr, ErrStudentAlreadyExist))
+	require.NoError(t, changeErr)
+
+	b, err := s.GetStudent("1")
+	require.NoError(t, err)
+	require.JSONEq(t, `{"id":1,"name":"Mateo Ferrari","student_email":"new@gmail.com","role":"STUDENT","career_ids":[],"email_history":[{"old_email":"old@gmail.com","new_email":"new@gmail.com","changed_at":"2021-04-01 12:00:00"}]}`, string(b))
+
+	token := login(t, s, "new@gmail.com", "new-password")
+	identity, err := s.Authenticate(token)
+	require.NoError(t, err)
+	require.Equal(t, "new@gmail.com", identity.StudentEmail)
+
+	_, err = s.Login("old@gmail.com", "new-password")
+	require.True(t, errors.Is(err, ErrInvalidCredentials))
+
+	require.NoError(t, s.DeleteStudent("1"))
+	require.True(t, errors.Is(s.DeleteStudent("1"), ErrNotFound))
+}
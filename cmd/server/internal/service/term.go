@@ -0,0 +1,136 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
+)
+
+var ErrInvalidTerm = errors.New("service: invalid term")
+
+// parseTerm parses terms with the YEAR-CUATRIMESTRE format, e.g. 2021-1.
+func parseTerm(term string) (int, int, error) {
+	parts := strings.Split(term, "-")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("%w [term: %s]: expected format is YEAR-CUATRIMESTRE", ErrInvalidTerm, term)
+	}
+
+	year, err := strconv.Atoi(parts[0])
+	if err != nil || year < 1900 {
+		return 0, 0, fmt.Errorf("%w [term: %s]: invalid year", ErrInvalidTerm, term)
+	}
+
+	cuatrimestre, err := strconv.Atoi(parts[1])
+	if err != nil || cuatrimestre < 1 || cuatrimestre > 2 {
+		return 0, 0, fmt.Errorf("%w [term: %s]: cuatrimestre must be 1 or 2", ErrInvalidTerm, term)
+	}
+
+	return year, cuatrimestre, nil
+}
+
+func (s *Service) getTerm(term string) (storage.Term, error) {
+	year, cuatrimestre, err := parseTerm(term)
+	if err != nil {
+		return storage.Term{}, err
+	}
+
+	t, err := s.storage.GetTerm(year, cuatrimestre)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return storage.Term{}, fmt.Errorf("could not find term [term: %s]: %w", term, ErrNotFound)
+		}
+
+		return storage.Term{}, fmt.Errorf("could not get term [term: %s]: %v", term, err)
+	}
+
+	return t, nil
+}
+
+// resolveTermID returns the id of the requested term or, when none is requested, the id of the current
+// one. Professorships are not filtered by term when there is no term for today, and the ones without a term
+// are listed in every term.
+func (s *Service) resolveTermID(term string) (string, error) {
+	if term != "" {
+		t, err := s.getTerm(term)
+		if err != nil {
+			return "", err
+		}
+
+		return strconv.Itoa(t.ID), nil
+	}
+
+	t, err := s.storage.GetCurrentTerm(s.now().Format("2006-01-02"))
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return "", nil
+		}
+
+		return "", fmt.Errorf("could not get current term: %v", err)
+	}
+
+	return strconv.Itoa(t.ID), nil
+}
+
+type TermRequest struct {
+	Year         int
+	Cuatrimestre int
+	Start        string
+	End          string
+}
+
+func (r TermRequest) toStorage() (storage.TermRequest, error) {
+	if r.Year < 1900 {
+		return storage.TermRequest{}, fmt.Errorf("%w [year: %d]: invalid year", ErrInvalidTerm, r.Year)
+	}
+
+	if r.Cuatrimestre < 1 || r.Cuatrimestre > 2 {
+		return storage.TermRequest{}, fmt.Errorf("%w [cuatrimestre: %d]: cuatrimestre must be 1 or 2", ErrInvalidTerm, r.Cuatrimestre)
+	}
+
+	start, err := time.Parse("2006-01-02", r.Start)
+	if err != nil {
+		return storage.TermRequest{}, fmt.Errorf("%w [start: %s]: expected format is YYYY-MM-DD", ErrInvalidTerm, r.Start)
+	}
+
+	end, err := time.Parse("2006-01-02", r.End)
+	if err != nil {
+		return storage.TermRequest{}, fmt.Errorf("%w [end: %s]: expected format is YYYY-MM-DD", ErrInvalidTerm, r.End)
+	}
+
+	if !start.Before(end) {
+		return storage.TermRequest{}, fmt.Errorf("%w [start: %s, end: %s]: start must be before end", ErrInvalidTerm, r.Start, r.End)
+	}
+
+	return storage.TermRequest{Year: r.Year, Cuatrimestre: r.Cuatrimestre, Start: r.Start, End: r.End}, nil
+}
+
+func (s *Service) CreateTerm(req TermRequest) ([]byte, error) {
+	term, err := req.toStorage()
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := s.storage.CreateTerm(term)
+	if err != nil {
+		return nil, translateCatalogError("create term", err)
+	}
+
+	return marshalCreatedID(id)
+}
+
+func (s *Service) UpdateTerm(termID string, req TermRequest) error {
+	term, err := req.toStorage()
+	if err != nil {
+		return err
+	}
+
+	if err := s.storage.UpdateTerm(termID, term); err != nil {
+		return translateCatalogError("update term", err)
+	}
+
+	return nil
+}
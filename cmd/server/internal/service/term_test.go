@@ -0,0 +1,302 @@
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
+)
+
+func TestParseTerm(t *testing.T) {
+	tt := []struct {
+		name                 string
+		term                 string
+		expectedYear         int
+		expectedCuatrimestre int
+		expectedError        string
+	}{
+		{
+			name:                 "valid term",
+			term:                 "2021-2",
+			expectedYear:         2021,
+			expectedCuatrimestre: 2,
+		},
+		{
+			name:          "invalid format",
+			term:          "2021",
+			expectedError: "service: invalid term [term: 2021]: expected format is YEAR-CUATRIMESTRE",
+		},
+		{
+			name:          "invalid year",
+			term:          "year-1",
+			expectedError: "service: invalid term [term: year-1]: invalid year",
+		},
+		{
+			name:          "invalid cuatrimestre",
+			term:          "2021-3",
+			expectedError: "service: invalid term [term: 2021-3]: cuatrimestre must be 1 or 2",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// When
+			year, cuatrimestre, err := parseTerm(tc.term)
+
+			// Then
+			if tc.expectedError != "" {
+				require.EqualError(t, err, tc.expectedError)
+				require.True(t, errors.Is(err, ErrInvalidTerm))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedYear, year)
+			require.Equal(t, tc.expectedCuatrimestre, cuatrimestre)
+		})
+	}
+}
+
+func TestService_GetProfessorships_CurrentTerm(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("GetCurrentTerm", "2021-05-01").Return(storage.Term{ID: 3, Year: 2021, Cuatrimestre: 1}, nil)
+	storage_.On("GetProfessorships", "1", "2", "3").Return([]storage.Professorship{
+		professorship(7, "K1021", 1, "19:00:00", "22:00:00"),
+	}, nil)
+	storage_.On("GetProfessorshipsProfessors", "1", "2").Return([]storage.ProfessorshipProfessor{}, nil)
+
+	s := NewService(&storage_)
+	s.now = func() time.Time {
+		return time.Date(2021, time.May, 1, 12, 0, 0, 0, time.UTC)
+	}
+
+	// When
+	b, err := s.GetProfessorships("1", "2", "", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.JSONEq(t, `{"K1021":[{"day":"Lunes","start":"19:00","end":"22:00"}]}`, string(b))
+}
+
+func TestService_GetProfessorships_RequestedTerm(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("GetTerm", 2020, 2).Return(storage.Term{ID: 2, Year: 2020, Cuatrimestre: 2}, nil)
+	storage_.On("GetProfessorships", "1", "2", "2").Return([]storage.Professorship{
+		professorship(5, "K1021", 2, "08:00:00", "10:00:00"),
+	}, nil)
+	storage_.On("GetProfessorshipsProfessors", "1", "2").Return([]storage.ProfessorshipProfessor{}, nil)
+
+	s := NewService(&storage_)
+
+	// When
+	b, err := s.GetProfessorships("1", "2", "2020-2", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.JSONEq(t, `{"K1021":[{"day":"Martes","start":"08:00","end":"10:00"}]}`, string(b))
+}
+
+func TestService_GetProfessorships_TermNotFoundError(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("GetTerm", 2020, 2).Return(storage.Term{}, storage.ErrNotFound)
+
+	s := NewService(&storage_)
+
+	// When
+	_, err := s.GetProfessorships("1", "2", "2020-2", false)
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "could not find term [term: 2020-2]: service: resource not found")
+}
+
+func TestService_GetProfessorships_InvalidTermError(t *testing.T) {
+	// Given
+	s := NewService(&storageMock{})
+
+	// When
+	_, err := s.GetProfessorships("1", "2", "2020", false)
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.True(t, errors.Is(err, ErrInvalidTerm))
+}
+
+func TestService_UpdateStudentSubject_WithTerm(t *testing.T) {
+	// Given
+	termID := 3
+
+	storage_ := storageMock{}
+	mockCareerPolicy(&storage_, defaultPolicy)
+	storage_.On("GetTerm", 2021, 1).Return(storage.Term{ID: termID, Year: 2021, Cuatrimestre: 1}, nil)
+	storage_.On("UpdateStudentSubject", storage.UpdateStudentSubjectRequest{
+		StudentEmail: "example@gmail.com",
+		CareerID:     "1",
+		SubjectID:    "2",
+		Status:       "APROBADA",
+		TermID:       &termID,
+	}).Return(nil)
+
+	s := NewService(&storage_)
+
+	// When
+	err := s.UpdateStudentSubject(UpdateStudentSubjectRequest{
+		StudentEmail: "example@gmail.com",
+		CareerID:     "1",
+		SubjectID:    "2",
+		Status:       "APROBADA",
+		Term:         "2021-1",
+
+		OverrideCorrelatives: true,
+		OverrideStatus:       true,
+	})
+
+	// Then
+	require.NoError(t, err)
+}
+
+func TestService_GetStudentCalendar_StoredTerm(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("GetStudentSchedules", "example@gmail.com", "3").Return([]storage.StudentSchedule{
+		{ProfessorshipID: 7, ProfessorshipName: "K1021", SubjectName: "Física I", Day: 5, Start: "19:00:00", End: "22:00:00"},
+	}, nil)
+	storage_.On("GetCurrentTerm", "2021-04-10").Return(storage.Term{ID: 3, Year: 2021, Cuatrimestre: 1, Start: "2021-03-15", End: "2021-07-10"}, nil)
+
+	s := NewService(&storage_)
+	s.now = func() time.Time {
+		return time.Date(2021, time.April, 10, 12, 0, 0, 0, time.UTC)
+	}
+
+	// When
+	b, err := s.GetStudentCalendar("example@gmail.com", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	calendar := string(b)
+	require.Contains(t, calendar, "DTSTART;TZID=America/Argentina/Buenos_Aires:20210319T190000\r\n")
+	require.Contains(t, calendar, "RRULE:FREQ=WEEKLY;UNTIL=20210711T025959Z\r\n")
+}
+
+func TestService_GetStudentCalendar_RequestedTerm(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("GetTerm", 2020, 2).Return(storage.Term{ID: 2, Year: 2020, Cuatrimestre: 2, Start: "2020-08-10", End: "2020-11-28"}, nil)
+	storage_.On("GetStudentSchedules", "example@gmail.com", "2").Return([]storage.StudentSchedule{
+		{ProfessorshipID: 5, ProfessorshipName: "K1011", SubjectName: "Álgebra", Day: 1, Start: "08:00:00", End: "12:00:00"},
+	}, nil)
+
+	s := NewService(&storage_)
+	s.now = func() time.Time {
+		return time.Date(2021, time.April, 10, 12, 0, 0, 0, time.UTC)
+	}
+
+	// When
+	b, err := s.GetStudentCalendar("example@gmail.com", "2020-2")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	calendar := string(b)
+	require.Contains(t, calendar, "DTSTART;TZID=America/Argentina/Buenos_Aires:20200810T080000\r\n")
+	require.Contains(t, calendar, "RRULE:FREQ=WEEKLY;UNTIL=20201129T025959Z\r\n")
+	storage_.AssertNotCalled(t, "GetCurrentTerm", mock.Anything)
+}
+
+func TestService_CreateTerm(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("CreateTerm", storage.TermRequest{Year: 2021, Cuatrimestre: 1, Start: "2021-03-15", End: "2021-07-10"}).Return(3, nil)
+
+	s := NewService(&storage_)
+
+	// When
+	b, err := s.CreateTerm(TermRequest{Year: 2021, Cuatrimestre: 1, Start: "2021-03-15", End: "2021-07-10"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Equal(t, `{"id":3}`, string(b))
+}
+
+func TestService_UpdateTerm_InvalidTermError(t *testing.T) {
+	tt := []struct {
+		name          string
+		req           TermRequest
+		expectedError string
+	}{
+		{
+			name:          "invalid year",
+			req:           TermRequest{Year: 21, Cuatrimestre: 1, Start: "2021-03-15", End: "2021-07-10"},
+			expectedError: "service: invalid term [year: 21]: invalid year",
+		},
+		{
+			name:          "invalid cuatrimestre",
+			req:           TermRequest{Year: 2021, Cuatrimestre: 3, Start: "2021-03-15", End: "2021-07-10"},
+			expectedError: "service: invalid term [cuatrimestre: 3]: cuatrimestre must be 1 or 2",
+		},
+		{
+			name:          "invalid start",
+			req:           TermRequest{Year: 2021, Cuatrimestre: 1, Start: "15/03/2021", End: "2021-07-10"},
+			expectedError: "service: invalid term [start: 15/03/2021]: expected format is YYYY-MM-DD",
+		},
+		{
+			name:          "end before start",
+			req:           TermRequest{Year: 2021, Cuatrimestre: 1, Start: "2021-07-10", End: "2021-03-15"},
+			expectedError: "service: invalid term [start: 2021-07-10, end: 2021-03-15]: start must be before end",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// Given
+			s := NewService(&storageMock{})
+
+			// When
+			err := s.UpdateTerm("3", tc.req)
+			if err == nil {
+				t.Fatal("test must fail")
+			}
+
+			// Then
+			require.True(t, errors.Is(err, ErrInvalidTerm))
+			require.EqualError(t, err, tc.expectedError)
+		})
+	}
+}
+
+func TestService_UpdateTerm_AlreadyExistError(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("UpdateTerm", "3", storage.TermRequest{Year: 2021, Cuatrimestre: 1, Start: "2021-03-15", End: "2021-07-10"}).Return(storage.ErrResourceAlreadyExist)
+
+	s := NewService(&storage_)
+
+	// When
+	err := s.UpdateTerm("3", TermRequest{Year: 2021, Cuatrimestre: 1, Start: "2021-03-15", End: "2021-07-10"})
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "could not update term: service: resource already exist")
+}
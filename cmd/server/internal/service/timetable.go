@@ -0,0 +1,363 @@
+package service
+
+import (
+	"container/heap"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
+)
+
+const (
+	defaultTimetablesLimit = 10
+
+	// maxTimetableNodes bounds the partial timetables explored, since overlapping professorships can make the
+	// search visit millions of them without completing any. A truncated search only ranks the timetables it found,
+	// so better ones may be missing.
+	maxTimetableNodes = 100000
+)
+
+type GenerateTimetablesRequest struct {
+	StudentEmail  string
+	CareerID      string
+	SubjectIDs    []int
+	EarliestStart string
+	FewerDays     bool
+	MaxGap        *int
+	Limit         int
+}
+
+type timetableOption struct {
+	SubjectID       int
+	ProfessorshipID int
+	Name            string
+	Slots           []timeSlot
+}
+
+type timetable struct {
+	order        int
+	options      []timetableOption
+	earlyClasses int
+	exceededGap  int
+	days         int
+	totalGap     int
+	maxGap       int
+}
+
+func newTimetable(options []timetableOption, earliestStart int, maxGap *int) timetable {
+	var slots []timeSlot
+	for _, o := range options {
+		slots = append(slots, o.Slots...)
+	}
+
+	sortTimeSlots(slots)
+
+	t := timetable{options: append([]timetableOption(nil), options...)}
+	days := map[int]bool{}
+	for _, slot := range slots {
+		days[slot.Day] = true
+		if slot.Start < earliestStart {
+			t.earlyClasses++
+		}
+	}
+
+	t.days = len(days)
+	for _, gap := range freeGaps(slots) {
+		minutes := gap.End - gap.Start
+		t.totalGap += minutes
+		if minutes > t.maxGap {
+			t.maxGap = minutes
+		}
+
+		if maxGap != nil && minutes > *maxGap {
+			t.exceededGap += minutes - *maxGap
+		}
+	}
+
+	return t
+}
+
+// timetableHeap keeps the best timetables found with the worst of them at the root, so a better one can replace it.
+type timetableHeap struct {
+	timetables []timetable
+	worse      func(a, b timetable) bool
+}
+
+func (h *timetableHeap) Len() int {
+	return len(h.timetables)
+}
+
+func (h *timetableHeap) Less(i, j int) bool {
+	return h.worse(h.timetables[i], h.timetables[j])
+}
+
+func (h *timetableHeap) Swap(i, j int) {
+	h.timetables[i], h.timetables[j] = h.timetables[j], h.timetables[i]
+}
+
+func (h *timetableHeap) Push(x interface{}) {
+	h.timetables = append(h.timetables, x.(timetable))
+}
+
+func (h *timetableHeap) Pop() interface{} {
+	t := h.timetables[len(h.timetables)-1]
+	h.timetables = h.timetables[:len(h.timetables)-1]
+	return t
+}
+
+func (s *Service) GenerateTimetables(req GenerateTimetablesRequest) ([]byte, error) {
+	type (
+		schedule struct {
+			Day   string `json:"day"`
+			Start string `json:"start"`
+			End   string `json:"end"`
+		}
+
+		professorship struct {
+			ID        int        `json:"id"`
+			Name      string     `json:"name"`
+			SubjectID int        `json:"subject_id"`
+			Schedules []schedule `json:"schedules"`
+		}
+
+		timetableResponse struct {
+			Professorships []professorship `json:"professorships"`
+			Days           int             `json:"days"`
+			EarlyClasses   int             `json:"early_classes"`
+			MaxGap         int             `json:"max_gap"`
+			TotalGap       int             `json:"total_gap"`
+		}
+
+		generateTimetablesResponse struct {
+			Timetables []timetableResponse `json:"timetables"`
+			Total      int                 `json:"total"`
+			Truncated  bool                `json:"truncated"`
+		}
+	)
+
+	earliestStart := 0
+	if req.EarliestStart != "" {
+		var err error
+		if earliestStart, err = parseClock(req.EarliestStart); err != nil {
+			return nil, err
+		}
+	}
+
+	studentSubjects, err := s.storage.GetStudentSubjects(req.StudentEmail, req.CareerID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, fmt.Errorf("could not get subjects: %w", ErrNotFound)
+		}
+
+		return nil, fmt.Errorf("could not get subjects: %v", err)
+	}
+
+	subjects, _ := indexStudentSubjects(studentSubjects)
+
+	termID, err := s.resolveTermID("")
+	if err != nil {
+		return nil, err
+	}
+
+	options := make([][]timetableOption, 0, len(req.SubjectIDs))
+	for _, subjectID := range req.SubjectIDs {
+		if _, exist := subjects[subjectID]; !exist {
+			return nil, fmt.Errorf("could not find subject [subject_id: %d]: %w", subjectID, ErrNotFound)
+		}
+
+		subjectOptions, err := s.getTimetableOptions(subjectID, req.CareerID, termID)
+		if err != nil {
+			return nil, err
+		}
+
+		options = append(options, subjectOptions)
+	}
+
+	sort.SliceStable(options, func(i, j int) bool {
+		return len(options[i]) < len(options[j])
+	})
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultTimetablesLimit
+	}
+
+	better := func(a, b timetable) bool {
+		if a.earlyClasses != b.earlyClasses {
+			return a.earlyClasses < b.earlyClasses
+		}
+
+		if a.exceededGap != b.exceededGap {
+			return a.exceededGap < b.exceededGap
+		}
+
+		if req.FewerDays && a.days != b.days {
+			return a.days < b.days
+		}
+
+		if a.totalGap != b.totalGap {
+			return a.totalGap < b.totalGap
+		}
+
+		return a.order < b.order
+	}
+
+	var (
+		best      = &timetableHeap{worse: func(a, b timetable) bool { return better(b, a) }}
+		chosen    []timetableOption
+		total     int
+		explored  int
+		truncated bool
+	)
+
+	var combine func(i int)
+	combine = func(i int) {
+		if truncated {
+			return
+		}
+
+		if explored++; explored > maxTimetableNodes {
+			truncated = true
+			return
+		}
+
+		if i == len(options) {
+			t := newTimetable(chosen, earliestStart, req.MaxGap)
+			t.order = total
+			total++
+
+			if best.Len() < limit {
+				heap.Push(best, t)
+			} else if better(t, best.timetables[0]) {
+				best.timetables[0] = t
+				heap.Fix(best, 0)
+			}
+
+			return
+		}
+
+		for _, option := range options[i] {
+			if overlapsAny(option, chosen) {
+				continue
+			}
+
+			chosen = append(chosen, option)
+			combine(i + 1)
+			chosen = chosen[:len(chosen)-1]
+		}
+	}
+
+	combine(0)
+
+	timetables := best.timetables
+	sort.Slice(timetables, func(i, j int) bool {
+		return better(timetables[i], timetables[j])
+	})
+
+	response := generateTimetablesResponse{
+		Timetables: make([]timetableResponse, 0, len(timetables)),
+		Total:      total,
+		Truncated:  truncated,
+	}
+
+	for _, t := range timetables {
+		professorships := make([]professorship, 0, len(t.options))
+		for _, o := range t.options {
+			schedules := make([]schedule, 0, len(o.Slots))
+			for _, slot := range o.Slots {
+				schedules = append(schedules, schedule{
+					Day:   dayNumberToDay[slot.Day],
+					Start: formatClock(slot.Start),
+					End:   formatClock(slot.End),
+				})
+			}
+
+			professorships = append(professorships, professorship{
+				ID:        o.ProfessorshipID,
+				Name:      o.Name,
+				SubjectID: o.SubjectID,
+				Schedules: schedules,
+			})
+		}
+
+		sort.Slice(professorships, func(i, j int) bool {
+			return professorships[i].SubjectID < professorships[j].SubjectID
+		})
+
+		response.Timetables = append(response.Timetables, timetableResponse{
+			Professorships: professorships,
+			Days:           t.days,
+			EarlyClasses:   t.earlyClasses,
+			MaxGap:         t.maxGap,
+			TotalGap:       t.totalGap,
+		})
+	}
+
+	b, err := json.Marshal(response)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal response: %v", err)
+	}
+
+	return b, nil
+}
+
+func (s *Service) getTimetableOptions(subjectID int, careerID, termID string) ([]timetableOption, error) {
+	professorships, err := s.storage.GetProfessorships(strconv.Itoa(subjectID), careerID, termID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, fmt.Errorf("could not get professorships [subject_id: %d]: %w", subjectID, ErrNotFound)
+		}
+
+		return nil, fmt.Errorf("could not get professorships [subject_id: %d]: %v", subjectID, err)
+	}
+
+	var options []timetableOption
+	indexes := map[int]int{}
+	for _, professorship := range professorships {
+		i, exist := indexes[professorship.ID]
+		if !exist {
+			i = len(options)
+			indexes[professorship.ID] = i
+			options = append(options, timetableOption{
+				SubjectID:       subjectID,
+				ProfessorshipID: professorship.ID,
+				Name:            professorship.Name,
+			})
+		}
+
+		// A professorship without schedules has no slots, so it fits in every timetable.
+		if professorship.Day == nil {
+			continue
+		}
+
+		slot, err := newTimeSlot(professorship.ID, *professorship.Day, *professorship.Start, *professorship.End)
+		if err != nil {
+			return nil, err
+		}
+
+		options[i].Slots = append(options[i].Slots, slot)
+	}
+
+	for _, o := range options {
+		sortTimeSlots(o.Slots)
+	}
+
+	return options, nil
+}
+
+func overlapsAny(option timetableOption, chosen []timetableOption) bool {
+	for _, c := range chosen {
+		for _, a := range option.Slots {
+			for _, b := range c.Slots {
+				if a.overlaps(b) {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
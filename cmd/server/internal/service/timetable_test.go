@@ -0,0 +1,330 @@
+package service
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
+)
+
+func TestService_GenerateTimetables(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("GetStudentSubjects", "example@gmail.com", "1").Return([]storage.StudentSubject{
+		{ID: 3, Name: "Física I", Status: "PENDIENTE"},
+		{ID: 4, Name: "Química", Status: "PENDIENTE"},
+	}, nil)
+
+	storage_.On("GetCurrentTerm", mock.Anything).Return(storage.Term{}, storage.ErrNotFound)
+	storage_.On("GetProfessorships", "3", "1", "").Return([]storage.Professorship{
+		professorship(10, "K1021", 1, "08:00:00", "10:00:00"),
+		professorship(11, "K1022", 2, "18:00:00", "20:00:00"),
+	}, nil)
+
+	storage_.On("GetProfessorships", "4", "1", "").Return([]storage.Professorship{
+		professorship(20, "K1031", 1, "09:00:00", "11:00:00"),
+		professorship(21, "K1032", 2, "14:00:00", "16:00:00"),
+		professorship(22, "K1033", 3, "18:00:00", "20:00:00"),
+	}, nil)
+
+	s := NewService(&storage_)
+
+	// When
+	b, err := s.GenerateTimetables(GenerateTimetablesRequest{
+		StudentEmail:  "example@gmail.com",
+		CareerID:      "1",
+		SubjectIDs:    []int{3, 4},
+		EarliestStart: "12:00",
+		FewerDays:     true,
+		Limit:         3,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.JSONEq(t, `{
+		"timetables": [
+			{
+				"professorships": [
+					{"id": 11, "name": "K1022", "subject_id": 3, "schedules": [{"day": "Martes", "start": "18:00", "end": "20:00"}]},
+					{"id": 21, "name": "K1032", "subject_id": 4, "schedules": [{"day": "Martes", "start": "14:00", "end": "16:00"}]}
+				],
+				"days": 1,
+				"early_classes": 0,
+				"max_gap": 120,
+				"total_gap": 120
+			},
+			{
+				"professorships": [
+					{"id": 11, "name": "K1022", "subject_id": 3, "schedules": [{"day": "Martes", "start": "18:00", "end": "20:00"}]},
+					{"id": 22, "name": "K1033", "subject_id": 4, "schedules": [{"day": "Miércoles", "start": "18:00", "end": "20:00"}]}
+				],
+				"days": 2,
+				"early_classes": 0,
+				"max_gap": 0,
+				"total_gap": 0
+			},
+			{
+				"professorships": [
+					{"id": 10, "name": "K1021", "subject_id": 3, "schedules": [{"day": "Lunes", "start": "08:00", "end": "10:00"}]},
+					{"id": 21, "name": "K1032", "subject_id": 4, "schedules": [{"day": "Martes", "start": "14:00", "end": "16:00"}]}
+				],
+				"days": 2,
+				"early_classes": 1,
+				"max_gap": 0,
+				"total_gap": 0
+			}
+		],
+		"total": 5,
+		"truncated": false
+	}`, string(b))
+}
+
+func TestService_GenerateTimetables_BestFoundLast(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("GetStudentSubjects", "example@gmail.com", "1").Return([]storage.StudentSubject{
+		{ID: 3, Name: "Física I", Status: "PENDIENTE"},
+		{ID: 4, Name: "Química", Status: "PENDIENTE"},
+		{ID: 5, Name: "Inglés", Status: "PENDIENTE"},
+	}, nil)
+
+	storage_.On("GetCurrentTerm", mock.Anything).Return(storage.Term{}, storage.ErrNotFound)
+	storage_.On("GetProfessorships", "3", "1", "").Return([]storage.Professorship{
+		professorship(10, "K1021", 1, "08:00:00", "10:00:00"),
+		professorship(11, "K1022", 2, "18:00:00", "20:00:00"),
+	}, nil)
+
+	storage_.On("GetProfessorships", "4", "1", "").Return([]storage.Professorship{
+		professorship(20, "K1031", 1, "09:00:00", "11:00:00"),
+		professorship(21, "K1032", 2, "14:00:00", "16:00:00"),
+		professorship(22, "K1033", 3, "18:00:00", "20:00:00"),
+	}, nil)
+
+	storage_.On("GetProfessorships", "5", "1", "").Return([]storage.Professorship{
+		{ID: 30, Name: "Virtual"},
+	}, nil)
+
+	s := NewService(&storage_)
+
+	// When
+	b, err := s.GenerateTimetables(GenerateTimetablesRequest{
+		StudentEmail:  "example@gmail.com",
+		CareerID:      "1",
+		SubjectIDs:    []int{3, 4, 5},
+		EarliestStart: "12:00",
+		FewerDays:     true,
+		Limit:         1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.JSONEq(t, `{
+		"timetables": [
+			{
+				"professorships": [
+					{"id": 11, "name": "K1022", "subject_id": 3, "schedules": [{"day": "Martes", "start": "18:00", "end": "20:00"}]},
+					{"id": 21, "name": "K1032", "subject_id": 4, "schedules": [{"day": "Martes", "start": "14:00", "end": "16:00"}]},
+					{"id": 30, "name": "Virtual", "subject_id": 5, "schedules": []}
+				],
+				"days": 1,
+				"early_classes": 0,
+				"max_gap": 120,
+				"total_gap": 120
+			}
+		],
+		"total": 5,
+		"truncated": false
+	}`, string(b))
+}
+
+func TestService_GenerateTimetables_MaxGap(t *testing.T) {
+	// Given
+	maxGap := 60
+
+	storage_ := storageMock{}
+	storage_.On("GetStudentSubjects", "example@gmail.com", "1").Return([]storage.StudentSubject{
+		{ID: 3, Name: "Física I", Status: "PENDIENTE"},
+		{ID: 4, Name: "Química", Status: "PENDIENTE"},
+	}, nil)
+
+	storage_.On("GetCurrentTerm", mock.Anything).Return(storage.Term{}, storage.ErrNotFound)
+	storage_.On("GetProfessorships", "3", "1", "").Return([]storage.Professorship{
+		professorship(11, "K1022", 2, "18:00:00", "20:00:00"),
+	}, nil)
+
+	storage_.On("GetProfessorships", "4", "1", "").Return([]storage.Professorship{
+		professorship(21, "K1032", 2, "14:00:00", "16:00:00"),
+		professorship(22, "K1033", 3, "18:00:00", "20:00:00"),
+	}, nil)
+
+	s := NewService(&storage_)
+
+	// When
+	b, err := s.GenerateTimetables(GenerateTimetablesRequest{
+		StudentEmail: "example@gmail.com",
+		CareerID:     "1",
+		SubjectIDs:   []int{3, 4},
+		FewerDays:    true,
+		MaxGap:       &maxGap,
+		Limit:        1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.JSONEq(t, `{
+		"timetables": [
+			{
+				"professorships": [
+					{"id": 11, "name": "K1022", "subject_id": 3, "schedules": [{"day": "Martes", "start": "18:00", "end": "20:00"}]},
+					{"id": 22, "name": "K1033", "subject_id": 4, "schedules": [{"day": "Miércoles", "start": "18:00", "end": "20:00"}]}
+				],
+				"days": 2,
+				"early_classes": 0,
+				"max_gap": 0,
+				"total_gap": 0
+			}
+		],
+		"total": 2,
+		"truncated": false
+	}`, string(b))
+}
+
+func TestService_GenerateTimetables_NoValidCombination(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("GetStudentSubjects", "example@gmail.com", "1").Return([]storage.StudentSubject{
+		{ID: 3, Name: "Física I", Status: "PENDIENTE"},
+		{ID: 4, Name: "Química", Status: "PENDIENTE"},
+	}, nil)
+
+	storage_.On("GetCurrentTerm", mock.Anything).Return(storage.Term{}, storage.ErrNotFound)
+	storage_.On("GetProfessorships", "3", "1", "").Return([]storage.Professorship{
+		professorship(10, "K1021", 1, "08:00:00", "10:00:00"),
+	}, nil)
+
+	storage_.On("GetProfessorships", "4", "1", "").Return([]storage.Professorship{
+		professorship(20, "K1031", 1, "09:00:00", "11:00:00"),
+	}, nil)
+
+	s := NewService(&storage_)
+
+	// When
+	b, err := s.GenerateTimetables(GenerateTimetablesRequest{
+		StudentEmail: "example@gmail.com",
+		CareerID:     "1",
+		SubjectIDs:   []int{3, 4},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.JSONEq(t, `{"timetables":[],"total":0,"truncated":false}`, string(b))
+}
+
+func TestService_GenerateTimetables_SubjectNotFoundError(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("GetStudentSubjects", "example@gmail.com", "1").Return([]storage.StudentSubject{
+		{ID: 3, Name: "Física I", Status: "PENDIENTE"},
+	}, nil)
+
+	storage_.On("GetCurrentTerm", mock.Anything).Return(storage.Term{}, storage.ErrNotFound)
+	s := NewService(&storage_)
+
+	// When
+	_, err := s.GenerateTimetables(GenerateTimetablesRequest{
+		StudentEmail: "example@gmail.com",
+		CareerID:     "1",
+		SubjectIDs:   []int{5},
+	})
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "could not find subject [subject_id: 5]: service: resource not found")
+}
+
+func TestService_GenerateTimetables_ProfessorshipsNotFoundError(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("GetStudentSubjects", "example@gmail.com", "1").Return([]storage.StudentSubject{
+		{ID: 3, Name: "Física I", Status: "PENDIENTE"},
+	}, nil)
+
+	storage_.On("GetCurrentTerm", mock.Anything).Return(storage.Term{}, storage.ErrNotFound)
+	storage_.On("GetProfessorships", "3", "1", "").Return([]storage.Professorship{}, storage.ErrNotFound)
+
+	s := NewService(&storage_)
+
+	// When
+	_, err := s.GenerateTimetables(GenerateTimetablesRequest{
+		StudentEmail: "example@gmail.com",
+		CareerID:     "1",
+		SubjectIDs:   []int{3},
+	})
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	require.EqualError(t, err, "could not get professorships [subject_id: 3]: service: resource not found")
+}
+
+func TestService_GenerateTimetables_TruncatedSearch(t *testing.T) {
+	// Given
+	storage_ := storageMock{}
+	storage_.On("GetCurrentTerm", mock.Anything).Return(storage.Term{}, storage.ErrNotFound)
+
+	// Every subject has ten professorships at the same hour, and the ones of the last subject overlap all the
+	// others, so no timetable is ever completed.
+	var (
+		subjects   []storage.StudentSubject
+		subjectIDs []int
+	)
+
+	for i := 1; i <= 12; i++ {
+		subjects = append(subjects, storage.StudentSubject{ID: i, Name: "Materia " + strconv.Itoa(i), Status: "PENDIENTE"})
+		subjectIDs = append(subjectIDs, i)
+
+		start, end := fmt.Sprintf("%02d:00:00", 7+i), fmt.Sprintf("%02d:00:00", 8+i)
+		if i == 12 {
+			start, end = "07:00:00", "21:00:00"
+		}
+
+		var professorships []storage.Professorship
+		for j := 1; j <= 10; j++ {
+			professorships = append(professorships, professorship(i*100+j, "Cátedra", 1, start, end))
+		}
+
+		storage_.On("GetProfessorships", strconv.Itoa(i), "1", "").Return(professorships, nil)
+	}
+
+	storage_.On("GetStudentSubjects", "example@gmail.com", "1").Return(subjects, nil)
+
+	s := NewService(&storage_)
+
+	// When
+	b, err := s.GenerateTimetables(GenerateTimetablesRequest{
+		StudentEmail: "example@gmail.com",
+		CareerID:     "1",
+		SubjectIDs:   subjectIDs,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.JSONEq(t, `{"timetables":[],"total":0,"truncated":true}`, string(b))
+}
@@ -0,0 +1,179 @@
+package internal
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service"
+	"github.com/mateoferrari97/Kit/web/server"
+)
+
+// wrapStudentID registers a route under /students/{studentID}, only reachable by the student with that id and by
+// admins. The student email is resolved from the id, so the handlers can read it as in the student routes.
+func (h *Handler) wrapStudentID(method, pattern string, f server.HandlerFunc) {
+	h.wrapper.Wrap(method, "/students/{studentID:[0-9]+}"+pattern, f, h.authenticate, h.authorizeStudentID)
+}
+
+func (h *Handler) authorizeStudentID(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		identity, _ := identityFromRequest(r)
+		vars := map[string]string{}
+		for k, v := range mux.Vars(r) {
+			vars[k] = v
+		}
+
+		if vars["studentID"] != strconv.Itoa(identity.StudentID) && !identity.IsAdmin() {
+			respondError(w, server.NewError("token does not belong to student", http.StatusForbidden))
+			return
+		}
+
+		studentEmail, err := h.service.GetStudentEmail(vars["studentID"])
+		if err != nil {
+			switch {
+			case errors.Is(err, service.ErrNotFound) && identity.IsAdmin():
+				respondError(w, server.NewError(err.Error(), http.StatusNotFound))
+			case errors.Is(err, service.ErrNotFound):
+				respondError(w, server.NewError("token does not belong to student", http.StatusForbidden))
+			default:
+				respondError(w, server.NewError(err.Error(), http.StatusInternalServerError))
+			}
+
+			return
+		}
+
+		vars["studentEmail"] = studentEmail
+		next(w, mux.SetURLVars(r, vars))
+	}
+}
+
+func studentError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrNotFound):
+		return server.NewError(err.Error(), http.StatusNotFound)
+	case errors.Is(err, service.ErrStudentAlreadyExist):
+		return server.NewError(err.Error(), http.StatusConflict)
+	case errors.Is(err, service.ErrInvalidCredentials):
+		return server.NewError(err.Error(), http.StatusUnauthorized)
+	case errors.Is(err, service.ErrPasswordRequired):
+		return server.NewError(err.Error(), http.StatusBadRequest)
+	default:
+		return err
+	}
+}
+
+func (h *Handler) GetStudent() {
+	wrapH := func(w http.ResponseWriter, r *http.Request) error {
+		studentID, err := requiredParam(r, "studentID", "student id")
+		if err != nil {
+			return err
+		}
+
+		response, err := h.service.GetStudent(studentID)
+		if err != nil {
+			return studentError(err)
+		}
+
+		return server.RespondJSON(w, response, http.StatusOK)
+	}
+
+	h.wrapStudentID(http.MethodGet, "", wrapH)
+}
+
+func (h *Handler) UpdateStudent() {
+	wrapH := func(w http.ResponseWriter, r *http.Request) error {
+		studentID, err := requiredParam(r, "studentID", "student id")
+		if err != nil {
+			return err
+		}
+
+		var student struct {
+			Name            string `json:"name" validate:"omitempty,max=50"`
+			Password        string `json:"password" validate:"omitempty,min=8"`
+			CurrentPassword string `json:"current_password"`
+		}
+
+		if err := decodeAndValidate(r, &student); err != nil {
+			return err
+		}
+
+		if student.Name == "" && student.Password == "" {
+			return server.NewError("name or password is required", http.StatusBadRequest)
+		}
+
+		identity, _ := identityFromRequest(r)
+		err = h.service.UpdateStudent(service.UpdateStudentRequest{
+			StudentID:       studentID,
+			Name:            student.Name,
+			Password:        student.Password,
+			CurrentPassword: student.CurrentPassword,
+			IsAdmin:         identity.IsAdmin(),
+		})
+
+		if err != nil {
+			return studentError(err)
+		}
+
+		return server.RespondJSON(w, nil, http.StatusOK)
+	}
+
+	h.wrapStudentID(http.MethodPatch, "", wrapH)
+}
+
+func (h *Handler) DeleteStudent() {
+	wrapH := func(w http.ResponseWriter, r *http.Request) error {
+		studentID, err := requiredParam(r, "studentID", "student id")
+		if err != nil {
+			return err
+		}
+
+		if err := h.service.DeleteStudent(studentID); err != nil {
+			return studentError(err)
+		}
+
+		return server.RespondJSON(w, nil, http.StatusNoContent)
+	}
+
+	h.wrapStudentID(http.MethodDelete, "", wrapH)
+}
+
+func (h *Handler) ChangeStudentEmail() {
+	wrapH := func(w http.ResponseWriter, r *http.Request) error {
+		studentID, err := requiredParam(r, "studentID", "student id")
+		if err != nil {
+			return err
+		}
+
+		var email struct {
+			StudentEmail string `json:"student_email" validate:"required,email,max=128"`
+			Password     string `json:"password"`
+		}
+
+		if err := decodeAndValidate(r, &email); err != nil {
+			return err
+		}
+
+		identity, _ := identityFromRequest(r)
+		response, err := h.service.ChangeStudentEmail(service.ChangeStudentEmailRequest{
+			StudentID:    studentID,
+			StudentEmail: email.StudentEmail,
+			Password:     email.Password,
+			IsAdmin:      identity.IsAdmin(),
+		})
+
+		if err != nil {
+			return studentError(err)
+		}
+
+		// Admins changing the email without the password get no token for the student.
+		if response == nil {
+			return server.RespondJSON(w, nil, http.StatusOK)
+		}
+
+		return server.RespondJSON(w, response, http.StatusOK)
+	}
+
+	h.wrapStudentID(http.MethodPut, "/email", wrapH)
+}
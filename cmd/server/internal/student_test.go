@@ -0,0 +1,186 @@
+package internal
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service"
+)
+
+func TestHandler_GetStudent(t *testing.T) {
+	// Given
+	service_ := serviceMock{}
+	service_.On("GetStudentEmail", "1").Return("example@gmail.com", nil)
+	service_.On("GetStudent", "1").Return([]byte(`{"id":1}`), nil)
+
+	sv, h := newAdminServer(&service_)
+	h.GetStudent()
+
+	// When
+	w := serveAdmin(sv, http.MethodGet, "/students/1", "student", "")
+
+	// Then
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, `{"id":1}`, w.Body.String())
+}
+
+func TestHandler_GetStudent_ForbiddenError(t *testing.T) {
+	// Given
+	service_ := serviceMock{}
+	service_.On("GetStudentEmail", "2").Return("other@gmail.com", nil)
+	service_.On("GetStudentEmail", "3").Return("", service.ErrNotFound)
+
+	sv, h := newAdminServer(&service_)
+	h.GetStudent()
+
+	// When
+	other := serveAdmin(sv, http.MethodGet, "/students/2", "student", "")
+	missing := serveAdmin(sv, http.MethodGet, "/students/3", "student", "")
+
+	// Then
+	require.Equal(t, http.StatusForbidden, other.Code)
+	require.Equal(t, http.StatusForbidden, missing.Code)
+	service_.AssertNotCalled(t, "GetStudent")
+}
+
+func TestHandler_GetStudent_AdminNotFoundError(t *testing.T) {
+	// Given
+	service_ := serviceMock{}
+	service_.On("GetStudentEmail", "3").Return("", service.ErrNotFound)
+
+	sv, h := newAdminServer(&service_)
+	h.GetStudent()
+
+	// When
+	w := serveAdmin(sv, http.MethodGet, "/students/3", "admin", "")
+
+	// Then
+	require.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestHandler_UpdateStudent(t *testing.T) {
+	// Given
+	service_ := serviceMock{}
+	service_.On("GetStudentEmail", "1").Return("example@gmail.com", nil)
+	service_.On("UpdateStudent", service.UpdateStudentRequest{StudentID: "1", Name: "Mateo", Password: "new-password", CurrentPassword: "secret-password"}).Return(nil)
+
+	sv, h := newAdminServer(&service_)
+	h.UpdateStudent()
+
+	// When
+	w := serveAdmin(sv, http.MethodPatch, "/students/1", "student", `{"name":"Mateo","password":"new-password","current_password":"secret-password"}`)
+
+	// Then
+	require.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestHandler_UpdateStudent_BodyValidationError(t *testing.T) {
+	tt := []struct {
+		name string
+		body string
+	}{
+		{name: "empty body", body: `{}`},
+		{name: "short password", body: `{"password":"short","current_password":"secret-password"}`},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// Given
+			service_ := serviceMock{}
+			service_.On("GetStudentEmail", "1").Return("example@gmail.com", nil)
+
+			sv, h := newAdminServer(&service_)
+			h.UpdateStudent()
+
+			// When
+			w := serveAdmin(sv, http.MethodPatch, "/students/1", "student", tc.body)
+
+			// Then
+			require.Equal(t, http.StatusBadRequest, w.Code)
+			service_.AssertNotCalled(t, "UpdateStudent")
+		})
+	}
+}
+
+func TestHandler_UpdateStudent_PasswordRequiredError(t *testing.T) {
+	// Given
+	service_ := serviceMock{}
+	service_.On("GetStudentEmail", "1").Return("example@gmail.com", nil)
+	service_.On("UpdateStudent", service.UpdateStudentRequest{StudentID: "1", Password: "new-password"}).Return(service.ErrPasswordRequired)
+
+	sv, h := newAdminServer(&service_)
+	h.UpdateStudent()
+
+	// When
+	w := serveAdmin(sv, http.MethodPatch, "/students/1", "student", `{"password":"new-password"}`)
+
+	// Then
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestHandler_DeleteStudent(t *testing.T) {
+	// Given
+	service_ := serviceMock{}
+	service_.On("GetStudentEmail", "1").Return("example@gmail.com", nil)
+	service_.On("DeleteStudent", "1").Return(nil)
+
+	sv, h := newAdminServer(&service_)
+	h.DeleteStudent()
+
+	// When
+	w := serveAdmin(sv, http.MethodDelete, "/students/1", "admin", "")
+
+	// Then
+	require.Equal(t, http.StatusNoContent, w.Code)
+}
+
+func TestHandler_ChangeStudentEmail(t *testing.T) {
+	// Given
+	service_ := serviceMock{}
+	service_.On("GetStudentEmail", "1").Return("example@gmail.com", nil)
+	service_.On("ChangeStudentEmail", service.ChangeStudentEmailRequest{StudentID: "1", StudentEmail: "new@gmail.com", Password: "secret-password"}).Return([]byte(`{"token":"abc"}`), nil)
+
+	sv, h := newAdminServer(&service_)
+	h.ChangeStudentEmail()
+
+	// When
+	w := serveAdmin(sv, http.MethodPut, "/students/1/email", "student", `{"student_email":"new@gmail.com","password":"secret-password"}`)
+
+	// Then
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, `{"token":"abc"}`, w.Body.String())
+}
+
+func TestHandler_ChangeStudentEmail_AlreadyExistError(t *testing.T) {
+	// Given
+	service_ := serviceMock{}
+	service_.On("GetStudentEmail", "1").Return("example@gmail.com", nil)
+	service_.On("ChangeStudentEmail", service.ChangeStudentEmailRequest{StudentID: "1", StudentEmail: "other@gmail.com", IsAdmin: true}).Return([]byte(nil), service.ErrStudentAlreadyExist)
+
+	sv, h := newAdminServer(&service_)
+	h.ChangeStudentEmail()
+
+	// When
+	w := serveAdmin(sv, http.MethodPut, "/students/1/email", "admin", `{"student_email":"other@gmail.com"}`)
+
+	// Then
+	require.Equal(t, http.StatusConflict, w.Code)
+}
+
+func TestHandler_ChangeStudentEmail_PasswordRequiredError(t *testing.T) {
+	// Given
+	service_ := serviceMock{}
+	service_.On("GetStudentEmail", "1").Return("example@gmail.com", nil)
+	service_.On("ChangeStudentEmail", service.ChangeStudentEmailRequest{StudentID: "1", StudentEmail: "new@gmail.com"}).Return([]byte(nil), service.ErrPasswordRequired)
+
+	sv, h := newAdminServer(&service_)
+	h.ChangeStudentEmail()
+
+	// When
+	w := serveAdmin(sv, http.MethodPut, "/students/1/email", "student", `{"student_email":"new@gmail.com"}`)
+
+	// Then
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
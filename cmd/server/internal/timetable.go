@@ -0,0 +1,68 @@
+package internal
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service"
+	"github.com/mateoferrari97/Kit/web/server"
+)
+
+const timetableTimeLayout = "15:04"
+
+func (h *Handler) GenerateTimetables() {
+	wrapH := func(w http.ResponseWriter, r *http.Request) error {
+		studentEmail, err := requiredParam(r, "studentEmail", "student email")
+		if err != nil {
+			return err
+		}
+
+		careerID, err := requiredParam(r, "careerID", "career id")
+		if err != nil {
+			return err
+		}
+
+		var timetable struct {
+			SubjectIDs  []int `json:"subject_ids" validate:"required,min=1,max=10,unique,dive,min=1"`
+			Preferences struct {
+				EarliestStart string `json:"earliest_start"`
+				FewerDays     bool   `json:"fewer_days"`
+				MaxGap        *int   `json:"max_gap" validate:"omitempty,min=0"`
+			} `json:"preferences"`
+			Limit int `json:"limit" validate:"omitempty,min=1,max=50"`
+		}
+
+		if err := decodeAndValidate(r, &timetable); err != nil {
+			return err
+		}
+
+		if earliestStart := timetable.Preferences.EarliestStart; earliestStart != "" {
+			if _, err := time.Parse(timetableTimeLayout, earliestStart); err != nil {
+				return server.NewError("earliest start must have HH:MM format", http.StatusBadRequest)
+			}
+		}
+
+		response, err := h.service.GenerateTimetables(service.GenerateTimetablesRequest{
+			StudentEmail:  studentEmail,
+			CareerID:      careerID,
+			SubjectIDs:    timetable.SubjectIDs,
+			EarliestStart: timetable.Preferences.EarliestStart,
+			FewerDays:     timetable.Preferences.FewerDays,
+			MaxGap:        timetable.Preferences.MaxGap,
+			Limit:         timetable.Limit,
+		})
+
+		if err != nil {
+			if errors.Is(err, service.ErrNotFound) {
+				return server.NewError(err.Error(), http.StatusNotFound)
+			}
+
+			return err
+		}
+
+		return server.RespondJSON(w, response, http.StatusOK)
+	}
+
+	h.wrapStudent(http.MethodPost, "/careers/{careerID}/timetables", wrapH)
+}
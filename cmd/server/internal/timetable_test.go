@@ -0,0 +1,141 @@
+package internal
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service"
+	"github.com/mateoferrari97/Kit/web/server"
+)
+
+func TestHandler_GenerateTimetables(t *testing.T) {
+	// Given
+	maxGap := 60
+
+	wrapper := wrapperMock{}
+	service_ := serviceMock{}
+	service_.On("GenerateTimetables", service.GenerateTimetablesRequest{
+		StudentEmail:  "example@gmail.com",
+		CareerID:      "1",
+		SubjectIDs:    []int{3, 4},
+		EarliestStart: "09:00",
+		FewerDays:     true,
+		MaxGap:        &maxGap,
+		Limit:         5,
+	}).Return([]byte(`{"timetables":[],"total":0,"truncated":false}`), nil)
+
+	h := NewHandler(&wrapper, &service_)
+	h.GenerateTimetables()
+
+	w := httptest.NewRecorder()
+	body := `{"subject_ids":[3,4],"preferences":{"earliest_start":"09:00","fewer_days":true,"max_gap":60},"limit":5}`
+	r, _ := http.NewRequest("POST", "whocares", bytes.NewReader([]byte(body)))
+	r = mux.SetURLVars(r, map[string]string{
+		"studentEmail": "example@gmail.com",
+		"careerID":     "1",
+	})
+
+	// When
+	err := wrapper.f(w, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, `{"timetables":[],"total":0,"truncated":false}`, w.Body.String())
+}
+
+func TestHandler_GenerateTimetables_BodyValidationError(t *testing.T) {
+	tt := []struct {
+		name          string
+		body          string
+		expectedError string
+	}{
+		{
+			name:          "subject ids are missing",
+			body:          `{}`,
+			expectedError: "Key: 'SubjectIDs' Error:Field validation for 'SubjectIDs' failed on the 'required' tag",
+		},
+		{
+			name:          "subject ids are repeated",
+			body:          `{"subject_ids":[3,3]}`,
+			expectedError: "Key: 'SubjectIDs' Error:Field validation for 'SubjectIDs' failed on the 'unique' tag",
+		},
+		{
+			name:          "limit is out of range",
+			body:          `{"subject_ids":[3],"limit":51}`,
+			expectedError: "Key: 'Limit' Error:Field validation for 'Limit' failed on the 'max' tag",
+		},
+		{
+			name:          "earliest start has invalid format",
+			body:          `{"subject_ids":[3],"preferences":{"earliest_start":"9am"}}`,
+			expectedError: "earliest start must have HH:MM format",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// Given
+			wrapper := wrapperMock{}
+
+			h := NewHandler(&wrapper, nil)
+			h.GenerateTimetables()
+
+			w := httptest.NewRecorder()
+			r, _ := http.NewRequest("POST", "whocares", bytes.NewReader([]byte(tc.body)))
+			r = mux.SetURLVars(r, map[string]string{
+				"studentEmail": "example@gmail.com",
+				"careerID":     "1",
+			})
+
+			// When
+			err := wrapper.f(w, r)
+			if err == nil {
+				t.Fatal("test must fail")
+			}
+
+			// Then
+			hErr := err.(*server.Error)
+			require.Equal(t, http.StatusBadRequest, hErr.StatusCode)
+			require.Equal(t, tc.expectedError, hErr.Message)
+		})
+	}
+}
+
+func TestHandler_GenerateTimetables_ServiceNotFoundError(t *testing.T) {
+	// Given
+	wrapper := wrapperMock{}
+	service_ := serviceMock{}
+	service_.On("GenerateTimetables", service.GenerateTimetablesRequest{
+		StudentEmail: "example@gmail.com",
+		CareerID:     "1",
+		SubjectIDs:   []int{3},
+	}).Return([]byte{}, service.ErrNotFound)
+
+	h := NewHandler(&wrapper, &service_)
+	h.GenerateTimetables()
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest("POST", "whocares", bytes.NewReader([]byte(`{"subject_ids":[3]}`)))
+	r = mux.SetURLVars(r, map[string]string{
+		"studentEmail": "example@gmail.com",
+		"careerID":     "1",
+	})
+
+	// When
+	err := wrapper.f(w, r)
+	if err == nil {
+		t.Fatal("test must fail")
+	}
+
+	// Then
+	hErr := err.(*server.Error)
+	require.Equal(t, http.StatusNotFound, hErr.StatusCode)
+	require.Equal(t, "service: resource not found", hErr.Message)
+}
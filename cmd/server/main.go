@@ -1,15 +1,18 @@
 package main
 
 import (
+	"errors"
 	"fmt"
 	"os"
-
-	_ "github.com/go-sql-driver/mysql"
-	"github.com/jmoiron/sqlx"
+	"strconv"
+	"time"
+	_ "time/tzdata"
 
 	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal"
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/database"
 	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service"
 	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/migrations"
 	"github.com/mateoferrari97/Kit/web/server"
 )
 
@@ -20,21 +23,104 @@ func main() {
 }
 
 func run() error {
-	stg, err := newStorage()
+	db, err := database.Open(database.ConfigFromEnv())
+	if err != nil {
+		return err
+	}
+
+	migrator, err := migrations.NewMigrator(db)
+	if err != nil {
+		return err
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		return migrate(migrator, os.Args[2:])
+	}
+
+	if _, err := migrator.Up(); err != nil {
+		return err
+	}
+
+	stg := storage.NewStorage(db)
+
+	calendar, err := newCalendarConfig()
 	if err != nil {
 		return err
 	}
 
-	svc := service.NewService(stg)
+	auth, err := newAuthConfig()
+	if err != nil {
+		return err
+	}
+
+	svc := service.NewService(stg, service.WithCalendar(calendar), service.WithAuth(auth))
 	sv := server.NewServer()
 	handler := internal.NewHandler(sv, svc)
 
 	handler.CreateStudent()
+	handler.Login()
+	handler.GetStudent()
+	handler.UpdateStudent()
+	handler.DeleteStudent()
+	handler.ChangeStudentEmail()
 	handler.AssignStudentToCareer()
+	handler.UnassignStudentFromCareer()
+	handler.TransferStudentCareer()
 	handler.GetStudentSubjects()
 	handler.UpdateStudentSubject()
 	handler.GetSubjectDetails()
 	handler.GetProfessorships()
+	handler.GetFaculties()
+	handler.GetFacultyCareers()
+	handler.GetCareer()
+	handler.GetProfessor()
+	handler.GetMaterials()
+	handler.CreateMaterial()
+	handler.UpdateMaterial()
+	handler.DeleteMaterial()
+	handler.CreateExam()
+	handler.GetStudentCareerSummary()
+	handler.GetAvailableSubjects()
+	handler.CheckScheduleConflicts()
+	handler.GenerateTimetables()
+	handler.EnrollStudentInProfessorship()
+	handler.DeleteStudentProfessorship()
+	handler.GetStudentCalendar()
+	handler.CreateCalendarToken()
+	handler.RevokeCalendarToken()
+	handler.GetCalendarByToken()
+	handler.ExportStudentRecord()
+	handler.CreateFaculty()
+	handler.UpdateFaculty()
+	handler.DeleteFaculty()
+	handler.CreateCareer()
+	handler.UpdateCareer()
+	handler.DeleteCareer()
+	handler.CreateSubject()
+	handler.UpdateSubject()
+	handler.DeleteSubject()
+	handler.CreateCareerSubject()
+	handler.UpdateCareerSubject()
+	handler.DeleteCareerSubject()
+	handler.CreateProfessorship()
+	handler.UpdateProfessorship()
+	handler.DeleteProfessorship()
+	handler.CreateSchedule()
+	handler.UpdateSchedule()
+	handler.DeleteSchedule()
+	handler.CreateTerm()
+	handler.UpdateTerm()
+	handler.ImportCareerPlan()
+	handler.GetEquivalences()
+	handler.CreateEquivalence()
+	handler.DeleteEquivalence()
+	handler.GetStudentEquivalences()
+	handler.RequestEquivalence()
+	handler.GetEquivalenceRequests()
+	handler.ResolveEquivalenceRequest()
+	handler.GetFacultyPolicy()
+	handler.UpdateFacultyPolicy()
+	handler.Search()
 
 	return sv.Run(getPort())
 }
@@ -48,16 +134,90 @@ func getPort() string {
 	return ":" + port
 }
 
-func newStorage() (*storage.Storage, error) {
-	source := os.Getenv("DATABASE_CONFIG")
-	if source == "" {
-		source = "root:root@tcp(localhost:3306)/study_in_exactas"
+// migrate runs the migrate subcommand: "migrate up" applies the pending migrations, "migrate down [steps]"
+// reverts the last ones and "migrate version" prints the current schema version.
+func migrate(migrator *migrations.Migrator, args []string) error {
+	command := "up"
+	if len(args) > 0 {
+		command = args[0]
+	}
+
+	switch command {
+	case "up":
+		applied, err := migrator.Up()
+		for _, m := range applied {
+			fmt.Printf("applied %d_%s\n", m.Version, m.Name)
+		}
+
+		return err
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			var err error
+			if steps, err = strconv.Atoi(args[1]); err != nil || steps < 1 {
+				return fmt.Errorf("could not parse steps: %s is not a positive number", args[1])
+			}
+		}
+
+		reverted, err := migrator.Down(steps)
+		for _, m := range reverted {
+			fmt.Printf("reverted %d_%s\n", m.Version, m.Name)
+		}
+
+		return err
+	case "version":
+		version, err := migrator.Version()
+		if err != nil {
+			return err
+		}
+
+		fmt.Println(version)
+		return nil
+	default:
+		return fmt.Errorf("unknown migrate command %s: expected up, down or version", command)
+	}
+}
+
+func newCalendarConfig() (service.CalendarConfig, error) {
+	timezone := os.Getenv("CALENDAR_TIMEZONE")
+	if timezone == "" {
+		timezone = "America/Argentina/Buenos_Aires"
 	}
 
-	db, err := sqlx.Open("mysql", source)
+	location, err := time.LoadLocation(timezone)
 	if err != nil {
-		return nil, fmt.Errorf("could not connect to db: %v", err)
+		return service.CalendarConfig{}, fmt.Errorf("could not load calendar timezone: %v", err)
+	}
+
+	config := service.CalendarConfig{Location: location}
+	if start := os.Getenv("CALENDAR_TERM_START"); start != "" {
+		if config.TermStart, err = time.ParseInLocation("2006-01-02", start, location); err != nil {
+			return service.CalendarConfig{}, fmt.Errorf("could not parse calendar term start: %v", err)
+		}
+	}
+
+	if end := os.Getenv("CALENDAR_TERM_END"); end != "" {
+		if config.TermEnd, err = time.ParseInLocation("2006-01-02", end, location); err != nil {
+			return service.CalendarConfig{}, fmt.Errorf("could not parse calendar term end: %v", err)
+		}
+	}
+
+	return config, nil
+}
+
+func newAuthConfig() (service.AuthConfig, error) {
+	secret := os.Getenv("AUTH_SECRET")
+	if secret == "" {
+		return service.AuthConfig{}, errors.New("could not configure auth: AUTH_SECRET is required")
+	}
+
+	config := service.AuthConfig{Secret: []byte(secret)}
+	if ttl := os.Getenv("AUTH_TOKEN_TTL"); ttl != "" {
+		var err error
+		if config.TokenTTL, err = time.ParseDuration(ttl); err != nil {
+			return service.AuthConfig{}, fmt.Errorf("could not parse auth token ttl: %v", err)
+		}
 	}
 
-	return storage.NewStorage(db), nil
+	return config, nil
 }
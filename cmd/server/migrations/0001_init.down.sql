@@ -0,0 +1,23 @@
+DROP TABLE IF EXISTS student_career_subject;
+
+DROP TABLE IF EXISTS student_career;
+
+DROP TABLE IF EXISTS student;
+
+DROP TABLE IF EXISTS professorship_professor;
+
+DROP TABLE IF EXISTS professor;
+
+DROP TABLE IF EXISTS material;
+
+DROP TABLE IF EXISTS schedule;
+
+DROP TABLE IF EXISTS professorship;
+
+DROP TABLE IF EXISTS career_subject;
+
+DROP TABLE IF EXISTS subject;
+
+DROP TABLE IF EXISTS career;
+
+DROP TABLE IF EXISTS faculty;
@@ -1,7 +1,3 @@
-CREATE DATABASE IF NOT EXISTS university;
-
-USE university;
-
 CREATE TABLE IF NOT EXISTS faculty
 (
     id         BIGINT AUTO_INCREMENT PRIMARY KEY,
@@ -115,4 +111,4 @@ CREATE TABLE IF NOT EXISTS student_career_subject
     description       VARCHAR(128),
     FOREIGN KEY (student_id) REFERENCES student (id),
     FOREIGN KEY (career_subject_id) REFERENCES career_subject (id)
-);
\ No newline at end of file
+);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS career_subject_correlative;
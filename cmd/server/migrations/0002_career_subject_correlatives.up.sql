@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS career_subject_correlative
+(
+    career_subject_id             BIGINT      NOT NULL,
+    correlative_career_subject_id BIGINT      NOT NULL,
+    requirement                   VARCHAR(50) NOT NULL DEFAULT 'APROBADA',
+    PRIMARY KEY (career_subject_id, correlative_career_subject_id),
+    FOREIGN KEY (career_subject_id) REFERENCES career_subject (id),
+    FOREIGN KEY (correlative_career_subject_id) REFERENCES career_subject (id)
+);
+
+-- career_subject.correlative_id is deprecated: it is kept until every deployment has run this backfill.
+INSERT IGNORE INTO career_subject_correlative (career_subject_id, correlative_career_subject_id, requirement)
+SELECT c.career_subject_id, c.correlative_career_subject_id, 'APROBADA'
+FROM (SELECT (SELECT MIN(id) FROM career_subject WHERE career_id = cs.career_id AND subject_id = cs.subject_id)     career_subject_id,
+             (SELECT MIN(id) FROM career_subject WHERE career_id = cs.career_id AND subject_id = cs.correlative_id) correlative_career_subject_id
+      FROM career_subject cs
+      WHERE cs.correlative_id IS NOT NULL) c
+WHERE c.correlative_career_subject_id IS NOT NULL;
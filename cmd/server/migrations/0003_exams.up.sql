@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS exam
+(
+    id                BIGINT AUTO_INCREMENT PRIMARY KEY,
+    student_id        BIGINT                             NOT NULL,
+    career_subject_id BIGINT                             NOT NULL,
+    grade             TINYINT                            NOT NULL,
+    date              DATE                               NOT NULL,
+    created_at        DATETIME DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    FOREIGN KEY (student_id) REFERENCES student (id),
+    FOREIGN KEY (career_subject_id) REFERENCES career_subject (id)
+);
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS student_professorship
+(
+    student_id       BIGINT                             NOT NULL,
+    professorship_id BIGINT                             NOT NULL,
+    created_at       DATETIME DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    PRIMARY KEY (student_id, professorship_id),
+    FOREIGN KEY (student_id) REFERENCES student (id),
+    FOREIGN KEY (professorship_id) REFERENCES professorship (id)
+);
@@ -0,0 +1,9 @@
+ALTER TABLE student_career_subject
+    DROP FOREIGN KEY student_career_subject_term_fk,
+    DROP COLUMN term_id;
+
+ALTER TABLE professorship
+    DROP FOREIGN KEY professorship_term_fk,
+    DROP COLUMN term_id;
+
+DROP TABLE IF EXISTS term;
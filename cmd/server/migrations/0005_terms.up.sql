@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS term
+(
+    id           BIGINT AUTO_INCREMENT PRIMARY KEY,
+    year         SMALLINT NOT NULL,
+    cuatrimestre TINYINT  NOT NULL,
+    start        DATE     NOT NULL,
+    end          DATE     NOT NULL,
+    UNIQUE KEY (year, cuatrimestre)
+);
+
+ALTER TABLE professorship
+    ADD COLUMN term_id BIGINT AFTER career_subject_id,
+    ADD CONSTRAINT professorship_term_fk FOREIGN KEY (term_id) REFERENCES term (id);
+
+ALTER TABLE student_career_subject
+    ADD COLUMN term_id BIGINT,
+    ADD CONSTRAINT student_career_subject_term_fk FOREIGN KEY (term_id) REFERENCES term (id);
@@ -0,0 +1,3 @@
+ALTER TABLE student
+    DROP COLUMN role,
+    DROP COLUMN password_hash;
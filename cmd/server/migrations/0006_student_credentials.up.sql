@@ -0,0 +1,3 @@
+ALTER TABLE student
+    ADD COLUMN password_hash VARCHAR(255) NULL AFTER email,
+    ADD COLUMN role          VARCHAR(16) DEFAULT 'STUDENT' NOT NULL AFTER password_hash;
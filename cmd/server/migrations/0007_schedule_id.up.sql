@@ -0,0 +1,2 @@
+ALTER TABLE schedule
+    ADD COLUMN id BIGINT AUTO_INCREMENT PRIMARY KEY FIRST;
@@ -0,0 +1,7 @@
+ALTER TABLE student
+    DROP INDEX student_email_unique;
+
+-- The unique key may be backing the student_id foreign key, which needs an index of its own once it is dropped.
+ALTER TABLE student_career_subject
+    ADD INDEX student_career_subject_student_idx (student_id),
+    DROP INDEX student_career_subject_unique;
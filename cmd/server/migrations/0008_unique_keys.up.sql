@@ -0,0 +1,18 @@
+-- updateStudentSubject upserts with ON DUPLICATE KEY UPDATE, which inserted a new row per update while the key
+-- was missing. A temporary id tells the duplicated rows apart so that only the last one is kept.
+ALTER TABLE student_career_subject
+    ADD COLUMN id BIGINT AUTO_INCREMENT PRIMARY KEY FIRST;
+
+DELETE previous
+FROM student_career_subject previous
+         INNER JOIN student_career_subject latest
+                    ON latest.student_id = previous.student_id
+                        AND latest.career_subject_id = previous.career_subject_id
+                        AND latest.id > previous.id;
+
+ALTER TABLE student_career_subject
+    DROP COLUMN id,
+    ADD UNIQUE KEY student_career_subject_unique (student_id, career_subject_id);
+
+ALTER TABLE student
+    ADD UNIQUE KEY student_email_unique (email);
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS student_email_history
+(
+    id         BIGINT AUTO_INCREMENT PRIMARY KEY,
+    student_id BIGINT       NOT NULL,
+    old_email  VARCHAR(128) NOT NULL,
+    new_email  VARCHAR(128) NOT NULL,
+    changed_at DATETIME     NOT NULL,
+    FOREIGN KEY (student_id) REFERENCES student (id)
+);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS student_equivalence;
+DROP TABLE IF EXISTS career_subject_equivalence;
@@ -0,0 +1,27 @@
+CREATE TABLE IF NOT EXISTS career_subject_equivalence
+(
+    id                           BIGINT AUTO_INCREMENT PRIMARY KEY,
+    career_subject_id            BIGINT                             NOT NULL,
+    equivalent_career_subject_id BIGINT                             NOT NULL,
+    created_at                   DATETIME DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    UNIQUE KEY career_subject_equivalence_unique (career_subject_id, equivalent_career_subject_id),
+    FOREIGN KEY (career_subject_id) REFERENCES career_subject (id),
+    FOREIGN KEY (equivalent_career_subject_id) REFERENCES career_subject (id)
+);
+
+-- student_equivalence keeps the subjects credited to a student by an equivalence, both the automatic credits and
+-- the requests resolved by an admin.
+CREATE TABLE IF NOT EXISTS student_equivalence
+(
+    id                       BIGINT AUTO_INCREMENT PRIMARY KEY,
+    student_id               BIGINT      NOT NULL,
+    career_subject_id        BIGINT      NOT NULL,
+    source_career_subject_id BIGINT      NOT NULL,
+    status                   VARCHAR(16) NOT NULL,
+    automatic                BOOLEAN     NOT NULL,
+    created_at               DATETIME    NOT NULL,
+    resolved_at              DATETIME    NULL,
+    FOREIGN KEY (student_id) REFERENCES student (id),
+    FOREIGN KEY (career_subject_id) REFERENCES career_subject (id),
+    FOREIGN KEY (source_career_subject_id) REFERENCES career_subject (id)
+);
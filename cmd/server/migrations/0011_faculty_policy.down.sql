@@ -0,0 +1,4 @@
+ALTER TABLE faculty
+    DROP COLUMN max_careers_per_student,
+    DROP COLUMN allowed_statuses,
+    DROP COLUMN enforce_correlatives;
@@ -0,0 +1,6 @@
+-- The defaults keep the rules every faculty followed before policies: two careers per student, every status and
+-- correlatives enforced.
+ALTER TABLE faculty
+    ADD COLUMN max_careers_per_student INT          DEFAULT 2 NOT NULL,
+    ADD COLUMN allowed_statuses        VARCHAR(128) DEFAULT 'PENDIENTE,CURSANDO,REGULARIZADA,APROBADA,LIBRE,RECURSANDO' NOT NULL,
+    ADD COLUMN enforce_correlatives    BOOLEAN      DEFAULT TRUE NOT NULL;
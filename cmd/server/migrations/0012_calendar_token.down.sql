@@ -0,0 +1,3 @@
+ALTER TABLE student
+    DROP INDEX student_calendar_token_unique,
+    DROP COLUMN calendar_token_hash;
@@ -0,0 +1,4 @@
+-- Calendar clients can't send headers, so each student can share a secret feed address. Only its SHA-256 is kept.
+ALTER TABLE student
+    ADD COLUMN calendar_token_hash CHAR(64) NULL,
+    ADD UNIQUE KEY student_calendar_token_unique (calendar_token_hash);
@@ -0,0 +1,5 @@
+ALTER TABLE student_professorship
+    DROP INDEX student_professorship_term_unique,
+    DROP COLUMN term_key,
+    DROP COLUMN term_id,
+    DROP COLUMN career_subject_id;
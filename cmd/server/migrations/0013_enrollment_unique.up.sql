@@ -0,0 +1,24 @@
+-- A student enrolls in one professorship per subject and term. The enrollment keeps the subject and term of its
+-- professorship so that a unique key enforces it. term_key stands for a missing term, which MySQL's unique keys
+-- don't compare. A professorship without a term also overlaps every other term, which is left to the storage check.
+ALTER TABLE student_professorship
+    ADD COLUMN career_subject_id BIGINT NULL,
+    ADD COLUMN term_id           BIGINT NULL;
+
+UPDATE student_professorship sp
+    INNER JOIN professorship p ON p.id = sp.professorship_id
+SET sp.career_subject_id = p.career_subject_id,
+    sp.term_id           = p.term_id;
+
+DELETE previous
+FROM student_professorship previous
+         INNER JOIN student_professorship latest
+                    ON latest.student_id = previous.student_id
+                        AND latest.career_subject_id = previous.career_subject_id
+                        AND IFNULL(latest.term_id, 0) = IFNULL(previous.term_id, 0)
+                        AND latest.professorship_id > previous.professorship_id;
+
+ALTER TABLE student_professorship
+    MODIFY COLUMN career_subject_id BIGINT NOT NULL,
+    ADD COLUMN term_key BIGINT AS (IFNULL(term_id, 0)) STORED,
+    ADD UNIQUE KEY student_professorship_term_unique (student_id, career_subject_id, term_key);
@@ -0,0 +1,245 @@
+package migrations
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
+)
+
+//go:embed *.sql sqlite/*.sql
+var files embed.FS
+
+var migrationFile = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+type Migration struct {
+	Version int
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Load reads the embedded migrations of the driver, named VERSION_NAME.up.sql and VERSION_NAME.down.sql,
+// sorted by version. SQLite databases are only used for development and tests, so their migrations start from
+// the current MySQL schema instead of replaying its history.
+func Load(driver string) ([]Migration, error) {
+	if driver != storage.DriverSQLite {
+		return load(files)
+	}
+
+	fsys, err := fs.Sub(files, "sqlite")
+	if err != nil {
+		return nil, err
+	}
+
+	return load(fsys)
+}
+
+func load(fsys fs.FS) ([]Migration, error) {
+	names, err := fs.Glob(fsys, "*.sql")
+	if err != nil {
+		return nil, err
+	}
+
+	migrations := map[int]*Migration{}
+	for _, name := range names {
+		match := migrationFile.FindStringSubmatch(name)
+		if match == nil {
+			return nil, fmt.Errorf("could not load migration %s: expected name is VERSION_NAME.up.sql or VERSION_NAME.down.sql", name)
+		}
+
+		version, _ := strconv.Atoi(match[1])
+		m, exist := migrations[version]
+		if !exist {
+			m = &Migration{Version: version, Name: match[2]}
+			migrations[version] = m
+		}
+
+		if m.Name != match[2] {
+			return nil, fmt.Errorf("could not load migration %s: version %d is already used by %s", name, version, m.Name)
+		}
+
+		content, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return nil, fmt.Errorf("could not load migration %s: %v", name, err)
+		}
+
+		if match[3] == "up" {
+			m.Up = string(content)
+		} else {
+			m.Down = string(content)
+		}
+	}
+
+	sorted := make([]Migration, 0, len(migrations))
+	for _, m := range migrations {
+		if m.Up == "" || m.Down == "" {
+			return nil, fmt.Errorf("could not load migration %d_%s: both up and down files are required", m.Version, m.Name)
+		}
+
+		sorted = append(sorted, *m)
+	}
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Version < sorted[j].Version
+	})
+
+	return sorted, nil
+}
+
+// splitStatements splits a migration in the statements ended by a semicolon at the end of a line, since the
+// driver runs a single statement per call.
+func splitStatements(content string) []string {
+	var statements []string
+	var current strings.Builder
+	for _, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if current.Len() == 0 && (trimmed == "" || strings.HasPrefix(trimmed, "--")) {
+			continue
+		}
+
+		current.WriteString(line)
+		current.WriteString("\n")
+		if strings.HasSuffix(trimmed, ";") {
+			statements = append(statements, strings.TrimSpace(current.String()))
+			current.Reset()
+		}
+	}
+
+	if rest := strings.TrimSpace(current.String()); rest != "" {
+		statements = append(statements, rest)
+	}
+
+	return statements
+}
+
+const (
+	createSchemaVersion = `CREATE TABLE IF NOT EXISTS schema_version
+(
+    version    BIGINT PRIMARY KEY,
+    name       VARCHAR(128)                       NOT NULL,
+    applied_at DATETIME DEFAULT CURRENT_TIMESTAMP NOT NULL
+);`
+
+	getSchemaVersion       = `SELECT IFNULL(MAX(version), 0) FROM schema_version;`
+	createSchemaVersionRow = `INSERT INTO schema_version (version, name) VALUES (?, ?);`
+	deleteSchemaVersionRow = `DELETE FROM schema_version WHERE version = ?;`
+)
+
+type Migrator struct {
+	db         *sqlx.DB
+	migrations []Migration
+}
+
+func NewMigrator(db *sqlx.DB) (*Migrator, error) {
+	driver := storage.DriverMySQL
+	if storage.IsSQLite(db) {
+		driver = storage.DriverSQLite
+	}
+
+	migrations, err := Load(driver)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Migrator{db: db, migrations: migrations}, nil
+}
+
+// Version returns the version of the last applied migration, or 0 when none was applied.
+func (m *Migrator) Version() (int, error) {
+	if _, err := m.db.Exec(createSchemaVersion); err != nil {
+		return 0, fmt.Errorf("could not create schema_version: %v", err)
+	}
+
+	var version int
+	if err := m.db.Get(&version, getSchemaVersion); err != nil {
+		return 0, fmt.Errorf("could not get schema version: %v", err)
+	}
+
+	return version, nil
+}
+
+// Up applies the pending migrations in order and returns them.
+func (m *Migrator) Up() ([]Migration, error) {
+	version, err := m.Version()
+	if err != nil {
+		return nil, err
+	}
+
+	var applied []Migration
+	for _, migration := range m.migrations {
+		if migration.Version <= version {
+			continue
+		}
+
+		if err := m.run(migration.Up, createSchemaVersionRow, migration.Version, migration.Name); err != nil {
+			return applied, fmt.Errorf("could not apply migration %d_%s: %v", migration.Version, migration.Name, err)
+		}
+
+		applied = append(applied, migration)
+	}
+
+	return applied, nil
+}
+
+// Down reverts the last steps applied migrations, newest first, and returns them.
+func (m *Migrator) Down(steps int) ([]Migration, error) {
+	version, err := m.Version()
+	if err != nil {
+		return nil, err
+	}
+
+	var reverted []Migration
+	for i := len(m.migrations) - 1; i >= 0 && len(reverted) < steps; i-- {
+		migration := m.migrations[i]
+		if migration.Version > version {
+			continue
+		}
+
+		if err := m.run(migration.Down, deleteSchemaVersionRow, migration.Version); err != nil {
+			return reverted, fmt.Errorf("could not revert migration %d_%s: %v", migration.Version, migration.Name, err)
+		}
+
+		reverted = append(reverted, migration)
+	}
+
+	return reverted, nil
+}
+
+// run executes the statements of a migration and records it in schema_version in a single transaction.
+// MySQL commits schema changes implicitly, so a migration that fails halfway has to be fixed by hand.
+func (m *Migrator) run(content, record string, args ...interface{}) (err error) {
+	tx, err := m.db.Beginx()
+	if err != nil {
+		return fmt.Errorf("could not begin tx: %v", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	for _, statement := range splitStatements(content) {
+		if _, err = tx.Exec(statement); err != nil {
+			return err
+		}
+	}
+
+	if _, err = tx.Exec(record, args...); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("could not commit tx: %v", err)
+	}
+
+	return nil
+}
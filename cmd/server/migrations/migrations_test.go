@@ -0,0 +1,184 @@
+package migrations
+
+import (
+	"errors"
+	"testing"
+	"testing/fstest"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mateoferrari97/AnitiMonono-StudentAPI/cmd/server/internal/service/storage"
+)
+
+func TestLoad(t *testing.T) {
+	for _, driver := range []string{storage.DriverMySQL, storage.DriverSQLite} {
+		t.Run(driver, func(t *testing.T) {
+			// When
+			migrations, err := Load(driver)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// Then
+			require.NotEmpty(t, migrations)
+			for i, m := range migrations {
+				require.Equal(t, i+1, m.Version)
+				require.NotEmpty(t, splitStatements(m.Up), m.Name)
+				require.NotEmpty(t, splitStatements(m.Down), m.Name)
+			}
+		})
+	}
+}
+
+func TestLoad_Error(t *testing.T) {
+	tt := []struct {
+		name          string
+		fsys          fstest.MapFS
+		expectedError string
+	}{
+		{
+			name:          "invalid name",
+			fsys:          fstest.MapFS{"init.sql": {}},
+			expectedError: "could not load migration init.sql: expected name is VERSION_NAME.up.sql or VERSION_NAME.down.sql",
+		},
+		{
+			name:          "missing down",
+			fsys:          fstest.MapFS{"0001_init.up.sql": {Data: []byte("CREATE TABLE a (id BIGINT);")}},
+			expectedError: "could not load migration 1_init: both up and down files are required",
+		},
+		{
+			name: "repeated version",
+			fsys: fstest.MapFS{
+				"0001_init.up.sql":  {Data: []byte("CREATE TABLE a (id BIGINT);")},
+				"0001_other.up.sql": {Data: []byte("CREATE TABLE b (id BIGINT);")},
+			},
+			expectedError: "could not load migration 0001_other.up.sql: version 1 is already used by init",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// When
+			_, err := load(tc.fsys)
+
+			// Then
+			require.EqualError(t, err, tc.expectedError)
+		})
+	}
+}
+
+func TestSplitStatements(t *testing.T) {
+	// Given
+	content := `-- creates the table
+CREATE TABLE a
+(
+    id BIGINT
+);
+
+-- fills it
+INSERT INTO a (id)
+VALUES (1);
+`
+
+	// When
+	statements := splitStatements(content)
+
+	// Then
+	require.Equal(t, []string{
+		"CREATE TABLE a\n(\n    id BIGINT\n);",
+		"INSERT INTO a (id)\nVALUES (1);",
+	}, statements)
+}
+
+func newMigrator(t *testing.T) (*Migrator, sqlmock.Sqlmock, func()) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("could not start sql mock: %v", err)
+	}
+
+	migrator := &Migrator{
+		db: sqlx.NewDb(db, ""),
+		migrations: []Migration{
+			{Version: 1, Name: "init", Up: "CREATE TABLE a (id BIGINT);", Down: "DROP TABLE a;"},
+			{Version: 2, Name: "b", Up: "CREATE TABLE b (id BIGINT);\nALTER TABLE a ADD COLUMN b_id BIGINT;", Down: "ALTER TABLE a DROP COLUMN b_id;\nDROP TABLE b;"},
+		},
+	}
+
+	return migrator, mock, func() { _ = db.Close() }
+}
+
+func TestMigrator_Up(t *testing.T) {
+	// Given
+	migrator, mock, closeDB := newMigrator(t)
+	defer closeDB()
+
+	mock.ExpectExec(createSchemaVersion).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(getSchemaVersion).WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(1))
+	mock.ExpectBegin()
+	mock.ExpectExec("CREATE TABLE b (id BIGINT);").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("ALTER TABLE a ADD COLUMN b_id BIGINT;").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(createSchemaVersionRow).WithArgs(2, "b").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	// When
+	applied, err := migrator.Up()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Len(t, applied, 1)
+	require.Equal(t, 2, applied[0].Version)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMigrator_Up_StatementError(t *testing.T) {
+	// Given
+	migrator, mock, closeDB := newMigrator(t)
+	defer closeDB()
+
+	mock.ExpectExec(createSchemaVersion).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(getSchemaVersion).WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(0))
+	mock.ExpectBegin()
+	mock.ExpectExec("CREATE TABLE a (id BIGINT);").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(createSchemaVersionRow).WithArgs(1, "init").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec("CREATE TABLE b (id BIGINT);").WillReturnError(errors.New("table b already exists"))
+	mock.ExpectRollback()
+
+	// When
+	applied, err := migrator.Up()
+
+	// Then
+	require.EqualError(t, err, "could not apply migration 2_b: table b already exists")
+	require.Len(t, applied, 1)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMigrator_Down(t *testing.T) {
+	// Given
+	migrator, mock, closeDB := newMigrator(t)
+	defer closeDB()
+
+	mock.ExpectExec(createSchemaVersion).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(getSchemaVersion).WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(2))
+	mock.ExpectBegin()
+	mock.ExpectExec("ALTER TABLE a DROP COLUMN b_id;").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("DROP TABLE b;").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(deleteSchemaVersionRow).WithArgs(2).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	// When
+	reverted, err := migrator.Down(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	require.Len(t, reverted, 1)
+	require.Equal(t, 2, reverted[0].Version)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,144 @@
+CREATE TABLE IF NOT EXISTS faculty
+(
+    id         INTEGER PRIMARY KEY AUTOINCREMENT,
+    name       VARCHAR(50)                        NOT NULL,
+    uri        VARCHAR(128),
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS career
+(
+    id         INTEGER PRIMARY KEY AUTOINCREMENT,
+    faculty_id BIGINT                             NOT NULL REFERENCES faculty (id),
+    name       VARCHAR(50)                        NOT NULL,
+    uri        VARCHAR(128),
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS subject
+(
+    id   INTEGER PRIMARY KEY AUTOINCREMENT,
+    name VARCHAR(50) NOT NULL,
+    uri  VARCHAR(128),
+    meet VARCHAR(128)
+);
+
+CREATE TABLE IF NOT EXISTS career_subject
+(
+    id             INTEGER PRIMARY KEY AUTOINCREMENT,
+    career_id      BIGINT NOT NULL REFERENCES career (id),
+    subject_id     BIGINT NOT NULL REFERENCES subject (id),
+    correlative_id BIGINT REFERENCES subject (id),
+    hours          BIGINT,
+    type           VARCHAR(64),
+    points         BIGINT
+);
+
+CREATE TABLE IF NOT EXISTS career_subject_correlative
+(
+    career_subject_id             BIGINT      NOT NULL REFERENCES career_subject (id),
+    correlative_career_subject_id BIGINT      NOT NULL REFERENCES career_subject (id),
+    requirement                   VARCHAR(50) NOT NULL DEFAULT 'APROBADA',
+    PRIMARY KEY (career_subject_id, correlative_career_subject_id)
+);
+
+CREATE TABLE IF NOT EXISTS term
+(
+    id           INTEGER PRIMARY KEY AUTOINCREMENT,
+    year         SMALLINT NOT NULL,
+    cuatrimestre TINYINT  NOT NULL,
+    start        DATE     NOT NULL,
+    end          DATE     NOT NULL,
+    UNIQUE (year, cuatrimestre)
+);
+
+CREATE TABLE IF NOT EXISTS professorship
+(
+    id                INTEGER PRIMARY KEY AUTOINCREMENT,
+    career_subject_id BIGINT                             NOT NULL REFERENCES career_subject (id),
+    term_id           BIGINT REFERENCES term (id),
+    name              VARCHAR(50)                        NOT NULL,
+    created_at        DATETIME DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at        DATETIME DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS schedule
+(
+    id               INTEGER PRIMARY KEY AUTOINCREMENT,
+    professorship_id BIGINT NOT NULL REFERENCES professorship (id),
+    day              BIGINT NOT NULL,
+    start            TIME   NOT NULL,
+    end              TIME   NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS material
+(
+    id               INTEGER PRIMARY KEY AUTOINCREMENT,
+    professorship_id BIGINT                             NOT NULL,
+    uri              VARCHAR(128)                       NOT NULL,
+    description      VARCHAR(128)                       NOT NULL,
+    created_at       DATETIME DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at       DATETIME DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS professor
+(
+    id         INTEGER PRIMARY KEY AUTOINCREMENT,
+    name       VARCHAR(50)                        NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS professorship_professor
+(
+    professorship_id BIGINT       NOT NULL REFERENCES professorship (id),
+    professor_id     BIGINT       NOT NULL REFERENCES professor (id),
+    role             VARCHAR(128) NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS student
+(
+    id            INTEGER PRIMARY KEY AUTOINCREMENT,
+    name          VARCHAR(50)                        NOT NULL,
+    email         VARCHAR(128)                       NOT NULL UNIQUE,
+    password_hash VARCHAR(255)                       NULL,
+    role          VARCHAR(16) DEFAULT 'STUDENT'      NOT NULL,
+    created_at    DATETIME DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at    DATETIME DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS student_career
+(
+    student_id BIGINT REFERENCES student (id),
+    career_id  BIGINT REFERENCES career (id)
+);
+
+CREATE TABLE IF NOT EXISTS student_career_subject
+(
+    student_id        BIGINT      NOT NULL REFERENCES student (id),
+    career_subject_id BIGINT      NOT NULL REFERENCES career_subject (id),
+    status            VARCHAR(50) NOT NULL,
+    description       VARCHAR(128),
+    term_id           BIGINT REFERENCES term (id),
+    UNIQUE (student_id, career_subject_id)
+);
+
+CREATE TABLE IF NOT EXISTS exam
+(
+    id                INTEGER PRIMARY KEY AUTOINCREMENT,
+    student_id        BIGINT                             NOT NULL REFERENCES student (id),
+    career_subject_id BIGINT                             NOT NULL REFERENCES career_subject (id),
+    grade             TINYINT                            NOT NULL,
+    date              DATE                               NOT NULL,
+    created_at        DATETIME DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS student_professorship
+(
+    student_id       BIGINT                             NOT NULL REFERENCES student (id),
+    professorship_id BIGINT                             NOT NULL REFERENCES professorship (id),
+    created_at       DATETIME DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    PRIMARY KEY (student_id, professorship_id)
+);
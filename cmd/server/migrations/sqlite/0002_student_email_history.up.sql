@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS student_email_history
+(
+    id         INTEGER PRIMARY KEY AUTOINCREMENT,
+    student_id BIGINT       NOT NULL REFERENCES student (id),
+    old_email  VARCHAR(128) NOT NULL,
+    new_email  VARCHAR(128) NOT NULL,
+    changed_at DATETIME     NOT NULL
+);
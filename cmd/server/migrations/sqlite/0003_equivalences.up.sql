@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS career_subject_equivalence
+(
+    id                           INTEGER PRIMARY KEY AUTOINCREMENT,
+    career_subject_id            BIGINT                             NOT NULL REFERENCES career_subject (id),
+    equivalent_career_subject_id BIGINT                             NOT NULL REFERENCES career_subject (id),
+    created_at                   DATETIME DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    UNIQUE (career_subject_id, equivalent_career_subject_id)
+);
+
+CREATE TABLE IF NOT EXISTS student_equivalence
+(
+    id                       INTEGER PRIMARY KEY AUTOINCREMENT,
+    student_id               BIGINT      NOT NULL REFERENCES student (id),
+    career_subject_id        BIGINT      NOT NULL REFERENCES career_subject (id),
+    source_career_subject_id BIGINT      NOT NULL REFERENCES career_subject (id),
+    status                   VARCHAR(16) NOT NULL,
+    automatic                BOOLEAN     NOT NULL,
+    created_at               DATETIME    NOT NULL,
+    resolved_at              DATETIME    NULL
+);
@@ -0,0 +1,3 @@
+ALTER TABLE faculty DROP COLUMN max_careers_per_student;
+ALTER TABLE faculty DROP COLUMN allowed_statuses;
+ALTER TABLE faculty DROP COLUMN enforce_correlatives;
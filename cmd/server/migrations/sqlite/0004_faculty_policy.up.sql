@@ -0,0 +1,3 @@
+ALTER TABLE faculty ADD COLUMN max_careers_per_student INT DEFAULT 2 NOT NULL;
+ALTER TABLE faculty ADD COLUMN allowed_statuses VARCHAR(128) DEFAULT 'PENDIENTE,CURSANDO,REGULARIZADA,APROBADA,LIBRE,RECURSANDO' NOT NULL;
+ALTER TABLE faculty ADD COLUMN enforce_correlatives BOOLEAN DEFAULT TRUE NOT NULL;
@@ -0,0 +1,2 @@
+DROP INDEX student_calendar_token_unique;
+ALTER TABLE student DROP COLUMN calendar_token_hash;
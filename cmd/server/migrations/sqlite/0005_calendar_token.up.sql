@@ -0,0 +1,2 @@
+ALTER TABLE student ADD COLUMN calendar_token_hash CHAR(64) NULL;
+CREATE UNIQUE INDEX student_calendar_token_unique ON student (calendar_token_hash);
@@ -0,0 +1,3 @@
+DROP INDEX student_professorship_term_unique;
+ALTER TABLE student_professorship DROP COLUMN term_id;
+ALTER TABLE student_professorship DROP COLUMN career_subject_id;
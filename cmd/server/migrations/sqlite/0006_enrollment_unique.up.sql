@@ -0,0 +1,17 @@
+ALTER TABLE student_professorship ADD COLUMN career_subject_id BIGINT NULL;
+ALTER TABLE student_professorship ADD COLUMN term_id BIGINT NULL;
+
+UPDATE student_professorship
+SET career_subject_id = (SELECT p.career_subject_id FROM professorship p WHERE p.id = student_professorship.professorship_id),
+    term_id           = (SELECT p.term_id FROM professorship p WHERE p.id = student_professorship.professorship_id);
+
+DELETE
+FROM student_professorship
+WHERE EXISTS(SELECT 1
+             FROM student_professorship latest
+             WHERE latest.student_id = student_professorship.student_id
+               AND latest.career_subject_id = student_professorship.career_subject_id
+               AND IFNULL(latest.term_id, 0) = IFNULL(student_professorship.term_id, 0)
+               AND latest.professorship_id > student_professorship.professorship_id);
+
+CREATE UNIQUE INDEX student_professorship_term_unique ON student_professorship (student_id, career_subject_id, IFNULL(term_id, 0));